@@ -14,6 +14,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/alecthomas/kingpin/v2"
 	"github.com/fatih/color"
@@ -33,11 +34,13 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/engine"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/engine/defaults"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/feature"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/githook"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/mcp"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/output"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/azurerepos"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/tui"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/updater"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/verificationcache"
@@ -67,6 +70,7 @@ var (
 	filterUnverified           = cli.Flag("filter-unverified", "Only output first unverified result per chunk per detector if there are more than one results.").Bool()
 	filterEntropy              = cli.Flag("filter-entropy", "Filter unverified results with Shannon entropy. Start with 3.0.").Float64()
 	scanEntireChunk            = cli.Flag("scan-entire-chunk", "Scan the entire chunk for secrets.").Hidden().Default("false").Bool()
+	maxDecodeDepth             = cli.Flag("max-decode-depth", "Maximum number of nested encoding layers to decode, e.g. base64 inside escaped unicode. 1 disables recursive decoding.").Int()
 	compareDetectionStrategies = cli.Flag("compare-detection-strategies", "Compare different detection strategies for matching spans").Hidden().Default("false").Bool()
 	configFilename             = cli.Flag("config", "Path to configuration file.").ExistingFile()
 	// rules = cli.Flag("rules", "Path to file with custom rules.").String()
@@ -105,10 +109,19 @@ var (
 	gitClonePath           = gitScan.Flag("clone-path", "Custom path where the repository should be cloned (default: temp dir).").String()
 	gitNoCleanup           = gitScan.Flag("no-cleanup", "Do not delete cloned repositories after scanning (can only be used with --clone-path).").Bool()
 	gitTrustLocalGitConfig = gitScan.Flag("trust-local-git-config", "Trust local git config.").Bool()
+	gitScanUnreachable     = gitScan.Flag("scan-unreachable", "Also scan stashes, reflogs and dangling commits and blobs that aren't reachable from any branch or tag.").Bool()
+	gitTrackLifecycle      = gitScan.Flag("track-lifecycle", "Report the commit that introduced each secret, the commit that removed it and whether it's still present at HEAD. Slows down scans of large repositories.").Bool()
 	_                      = gitScan.Flag("allow", "No-op flag for backwards compat.").Bool()
 	_                      = gitScan.Flag("entropy", "No-op flag for backwards compat.").Bool()
 	_                      = gitScan.Flag("regex", "No-op flag for backwards compat.").Bool()
 
+	gitHookCmd    = cli.Command("git-hook", "Scan the commits of a push from a git pre-receive, update or pre-push hook, and reject the push if it adds secrets.")
+	gitHookName   = gitHookCmd.Arg("hook", "Hook being run: pre-receive, update or pre-push.").Required().Enum(string(githook.PreReceive), string(githook.Update), string(githook.PrePush))
+	gitHookArgs   = gitHookCmd.Arg("args", "Arguments git passed to the hook.").Strings()
+	gitHookFailOn = gitHookCmd.Flag("fail-on", "Reject pushes that add verified secrets (verified) or any secrets (any).").Default("verified").Enum("verified", "any")
+	// gitHookPush is the push read from the hook's input, which can only be read once.
+	gitHookPush *githook.Push
+
 	githubScan                  = cli.Command("github", "Find credentials in GitHub repositories.")
 	githubScanEndpoint          = githubScan.Flag("endpoint", "GitHub endpoint.").Default("https://api.github.com").String()
 	githubScanRepos             = githubScan.Flag("repo", `GitHub repository to scan. You can repeat this flag. Example: "https://github.com/dustin-decker/secretsandstuff"`).Strings()
@@ -154,6 +167,74 @@ var (
 	gitlabClonePath        = gitlabScan.Flag("clone-path", "Custom path where the repository should be cloned (default: temp dir)").String()
 	gitlabNoCleanup        = gitlabScan.Flag("no-cleanup", "Do not delete cloned repositories after scanning (can only be used with --clone-path).").Bool()
 
+	bitbucketScan                  = cli.Command("bitbucket", "Find credentials in Bitbucket Data Center and Bitbucket Cloud repositories.")
+	bitbucketScanEndpoint          = bitbucketScan.Flag("endpoint", "Bitbucket endpoint. Leave empty for Bitbucket Cloud. Example: https://bitbucket.example.com").String()
+	bitbucketScanInstallationType  = bitbucketScan.Flag("installation-type", "Bitbucket installation type. Detected from the endpoint by default.").Default("autodetect").Enum("autodetect", "cloud", "data-center")
+	bitbucketScanToken             = bitbucketScan.Flag("token", "Bitbucket access token. Can be provided with environment variable BITBUCKET_TOKEN.").Envar("BITBUCKET_TOKEN").String()
+	bitbucketScanUsername          = bitbucketScan.Flag("username", "Bitbucket username to authenticate with an app password instead of a token.").Envar("BITBUCKET_USERNAME").String()
+	bitbucketScanPassword          = bitbucketScan.Flag("password", "Bitbucket app password. Can be provided with environment variable BITBUCKET_PASSWORD.").Envar("BITBUCKET_PASSWORD").String()
+	bitbucketScanRepos             = bitbucketScan.Flag("repo", "Bitbucket repository to scan, as <project key or workspace>/<slug>. You can repeat this flag. Leave empty to scan all repos accessible with provided credential.").Strings()
+	bitbucketScanProjects          = bitbucketScan.Flag("project", "Data Center project key or Cloud workspace to scan the repositories of. You can repeat this flag.").Strings()
+	bitbucketScanIncludeRepos      = bitbucketScan.Flag("include-repos", `Repositories to include in the scan. This can also be a glob pattern. You can repeat this flag. Example: "PROJ/app", "PROJ/a*"`).Strings()
+	bitbucketScanExcludeRepos      = bitbucketScan.Flag("exclude-repos", `Repositories to exclude from the scan. This can also be a glob pattern. You can repeat this flag. Example: "PROJ/archive", "*/legacy-*"`).Strings()
+	bitbucketScanIncludePRComments = bitbucketScan.Flag("include-pr-comments", "Also scan the comments of pull requests.").Bool()
+	bitbucketScanIncludePaths      = bitbucketScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
+	bitbucketScanExcludePaths      = bitbucketScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+
+	azureReposScan                         = cli.Command("azure-repos", "Find credentials in Azure DevOps repositories.")
+	azureReposScanEndpoint                 = azureReposScan.Flag("endpoint", "Azure DevOps endpoint. Set it to the URL of an Azure DevOps Server to scan its collections.").Default(azurerepos.DefaultEndpoint).String()
+	azureReposScanToken                    = azureReposScan.Flag("token", "Azure DevOps personal access token. Can be provided with environment variable AZURE_DEVOPS_TOKEN.").Envar("AZURE_DEVOPS_TOKEN").String()
+	azureReposScanRepos                    = azureReposScan.Flag("repo", "Azure Repos repository URL. You can repeat this flag. Example: https://dev.azure.com/org/project/_git/repo").Strings()
+	azureReposScanOrganizations            = azureReposScan.Flag("organization", "Azure DevOps organization, or Azure DevOps Server collection, to scan. You can repeat this flag. Leave empty to scan every organization of the token's user.").Strings()
+	azureReposScanProjects                 = azureReposScan.Flag("project", "Azure DevOps project to scan, as <organization>/<project>. You can repeat this flag.").Strings()
+	azureReposScanIncludeRepos             = azureReposScan.Flag("include-repos", `Repositories to include in the scan. This can also be a glob pattern. You can repeat this flag. Must use <organization>/<project>/<repository>. Example: "org/project/app", "org/*/app-*"`).Strings()
+	azureReposScanExcludeRepos             = azureReposScan.Flag("exclude-repos", `Repositories to exclude from the scan. This can also be a glob pattern. You can repeat this flag. Must use <organization>/<project>/<repository>. Example: "org/project/archive"`).Strings()
+	azureReposScanIncludeProjects          = azureReposScan.Flag("include-projects", `Projects to include in the scan. This can also be a glob pattern. You can repeat this flag. Must use <organization>/<project>. Example: "org/project", "org/team-*"`).Strings()
+	azureReposScanExcludeProjects          = azureReposScan.Flag("exclude-projects", `Projects to exclude from the scan. This can also be a glob pattern. You can repeat this flag. Must use <organization>/<project>.`).Strings()
+	azureReposScanIncludeForks             = azureReposScan.Flag("include-forks", "Include forked repositories in the scan.").Bool()
+	azureReposScanIncludePipelineVariables = azureReposScan.Flag("include-pipeline-variables", "Also scan the plaintext variables of build pipelines and variable groups.").Bool()
+	azureReposScanIncludeWikis             = azureReposScan.Flag("include-wikis", "Also scan the repositories of project wikis.").Bool()
+	azureReposScanIncludePaths             = azureReposScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
+	azureReposScanExcludePaths             = azureReposScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+
+	gerritScan                 = cli.Command("gerrit", "Find credentials in Gerrit projects, including the patch sets and comments of their changes.")
+	gerritScanEndpoint         = gerritScan.Flag("endpoint", "Gerrit server URL. Example: https://gerrit.example.com").Required().String()
+	gerritScanUsername         = gerritScan.Flag("username", "Gerrit username. Can be provided with environment variable GERRIT_USERNAME.").Envar("GERRIT_USERNAME").String()
+	gerritScanPassword         = gerritScan.Flag("password", "Gerrit HTTP password. Can be provided with environment variable GERRIT_HTTP_PASSWORD.").Envar("GERRIT_HTTP_PASSWORD").String()
+	gerritScanProjects         = gerritScan.Flag("project", "Gerrit project to scan. You can repeat this flag. Leave empty to scan all projects accessible with provided credential.").Strings()
+	gerritScanRecentChangeDays = gerritScan.Flag("recent-change-days", "Also scan the patch sets of closed changes updated within this many days. Open changes are always scanned.").Uint32()
+	gerritScanSkipComments     = gerritScan.Flag("skip-comments", "Don't scan change messages and review comments.").Bool()
+	gerritScanIncludePaths     = gerritScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
+	gerritScanExcludePaths     = gerritScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+
+	jiraScan                   = cli.Command("jira", "Find credentials in Jira issues, including their comments and attachments.")
+	jiraScanEndpoint           = jiraScan.Flag("endpoint", "Jira site URL. Example: https://example.atlassian.net").Required().String()
+	jiraScanInstallationType   = jiraScan.Flag("installation-type", "Jira installation type. Detected from the endpoint by default.").Default("autodetect").Enum("autodetect", "cloud", "data-center")
+	jiraScanUsername           = jiraScan.Flag("username", "Jira email or username. Can be provided with environment variable JIRA_USERNAME.").Envar("JIRA_USERNAME").String()
+	jiraScanPassword           = jiraScan.Flag("password", "Jira API token or password. Can be provided with environment variable JIRA_PASSWORD.").Envar("JIRA_PASSWORD").String()
+	jiraScanToken              = jiraScan.Flag("token", "Jira personal access token. Can be provided with environment variable JIRA_TOKEN.").Envar("JIRA_TOKEN").String()
+	jiraScanProjects           = jiraScan.Flag("project", "Jira project key to scan. You can repeat this flag. Leave empty to scan all projects accessible with provided credential.").Strings()
+	jiraScanIgnoreProjects     = jiraScan.Flag("ignore-project", "Jira project key to skip. You can repeat this flag.").Strings()
+	jiraScanJQL                = jiraScan.Flag("jql", "Only scan the issues matching this JQL query.").String()
+	jiraScanSince              = jiraScan.Flag("since", "Only scan issues updated at or after this time (RFC 3339 or YYYY-MM-DD).").String()
+	jiraScanIncludeAttachments = jiraScan.Flag("include-attachments", "Also scan the attachments of issues.").Bool()
+	jiraScanInsecureSkipTLS    = jiraScan.Flag("insecure-skip-verify-tls", "Skip verifying the TLS certificate of the Jira server.").Bool()
+
+	confluenceScan                   = cli.Command("confluence", "Find credentials in Confluence pages and blog posts, including their history, comments and attachments.")
+	confluenceScanEndpoint           = confluenceScan.Flag("endpoint", "Confluence site URL. Example: https://example.atlassian.net/wiki").Required().String()
+	confluenceScanUsername           = confluenceScan.Flag("username", "Confluence email or username. Can be provided with environment variable CONFLUENCE_USERNAME.").Envar("CONFLUENCE_USERNAME").String()
+	confluenceScanPassword           = confluenceScan.Flag("password", "Confluence API token or password. Can be provided with environment variable CONFLUENCE_PASSWORD.").Envar("CONFLUENCE_PASSWORD").String()
+	confluenceScanToken              = confluenceScan.Flag("token", "Confluence personal access token. Can be provided with environment variable CONFLUENCE_TOKEN.").Envar("CONFLUENCE_TOKEN").String()
+	confluenceScanSpaces             = confluenceScan.Flag("space", "Confluence space key to scan. You can repeat this flag. Leave empty to scan all spaces of --spaces-scope.").Strings()
+	confluenceScanIgnoreSpaces       = confluenceScan.Flag("ignore-space", "Confluence space key to skip. You can repeat this flag.").Strings()
+	confluenceScanSpacesScope        = confluenceScan.Flag("spaces-scope", "Type of spaces to scan when no --space is given.").Default("all").Enum("all", "global", "personal")
+	confluenceScanCQL                = confluenceScan.Flag("cql", "Only scan the pages and blog posts matching this CQL query.").String()
+	confluenceScanSince              = confluenceScan.Flag("since", "Only scan pages and blog posts modified at or after this time (RFC 3339 or YYYY-MM-DD).").String()
+	confluenceScanIncludeComments    = confluenceScan.Flag("include-comments", "Also scan the comments of pages and blog posts.").Bool()
+	confluenceScanIncludeAttachments = confluenceScan.Flag("include-attachments", "Also scan the attachments of pages and blog posts.").Bool()
+	confluenceScanSkipHistory        = confluenceScan.Flag("skip-history", "Only scan the current version of pages and blog posts.").Bool()
+	confluenceScanInsecureSkipTLS    = confluenceScan.Flag("insecure-skip-verify-tls", "Skip verifying the TLS certificate of the Confluence server.").Bool()
+
 	filesystemScan  = cli.Command("filesystem", "Find credentials in a filesystem.")
 	filesystemPaths = filesystemScan.Arg("path", "Path to file or directory to scan.").Strings()
 	// DEPRECATED: --directory is deprecated in favor of arguments.
@@ -162,6 +243,15 @@ var (
 	// filesystemScanRecursive = filesystemScan.Flag("recursive", "Scan recursively.").Short('r').Bool()
 	filesystemScanIncludePaths = filesystemScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
 	filesystemScanExcludePaths = filesystemScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+	filesystemScanGitignore    = filesystemScan.Flag("respect-gitignore", "Skip files and directories ignored by .gitignore and .trufflehogignore files.").Bool()
+	filesystemScanStateFile    = filesystemScan.Flag("state-file", "Path to a file recording the size, modification time and hash of scanned files. Files unchanged since the previous scan are skipped.").String()
+	filesystemScanRescanFound  = filesystemScan.Flag("rescan-files-with-findings", "With --state-file, rescan unchanged files that had findings in the previous scan, so that their findings are reported and verified again, rather than skipping them.").Bool()
+	filesystemFollowSymlinks   = filesystemScan.Flag("follow-symlinks", "Follow symlinks to files and directories. Symlinks are skipped by default.").Bool()
+	filesystemSymlinksInRoot   = filesystemScan.Flag("symlinks-within-root", "With --follow-symlinks, only follow symlinks whose targets are within the scanned directory.").Bool()
+	filesystemMaxFileSize      = filesystemScan.Flag("max-file-size", "Maximum size of files to scan. Files larger than this will be skipped. (Byte units eg. 512B, 2KB, 4MB)").Bytes()
+	filesystemModifiedSince    = filesystemScan.Flag("modified-since", "Only scan files modified at or after this time (RFC 3339 or YYYY-MM-DD).").String()
+	filesystemModifiedBefore   = filesystemScan.Flag("modified-before", "Only scan files modified before this time (RFC 3339 or YYYY-MM-DD).").String()
+	filesystemOneFileSystem    = filesystemScan.Flag("one-file-system", "Don't descend into directories on other filesystems, such as mount points.").Bool()
 
 	s3Scan              = cli.Command("s3", "Find credentials in S3 buckets.")
 	s3ScanKey           = s3Scan.Flag("key", "S3 key used to authenticate. Can be provided with environment variable AWS_ACCESS_KEY_ID.").Envar("AWS_ACCESS_KEY_ID").String()
@@ -192,6 +282,51 @@ var (
 	syslogTLSKey   = syslogScan.Flag("key", "Path to TLS key.").String()
 	syslogFormat   = syslogScan.Flag("format", "Log format. Can be rfc3164 or rfc5424").Required().String()
 
+	slackExportScan               = cli.Command("slack-export", "Find credentials in Slack workspace exports, offline.")
+	slackExportScanPaths          = slackExportScan.Arg("path", "Path to an export directory or zip to scan.").Required().Strings()
+	slackExportScanWorkspaceURL   = slackExportScan.Flag("workspace-url", "URL of the exported workspace, used to link to messages. Example: https://acme.slack.com").String()
+	slackExportScanChannels       = slackExportScan.Flag("channel", "Name or ID of a channel to scan. You can repeat this flag. Leave empty to scan all conversations.").Strings()
+	slackExportScanIgnoreChannels = slackExportScan.Flag("ignore-channel", "Name or ID of a channel to skip. You can repeat this flag.").Strings()
+
+	npmScan            = cli.Command("npm", "Find credentials in every published version of npm packages.")
+	npmScanRegistryURL = npmScan.Flag("registry", "npm registry URL.").Default("https://registry.npmjs.org").String()
+	npmScanPackages    = npmScan.Flag("package", "Package to scan. You can repeat this flag.").Strings()
+	npmScanScope       = npmScan.Flag("scope", "Scan every package of this scope, found by searching the registry. Example: @acme").String()
+
+	pypiScan            = cli.Command("pypi", "Find credentials in every release of Python packages.")
+	pypiScanIndexURL    = pypiScan.Flag("index-url", "URL of the simple repository API of the package index.").Default("https://pypi.org/simple").String()
+	pypiScanPackages    = pypiScan.Flag("package", "Package to scan. You can repeat this flag.").Strings()
+	pypiScanAllPackages = pypiScan.Flag("all-packages", "Scan every package of the index. Meant for private indexes.").Bool()
+
+	artifactoryScan                = cli.Command("artifactory", "Find credentials in the artifacts of JFrog Artifactory repositories.")
+	artifactoryScanEndpoint        = artifactoryScan.Flag("endpoint", "Artifactory URL. Example: https://acme.jfrog.io/artifactory").Required().String()
+	artifactoryScanUsername        = artifactoryScan.Flag("username", "Artifactory username. Can be provided with environment variable ARTIFACTORY_USERNAME.").Envar("ARTIFACTORY_USERNAME").String()
+	artifactoryScanPassword        = artifactoryScan.Flag("password", "Artifactory password or API key. Can be provided with environment variable ARTIFACTORY_PASSWORD.").Envar("ARTIFACTORY_PASSWORD").String()
+	artifactoryScanAccessToken     = artifactoryScan.Flag("access-token", "Artifactory access token. Can be provided with environment variable ARTIFACTORY_ACCESS_TOKEN.").Envar("ARTIFACTORY_ACCESS_TOKEN").String()
+	artifactoryScanRepositories    = artifactoryScan.Flag("repository", "Repository to scan. You can repeat this flag. Leave empty to scan all local repositories.").Strings()
+	artifactoryScanIncludePaths    = artifactoryScan.Flag("include-paths", "Glob of the artifact paths to scan, where ** matches any number of folders. You can repeat this flag.").Short('i').Strings()
+	artifactoryScanIgnorePaths     = artifactoryScan.Flag("ignore-paths", "Glob of the artifact paths to skip, where ** matches any number of folders. You can repeat this flag.").Short('x').Strings()
+	artifactoryScanMaxArtifactSize = artifactoryScan.Flag("max-artifact-size", "Maximum size of artifacts to scan. Artifacts larger than this will be skipped. (Byte units eg. 512B, 2KB, 4MB)").Default("250MB").Bytes()
+
+	kubernetesScan                 = cli.Command("kubernetes", "Find credentials in the ConfigMaps, workload specs and Helm releases of a Kubernetes cluster.")
+	kubernetesScanKubeconfig       = kubernetesScan.Flag("kubeconfig", "Path to the kubeconfig file. Defaults to $KUBECONFIG or ~/.kube/config.").String()
+	kubernetesScanContext          = kubernetesScan.Flag("context", "Kubeconfig context to use. Defaults to the current context.").String()
+	kubernetesScanInCluster        = kubernetesScan.Flag("in-cluster", "Authenticate with the service account of the pod TruffleHog runs in.").Bool()
+	kubernetesScanNamespaces       = kubernetesScan.Flag("namespace", "Namespace to scan. You can repeat this flag. Leave empty to scan all namespaces.").Strings()
+	kubernetesScanIgnoreNamespaces = kubernetesScan.Flag("ignore-namespace", "Namespace to skip. You can repeat this flag.").Strings()
+	kubernetesScanIncludeSecrets   = kubernetesScan.Flag("include-secrets", "Also scan Secrets, to find credentials that belong elsewhere.").Bool()
+
+	webhookScan                    = cli.Command("webhook", "Listen for webhook requests, such as CI logs or chat messages, and find credentials in their bodies.")
+	webhookScanListen              = webhookScan.Flag("listen", "Address and port to listen on. Example: 127.0.0.1:8080").Default(":8080").String()
+	webhookScanHeaderName          = webhookScan.Flag("header-name", "Name of a header requests must carry, such as X-Webhook-Token.").String()
+	webhookScanHeaderValue         = webhookScan.Flag("header-value", "Value of the header requests must carry. Can be provided with environment variable WEBHOOK_HEADER_VALUE.").Envar("WEBHOOK_HEADER_VALUE").String()
+	webhookScanHMACSecret          = webhookScan.Flag("hmac-secret", "Secret of the HMAC-SHA256 signature of the body requests must carry. Can be provided with environment variable WEBHOOK_HMAC_SECRET.").Envar("WEBHOOK_HMAC_SECRET").String()
+	webhookScanSignatureHeader     = webhookScan.Flag("signature-header", "Header carrying the hex encoded signature, optionally prefixed with sha256=.").Default("X-Hub-Signature-256").String()
+	webhookScanMetadataHeaders     = webhookScan.Flag("metadata-header", "Request header to record in the metadata of findings. You can repeat this flag.").Strings()
+	webhookScanMaxBodySize         = webhookScan.Flag("max-body-size", "Maximum size of request bodies. Larger requests will be rejected. (Byte units eg. 512B, 2KB, 4MB)").Default("10MB").Bytes()
+	webhookScanRespondWithFindings = webhookScan.Flag("respond-with-findings", "Wait for the findings of each request and return them in the response, instead of accepting requests right away.").Bool()
+	webhookScanResponseTimeout     = webhookScan.Flag("response-timeout", "Maximum time a request waits for its findings.").Default("30s").Duration()
+
 	circleCiScan      = cli.Command("circleci", "Scan CircleCI")
 	circleCiScanToken = circleCiScan.Flag("token", "CircleCI token. Can also be provided with environment variable").Envar("CIRCLECI_TOKEN").Required().String()
 
@@ -496,6 +631,19 @@ func run(state overseer.State) {
 			logFatal(err, "error parsing the provided configuration file")
 		}
 	}
+	// Detector plugins run as child processes, which must be stopped however the scan ends, including when exiting
+	// on a fatal error.
+	closeConf := func() {
+		if err := conf.Close(); err != nil {
+			logger.Error(err, "error stopping detector plugins")
+		}
+	}
+	defer closeConf()
+	exitFatal := logFatal
+	logFatal = func(err error, message string, keyAndVals ...any) {
+		closeConf()
+		exitFatal(err, message, keyAndVals...)
+	}
 
 	if *detectorTimeout != 0 {
 		logger.Info("Setting detector timeout", "timeout", detectorTimeout.String())
@@ -561,6 +709,7 @@ func run(state overseer.State) {
 		Results:                  parsedResults,
 		PrintAvgDetectorTime:     *printAvgDetectorTime,
 		ShouldScanEntireChunk:    *scanEntireChunk,
+		MaxDecodeDepth:           *maxDecodeDepth,
 		VerificationCacheMetrics: &verificationCacheMetrics,
 	}
 
@@ -578,6 +727,14 @@ func run(state overseer.State) {
 		)
 	}
 
+	if cmd == gitHookCmd.FullCommand() {
+		push, err := githook.Read(githook.Hook(*gitHookName), *gitHookArgs, os.Stdin)
+		if err != nil {
+			logFatal(err, "error reading git hook input")
+		}
+		gitHookPush = push
+	}
+
 	if *compareDetectionStrategies {
 		if err := compareScans(ctx, cmd, engConf); err != nil {
 			logFatal(err, "error comparing detection strategies")
@@ -589,6 +746,8 @@ func run(state overseer.State) {
 	if err != nil {
 		logFatal(err, "error running scan")
 	}
+	// Stop the plugins before reporting, as exiting with a status code skips deferred calls.
+	closeConf()
 
 	verificationCacheMetricsSnapshot := struct {
 		Hits                    int32
@@ -615,6 +774,12 @@ func run(state overseer.State) {
 		"verification_caching", verificationCacheMetricsSnapshot,
 	)
 
+	if cmd == gitHookCmd.FullCommand() && rejectsPush(metrics.Metrics, *gitHookFailOn) {
+		fmt.Fprintf(os.Stderr, "TruffleHog rejected the push to %s: it adds %d verified and %d unverified secrets. Remove them from the pushed commits and push again.\n",
+			strings.Join(gitHookPush.Refs(), ", "), metrics.VerifiedSecretsFound, metrics.UnverifiedSecretsFound)
+		os.Exit(183)
+	}
+
 	if metrics.hasFoundResults && *fail {
 		logger.V(2).Info("exiting with code 183 because results were found")
 		os.Exit(183)
@@ -742,9 +907,9 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 			ctx.Logger().Error(err, "error cleaning temp artifacts")
 		}
 
-		if *jsonLegacy {
-			// If JSON legacy is enabled, that means the cloned repos are not deleted yet
-			// because they were needed for outputting legacy JSON.
+		if *jsonLegacy || *gitTrackLifecycle {
+			// If JSON legacy or lifecycle tracking is enabled, that means the cloned repos are not deleted yet
+			// because they were needed for outputting results.
 			// We only clean them up here if the user did not request to persist them.
 			if !persistGitRepo {
 				if err := cleantemp.CleanTempDirsForLegacyJSON(gitCloneTempPath); err != nil {
@@ -775,6 +940,35 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 			NoCleanup:           *gitNoCleanup,
 			PrintLegacyJSON:     *jsonLegacy,
 			TrustLocalGitConfig: *gitTrustLocalGitConfig,
+			ScanUnreachable:     *gitScanUnreachable,
+			TrackLifecycle:      *gitTrackLifecycle,
+		}
+		if ref, err := eng.ScanGit(ctx, gitCfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Git: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case gitHookCmd.FullCommand():
+		repoPath, bare, err := githook.RepoPath(ctx)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("failed to find the hook's repository: %v", err)
+		}
+		revisions, err := gitHookPush.Revisions(ctx, repoPath)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("failed to find the pushed commits: %v", err)
+		}
+		if len(revisions) == 0 {
+			ctx.Logger().V(1).Info("push adds no commits")
+			break
+		}
+
+		// Hooks run in the repository, so it's scanned in place: a clone wouldn't hold the objects a server
+		// quarantines until the push is accepted.
+		gitCfg := sources.GitConfig{
+			URI:                 "file://" + repoPath,
+			Bare:                bare,
+			TrustLocalGitConfig: true,
+			Revisions:           revisions,
 		}
 		if ref, err := eng.ScanGit(ctx, gitCfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan Git: %v", err)
@@ -873,6 +1067,133 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 		} else {
 			refs = []sources.JobProgressRef{ref}
 		}
+	case bitbucketScan.FullCommand():
+		filter, err := common.FilterFromFiles(*bitbucketScanIncludePaths, *bitbucketScanExcludePaths)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("could not create filter: %v", err)
+		}
+
+		if len(*bitbucketScanRepos) > 0 && len(*bitbucketScanProjects) > 0 {
+			return scanMetrics, fmt.Errorf("invalid config: you cannot specify both repositories and projects at the same time")
+		}
+
+		cfg := sources.BitbucketConfig{
+			Endpoint:                   *bitbucketScanEndpoint,
+			InstallationType:           *bitbucketScanInstallationType,
+			Token:                      *bitbucketScanToken,
+			Username:                   *bitbucketScanUsername,
+			Password:                   *bitbucketScanPassword,
+			Repos:                      *bitbucketScanRepos,
+			Projects:                   *bitbucketScanProjects,
+			IncludeRepos:               *bitbucketScanIncludeRepos,
+			ExcludeRepos:               *bitbucketScanExcludeRepos,
+			IncludePullRequestComments: *bitbucketScanIncludePRComments,
+			Filter:                     filter,
+		}
+
+		if ref, err := eng.ScanBitbucket(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Bitbucket: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case azureReposScan.FullCommand():
+		filter, err := common.FilterFromFiles(*azureReposScanIncludePaths, *azureReposScanExcludePaths)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("could not create filter: %v", err)
+		}
+
+		cfg := sources.AzureReposConfig{
+			Endpoint:                 *azureReposScanEndpoint,
+			Token:                    *azureReposScanToken,
+			Repos:                    *azureReposScanRepos,
+			Organizations:            *azureReposScanOrganizations,
+			Projects:                 *azureReposScanProjects,
+			IncludeRepos:             *azureReposScanIncludeRepos,
+			ExcludeRepos:             *azureReposScanExcludeRepos,
+			IncludeProjects:          *azureReposScanIncludeProjects,
+			ExcludeProjects:          *azureReposScanExcludeProjects,
+			IncludeForks:             *azureReposScanIncludeForks,
+			IncludePipelineVariables: *azureReposScanIncludePipelineVariables,
+			IncludeWikis:             *azureReposScanIncludeWikis,
+			Filter:                   filter,
+		}
+
+		if ref, err := eng.ScanAzureRepos(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Azure Repos: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case gerritScan.FullCommand():
+		filter, err := common.FilterFromFiles(*gerritScanIncludePaths, *gerritScanExcludePaths)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("could not create filter: %v", err)
+		}
+
+		cfg := sources.GerritConfig{
+			Endpoint:         *gerritScanEndpoint,
+			Username:         *gerritScanUsername,
+			Password:         *gerritScanPassword,
+			Projects:         *gerritScanProjects,
+			RecentChangeDays: *gerritScanRecentChangeDays,
+			SkipComments:     *gerritScanSkipComments,
+			Filter:           filter,
+		}
+
+		if ref, err := eng.ScanGerrit(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Gerrit: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case jiraScan.FullCommand():
+		since, err := parseTimeFlag(*jiraScanSince)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("invalid --since: %w", err)
+		}
+		cfg := sources.JiraConfig{
+			Endpoint:              *jiraScanEndpoint,
+			InstallationType:      *jiraScanInstallationType,
+			Username:              *jiraScanUsername,
+			Password:              *jiraScanPassword,
+			Token:                 *jiraScanToken,
+			Projects:              *jiraScanProjects,
+			IgnoreProjects:        *jiraScanIgnoreProjects,
+			JQL:                   *jiraScanJQL,
+			Since:                 since,
+			IncludeAttachments:    *jiraScanIncludeAttachments,
+			InsecureSkipVerifyTLS: *jiraScanInsecureSkipTLS,
+		}
+
+		if ref, err := eng.ScanJira(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Jira: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case confluenceScan.FullCommand():
+		since, err := parseTimeFlag(*confluenceScanSince)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("invalid --since: %w", err)
+		}
+		cfg := sources.ConfluenceConfig{
+			Endpoint:              *confluenceScanEndpoint,
+			Username:              *confluenceScanUsername,
+			Password:              *confluenceScanPassword,
+			Token:                 *confluenceScanToken,
+			Spaces:                *confluenceScanSpaces,
+			IgnoreSpaces:          *confluenceScanIgnoreSpaces,
+			SpacesScope:           *confluenceScanSpacesScope,
+			CQL:                   *confluenceScanCQL,
+			Since:                 since,
+			IncludeComments:       *confluenceScanIncludeComments,
+			IncludeAttachments:    *confluenceScanIncludeAttachments,
+			SkipHistory:           *confluenceScanSkipHistory,
+			InsecureSkipVerifyTLS: *confluenceScanInsecureSkipTLS,
+		}
+
+		if ref, err := eng.ScanConfluence(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Confluence: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
 	case filesystemScan.FullCommand():
 		if len(*filesystemDirectories) > 0 {
 			ctx.Logger().Info("--directory flag is deprecated, please pass directories as arguments")
@@ -880,10 +1201,27 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 		paths := make([]string, 0, len(*filesystemPaths)+len(*filesystemDirectories))
 		paths = append(paths, *filesystemPaths...)
 		paths = append(paths, *filesystemDirectories...)
+		modifiedSince, err := parseTimeFlag(*filesystemModifiedSince)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("invalid --modified-since: %w", err)
+		}
+		modifiedBefore, err := parseTimeFlag(*filesystemModifiedBefore)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("invalid --modified-before: %w", err)
+		}
 		cfg := sources.FilesystemConfig{
-			Paths:            paths,
-			IncludePathsFile: *filesystemScanIncludePaths,
-			ExcludePathsFile: *filesystemScanExcludePaths,
+			Paths:                   paths,
+			IncludePathsFile:        *filesystemScanIncludePaths,
+			ExcludePathsFile:        *filesystemScanExcludePaths,
+			RespectGitignore:        *filesystemScanGitignore,
+			StateFile:               *filesystemScanStateFile,
+			RescanFilesWithFindings: *filesystemScanRescanFound,
+			FollowSymlinks:          *filesystemFollowSymlinks,
+			SymlinksWithinRoot:      *filesystemSymlinksInRoot,
+			MaxFileSize:             int64(*filesystemMaxFileSize),
+			ModifiedSince:           modifiedSince,
+			ModifiedBefore:          modifiedBefore,
+			OneFileSystem:           *filesystemOneFileSystem,
 		}
 		if ref, err := eng.ScanFileSystem(ctx, cfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan filesystem: %v", err)
@@ -920,6 +1258,87 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 		} else {
 			refs = []sources.JobProgressRef{ref}
 		}
+	case slackExportScan.FullCommand():
+		cfg := sources.SlackExportConfig{
+			Paths:          *slackExportScanPaths,
+			WorkspaceURL:   *slackExportScanWorkspaceURL,
+			Channels:       *slackExportScanChannels,
+			IgnoreChannels: *slackExportScanIgnoreChannels,
+		}
+		if ref, err := eng.ScanSlackExport(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Slack export: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case npmScan.FullCommand():
+		cfg := sources.NPMConfig{
+			RegistryURL: *npmScanRegistryURL,
+			Packages:    *npmScanPackages,
+			Scope:       *npmScanScope,
+		}
+		if ref, err := eng.ScanNPM(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan npm: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case pypiScan.FullCommand():
+		cfg := sources.PyPIConfig{
+			IndexURL:    *pypiScanIndexURL,
+			Packages:    *pypiScanPackages,
+			AllPackages: *pypiScanAllPackages,
+		}
+		if ref, err := eng.ScanPyPI(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan PyPI: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case artifactoryScan.FullCommand():
+		cfg := sources.ArtifactoryConfig{
+			Endpoint:        *artifactoryScanEndpoint,
+			Username:        *artifactoryScanUsername,
+			Password:        *artifactoryScanPassword,
+			AccessToken:     *artifactoryScanAccessToken,
+			Repositories:    *artifactoryScanRepositories,
+			IncludePaths:    *artifactoryScanIncludePaths,
+			IgnorePaths:     *artifactoryScanIgnorePaths,
+			MaxArtifactSize: int64(*artifactoryScanMaxArtifactSize),
+		}
+		if ref, err := eng.ScanArtifactory(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Artifactory: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case kubernetesScan.FullCommand():
+		cfg := sources.KubernetesConfig{
+			Kubeconfig:       *kubernetesScanKubeconfig,
+			Context:          *kubernetesScanContext,
+			InCluster:        *kubernetesScanInCluster,
+			Namespaces:       *kubernetesScanNamespaces,
+			IgnoreNamespaces: *kubernetesScanIgnoreNamespaces,
+			IncludeSecrets:   *kubernetesScanIncludeSecrets,
+		}
+		if ref, err := eng.ScanKubernetes(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Kubernetes: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case webhookScan.FullCommand():
+		cfg := sources.WebhookConfig{
+			ListenAddress:       *webhookScanListen,
+			HeaderName:          *webhookScanHeaderName,
+			HeaderValue:         *webhookScanHeaderValue,
+			HMACSecret:          *webhookScanHMACSecret,
+			SignatureHeader:     *webhookScanSignatureHeader,
+			MetadataHeaders:     *webhookScanMetadataHeaders,
+			MaxBodySize:         int64(*webhookScanMaxBodySize),
+			RespondWithFindings: *webhookScanRespondWithFindings,
+			ResponseTimeout:     *webhookScanResponseTimeout,
+		}
+		if ref, err := eng.ScanWebhook(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan webhook: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
 	case circleCiScan.FullCommand():
 		if ref, err := eng.ScanCircleCI(ctx, *circleCiScanToken); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan CircleCI: %v", err)
@@ -1132,6 +1551,14 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 	return metrics{Metrics: eng.GetMetrics(), hasFoundResults: eng.HasFoundResults()}, retErr
 }
 
+// rejectsPush reports whether a git hook scan's results reject the push under the policy given by --fail-on.
+func rejectsPush(m engine.Metrics, failOn string) bool {
+	if failOn == "any" {
+		return m.VerifiedSecretsFound+m.UnverifiedSecretsFound > 0
+	}
+	return m.VerifiedSecretsFound > 0
+}
+
 // parseResults ensures that users provide valid CSV input to `--results`.
 //
 // This is a work-around to kingpin not supporting CSVs.
@@ -1243,3 +1670,15 @@ func runMCPServer(ctx context.Context) {
 		os.Exit(1)
 	}
 }
+
+// parseTimeFlag parses a time given on the command line as either an RFC 3339 timestamp or a date, which is taken as
+// midnight UTC. An empty value yields the zero time.
+func parseTimeFlag(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
@@ -60,7 +60,6 @@ var (
 		"wmv":  {},
 
 		// documents
-		"pdf": {},
 		"psd": {},
 
 		// fonts
@@ -81,7 +80,6 @@ var (
 		// binaries
 		// These can theoretically contain secrets, but need decoding for users to make sense of them, and we don't have
 		// any such decoders right now.
-		"class":  {}, // Java bytecode class file
 		"dll":    {}, // Dynamic Link Library, Windows
 		"jdo":    {}, // Java Data Object, Java serialization format
 		"jks":    {}, // Java Key Store, Java keystore format
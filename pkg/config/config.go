@@ -1,7 +1,9 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/custom_detectors"
@@ -10,21 +12,45 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/protoyaml"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/artifactory"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/azurerepos"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/bitbucket"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/confluence"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/docker"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/filesystem"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gcs"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gerrit"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/github"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gitlab"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/jenkins"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/jira"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/kubernetes"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/npm"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/postman"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/pypi"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/s3"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/slack"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/webhook"
 )
 
 // Config holds user supplied configuration.
 type Config struct {
 	Sources   []sources.ConfiguredSource
 	Detectors []detectors.Detector
+
+	// plugins holds the running detector plugin processes so they can be
+	// stopped once scanning is finished.
+	plugins []io.Closer
+}
+
+// Close stops any detector plugin processes started for this Config.
+func (c *Config) Close() error {
+	var errs []error
+	for _, plugin := range c.plugins {
+		errs = append(errs, plugin.Close())
+	}
+	return errors.Join(errs...)
 }
 
 // Read parses a given filename into a Config.
@@ -66,9 +92,24 @@ func NewYAML(input []byte) (*Config, error) {
 		sourceConfigs = append(sourceConfigs, src)
 	}
 
+	// Start detector plugins and register the detectors they provide.
+	var plugins []io.Closer
+	for _, pluginConfig := range inputYAML.Plugins {
+		pluginDetectors, plugin, err := custom_detectors.NewPluginDetectors(pluginConfig)
+		if err != nil {
+			for _, p := range plugins {
+				_ = p.Close()
+			}
+			return nil, err
+		}
+		plugins = append(plugins, plugin)
+		detectorConfigs = append(detectorConfigs, pluginDetectors...)
+	}
+
 	return &Config{
 		Detectors: detectorConfigs,
 		Sources:   sourceConfigs,
+		plugins:   plugins,
 	}, nil
 }
 
@@ -87,6 +128,12 @@ func instantiateSourceFromType(sourceType string) (sources.Source, error) {
 		source = new(git.Source)
 	case sourcespb.SourceType_SOURCE_TYPE_GITLAB.String():
 		source = new(gitlab.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_BITBUCKET.String():
+		source = new(bitbucket.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_AZURE_REPOS.String():
+		source = new(azurerepos.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_GERRIT.String():
+		source = new(gerrit.Source)
 	case sourcespb.SourceType_SOURCE_TYPE_POSTMAN.String():
 		source = new(postman.Source)
 	case sourcespb.SourceType_SOURCE_TYPE_S3.String():
@@ -97,6 +144,22 @@ func instantiateSourceFromType(sourceType string) (sources.Source, error) {
 		source = new(filesystem.Source)
 	case sourcespb.SourceType_SOURCE_TYPE_JENKINS.String():
 		source = new(jenkins.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_JIRA.String():
+		source = new(jira.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_CONFLUENCE.String():
+		source = new(confluence.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_SLACK.String():
+		source = new(slack.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_NPM_UNAUTHD_PACKAGES.String():
+		source = new(npm.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_PYPI_UNAUTHD_PACKAGES.String():
+		source = new(pypi.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_JFROG_ARTIFACTORY.String():
+		source = new(artifactory.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_KUBERNETES.String():
+		source = new(kubernetes.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_WEBHOOK.String():
+		source = new(webhook.Source)
 	case sourcespb.SourceType_SOURCE_TYPE_GCS.String():
 		source = new(gcs.Source)
 	case sourcespb.SourceType_SOURCE_TYPE_GCS_UNAUTHED.String():
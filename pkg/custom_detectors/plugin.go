@@ -0,0 +1,416 @@
+package custom_detectors
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// PluginProtocolVersion is the version of the JSON-over-stdio protocol spoken
+// with detector plugins. Plugins must echo it back in their describe response.
+const PluginProtocolVersion = 1
+
+const (
+	defaultPluginTimeout        = 10 * time.Second
+	defaultPluginMaxConcurrency = 8
+
+	pluginMethodDescribe = "describe"
+	pluginMethodScan     = "scan"
+)
+
+var (
+	errPluginExited  = errors.New("plugin process exited")
+	errPluginTimeout = errors.New("plugin timed out")
+)
+
+// pluginRequest is a single line written to the plugin's stdin.
+type pluginRequest struct {
+	ID       uint64 `json:"id"`
+	Method   string `json:"method"`
+	Detector string `json:"detector,omitempty"`
+	Verify   bool   `json:"verify,omitempty"`
+	// Data is the keyword-matched chunk data, base64 encoded on the wire.
+	Data []byte `json:"data,omitempty"`
+}
+
+// pluginResponse is a single line read from the plugin's stdout. Responses
+// are matched to requests by ID and may arrive in any order.
+type pluginResponse struct {
+	ID              uint64               `json:"id"`
+	Error           string               `json:"error,omitempty"`
+	ProtocolVersion int                  `json:"protocol_version,omitempty"`
+	Detectors       []pluginDetectorInfo `json:"detectors,omitempty"`
+	Results         []pluginResult       `json:"results,omitempty"`
+}
+
+type pluginDetectorInfo struct {
+	Name          string   `json:"name"`
+	Keywords      []string `json:"keywords"`
+	Description   string   `json:"description,omitempty"`
+	MaxSecretSize int64    `json:"max_secret_size,omitempty"`
+}
+
+type pluginResult struct {
+	Raw               string            `json:"raw"`
+	RawV2             string            `json:"raw_v2,omitempty"`
+	Redacted          string            `json:"redacted,omitempty"`
+	Verified          bool              `json:"verified,omitempty"`
+	VerificationError string            `json:"verification_error,omitempty"`
+	ExtraData         map[string]string `json:"extra_data,omitempty"`
+}
+
+// pluginProcess manages the lifecycle of a single plugin executable. The
+// process is started lazily and restarted on the next request if it exits.
+// Requests are multiplexed over one stdin/stdout pair and bounded by a
+// concurrency semaphore. A request that times out kills the process, which
+// is then restarted on the next request.
+type pluginProcess struct {
+	cfg     *custom_detectorspb.DetectorPlugin
+	timeout time.Duration
+	sem     chan struct{}
+
+	// writeMu serializes writes to stdin, so request lines do not interleave.
+	// It is separate from mu so that a plugin which stops reading its stdin
+	// cannot block the delivery of responses.
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	stdin   io.WriteCloser
+	cmd     *exec.Cmd
+	exited  chan struct{}
+	nextID  uint64
+	pending map[uint64]chan pluginResponse
+}
+
+func newPluginProcess(cfg *custom_detectorspb.DetectorPlugin) *pluginProcess {
+	timeout := cfg.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = defaultPluginTimeout
+	}
+	concurrency := int(cfg.GetMaxConcurrency())
+	if concurrency <= 0 {
+		concurrency = defaultPluginMaxConcurrency
+	}
+	return &pluginProcess{
+		cfg:     cfg,
+		timeout: timeout,
+		sem:     make(chan struct{}, concurrency),
+		pending: make(map[uint64]chan pluginResponse),
+	}
+}
+
+// startLocked spawns the plugin process. The caller must hold p.mu.
+func (p *pluginProcess) startLocked() error {
+	cmd := exec.Command(p.cfg.GetCommand(), p.cfg.GetArgs()...)
+	cmd.Env = os.Environ()
+	for k, v := range p.cfg.GetEnv() {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("error starting plugin %q: %w", p.cfg.GetName(), err)
+	}
+
+	exited := make(chan struct{})
+	p.cmd, p.stdin, p.exited = cmd, stdin, exited
+	go p.readLoop(cmd, stdout, exited)
+	return nil
+}
+
+// readLoop dispatches responses to their waiting requests until the plugin's
+// stdout is closed, then reaps the process so it is restarted on next use.
+func (p *pluginProcess) readLoop(cmd *exec.Cmd, stdout io.Reader, exited chan struct{}) {
+	dec := json.NewDecoder(bufio.NewReader(stdout))
+	for {
+		var resp pluginResponse
+		if err := dec.Decode(&resp); err != nil {
+			break
+		}
+		p.mu.Lock()
+		ch, ok := p.pending[resp.ID]
+		delete(p.pending, resp.ID)
+		p.mu.Unlock()
+		if ok {
+			ch <- resp
+		}
+	}
+
+	p.mu.Lock()
+	if p.cmd == cmd {
+		_ = p.stdin.Close()
+		p.cmd, p.stdin = nil, nil
+	}
+	p.mu.Unlock()
+	_ = cmd.Wait()
+	close(exited)
+}
+
+// call sends a request to the plugin and waits for the matching response,
+// the configured timeout, or the plugin exiting, whichever comes first. The
+// plugin is killed if the timeout expires, so that a stuck plugin is
+// restarted rather than holding up every later request.
+func (p *pluginProcess) call(ctx context.Context, req pluginRequest) (pluginResponse, error) {
+	ctx, cancel := context.WithTimeoutCause(ctx, p.timeout, errPluginTimeout)
+	defer cancel()
+
+	select {
+	case p.sem <- struct{}{}:
+		defer func() { <-p.sem }()
+	case <-ctx.Done():
+		return pluginResponse{}, context.Cause(ctx)
+	}
+
+	p.mu.Lock()
+	if p.cmd == nil {
+		if err := p.startLocked(); err != nil {
+			p.mu.Unlock()
+			return pluginResponse{}, err
+		}
+	}
+	p.nextID++
+	req.ID = p.nextID
+	ch := make(chan pluginResponse, 1)
+	p.pending[req.ID] = ch
+	cmd, stdin, exited := p.cmd, p.stdin, p.exited
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		delete(p.pending, req.ID)
+		p.mu.Unlock()
+	}()
+
+	line, err := json.Marshal(req)
+	if err != nil {
+		return pluginResponse{}, fmt.Errorf("error encoding request for plugin %q: %w", p.cfg.GetName(), err)
+	}
+	// The write blocks if the plugin is not reading its stdin, so it is done
+	// in the background where the timeout can interrupt it.
+	written := make(chan error, 1)
+	go func() {
+		p.writeMu.Lock()
+		defer p.writeMu.Unlock()
+		_, err := stdin.Write(append(line, '\n'))
+		written <- err
+	}()
+
+	for {
+		select {
+		case err := <-written:
+			if err != nil {
+				return pluginResponse{}, fmt.Errorf("error writing to plugin %q: %w", p.cfg.GetName(), err)
+			}
+			written = nil
+		case resp := <-ch:
+			if resp.Error != "" {
+				return resp, fmt.Errorf("plugin %q: %s", p.cfg.GetName(), resp.Error)
+			}
+			return resp, nil
+		case <-exited:
+			return pluginResponse{}, fmt.Errorf("plugin %q: %w", p.cfg.GetName(), errPluginExited)
+		case <-ctx.Done():
+			err := context.Cause(ctx)
+			if errors.Is(err, errPluginTimeout) {
+				p.kill(cmd)
+				err = fmt.Errorf("plugin %q: %w after %s", p.cfg.GetName(), err, p.timeout)
+			}
+			return pluginResponse{}, err
+		}
+	}
+}
+
+// kill kills the plugin process cmd if it is still the running one, so the
+// next request starts a new process. Its read loop then reaps it, failing
+// the requests in flight.
+func (p *pluginProcess) kill(cmd *exec.Cmd) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.cmd != cmd {
+		return
+	}
+	_ = p.stdin.Close()
+	p.cmd, p.stdin = nil, nil
+	_ = cmd.Process.Kill()
+}
+
+// Close stops the plugin process, if running, by closing its stdin and
+// waiting for it to exit. The process is killed if it does not exit within
+// the configured timeout.
+func (p *pluginProcess) Close() error {
+	p.mu.Lock()
+	cmd, stdin, exited := p.cmd, p.stdin, p.exited
+	p.mu.Unlock()
+	if cmd == nil {
+		return nil
+	}
+
+	_ = stdin.Close()
+	select {
+	case <-exited:
+	case <-time.After(p.timeout):
+		_ = cmd.Process.Kill()
+		<-exited
+	}
+	return nil
+}
+
+// PluginDetector is a detector whose keywords and metadata are registered by
+// an external plugin process and whose FromData is answered by that process.
+type PluginDetector struct {
+	name          string
+	keywords      []string
+	description   string
+	maxSecretSize int64
+	plugin        *pluginProcess
+}
+
+// Ensure the PluginDetector satisfies the interfaces at compile time.
+var _ detectors.Detector = (*PluginDetector)(nil)
+var _ detectors.CustomFalsePositiveChecker = (*PluginDetector)(nil)
+var _ detectors.MaxSecretSizeProvider = (*PluginDetector)(nil)
+
+// NewPluginDetectors starts the configured plugin, asks it to describe the
+// detectors it provides, and returns one validated detector for each. The
+// returned closer stops the plugin process.
+func NewPluginDetectors(pb *custom_detectorspb.DetectorPlugin) ([]detectors.Detector, io.Closer, error) {
+	if pb.GetName() == "" {
+		return nil, nil, errors.New("plugin name must be set")
+	}
+	if pb.GetCommand() == "" {
+		return nil, nil, fmt.Errorf("plugin %q: command must be set", pb.GetName())
+	}
+
+	plugin := newPluginProcess(pb)
+	resp, err := plugin.call(context.Background(), pluginRequest{Method: pluginMethodDescribe})
+	if err != nil {
+		_ = plugin.Close()
+		return nil, nil, err
+	}
+	if resp.ProtocolVersion != PluginProtocolVersion {
+		_ = plugin.Close()
+		return nil, nil, fmt.Errorf("plugin %q: unsupported protocol version %d", pb.GetName(), resp.ProtocolVersion)
+	}
+	if len(resp.Detectors) == 0 {
+		_ = plugin.Close()
+		return nil, nil, fmt.Errorf("plugin %q: no detectors registered", pb.GetName())
+	}
+
+	seen := make(map[string]struct{}, len(resp.Detectors))
+	dets := make([]detectors.Detector, 0, len(resp.Detectors))
+	for _, info := range resp.Detectors {
+		if info.Name == "" {
+			_ = plugin.Close()
+			return nil, nil, fmt.Errorf("plugin %q: detector name must be set", pb.GetName())
+		}
+		if _, ok := seen[info.Name]; ok {
+			_ = plugin.Close()
+			return nil, nil, fmt.Errorf("plugin %q: duplicate detector %q", pb.GetName(), info.Name)
+		}
+		seen[info.Name] = struct{}{}
+		if err := ValidateKeywords(info.Keywords); err != nil {
+			_ = plugin.Close()
+			return nil, nil, fmt.Errorf("plugin %q: detector %q: %w", pb.GetName(), info.Name, err)
+		}
+		dets = append(dets, &PluginDetector{
+			name:          info.Name,
+			keywords:      info.Keywords,
+			description:   info.Description,
+			maxSecretSize: info.MaxSecretSize,
+			plugin:        plugin,
+		})
+	}
+
+	return dets, plugin, nil
+}
+
+func (d *PluginDetector) FromData(ctx context.Context, verify bool, data []byte) ([]detectors.Result, error) {
+	resp, err := d.plugin.call(ctx, pluginRequest{
+		Method:   pluginMethodScan,
+		Detector: d.name,
+		Verify:   verify,
+		Data:     data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]detectors.Result, 0, len(resp.Results))
+	for _, r := range resp.Results {
+		if r.Raw == "" {
+			continue
+		}
+		result := detectors.Result{
+			DetectorType: detectorspb.DetectorType_CustomRegex,
+			DetectorName: d.name,
+			Raw:          []byte(r.Raw),
+			Redacted:     r.Redacted,
+			Verified:     verify && r.Verified,
+			ExtraData:    map[string]string{"name": d.name},
+		}
+		if r.RawV2 != "" {
+			result.RawV2 = []byte(r.RawV2)
+		}
+		for k, v := range r.ExtraData {
+			result.ExtraData[k] = v
+		}
+		if verify && r.VerificationError != "" {
+			result.SetVerificationError(errors.New(r.VerificationError), r.Raw)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// IsFalsePositive defers false positive filtering to the plugin.
+func (d *PluginDetector) IsFalsePositive(_ detectors.Result) (bool, string) {
+	return false, ""
+}
+
+// MaxSecretSize returns the size registered by the plugin, falling back to
+// the same default used by regex custom detectors.
+func (d *PluginDetector) MaxSecretSize() int64 {
+	if d.maxSecretSize > 0 {
+		return d.maxSecretSize
+	}
+	return 1000
+}
+
+func (d *PluginDetector) Keywords() []string {
+	return d.keywords
+}
+
+// GetName returns the name the plugin registered this detector under.
+func (d *PluginDetector) GetName() string {
+	return d.name
+}
+
+func (d *PluginDetector) Type() detectorspb.DetectorType {
+	return detectorspb.DetectorType_CustomRegex
+}
+
+func (d *PluginDetector) Description() string {
+	if d.description == "" {
+		return defaultDescription
+	}
+	return d.description
+}
@@ -0,0 +1,207 @@
+package custom_detectors
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// TestPluginHelperProcess is not a real test. It is re-executed by the plugin
+// tests as the plugin executable and speaks the plugin protocol on stdio.
+func TestPluginHelperProcess(t *testing.T) {
+	if os.Getenv("TRUFFLEHOG_TEST_PLUGIN") != "1" {
+		t.Skip("helper process")
+	}
+
+	checksumPat := regexp.MustCompile(`chk_([0-9]{6})`)
+	out := json.NewEncoder(os.Stdout)
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		var req pluginRequest
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			os.Exit(2)
+		}
+		resp := pluginResponse{ID: req.ID}
+		switch req.Method {
+		case pluginMethodDescribe:
+			resp.ProtocolVersion = PluginProtocolVersion
+			resp.Detectors = []pluginDetectorInfo{
+				{Name: "Checksum", Keywords: []string{"chk_"}, Description: "checksummed token"},
+				{Name: "Slow", Keywords: []string{"slow"}},
+				{Name: "Crash", Keywords: []string{"crash"}},
+				{Name: "Hang", Keywords: []string{"hang"}},
+			}
+		case pluginMethodScan:
+			switch req.Detector {
+			case "Checksum":
+				for _, m := range checksumPat.FindAllStringSubmatch(string(req.Data), -1) {
+					sum := 0
+					for _, c := range m[1][:5] {
+						sum += int(c - '0')
+					}
+					if sum%10 != int(m[1][5]-'0') {
+						continue
+					}
+					resp.Results = append(resp.Results, pluginResult{
+						Raw:       m[0],
+						Verified:  req.Verify,
+						ExtraData: map[string]string{"digits": m[1]},
+					})
+				}
+			case "Slow":
+				time.Sleep(time.Second)
+			case "Crash":
+				os.Exit(1)
+			case "Hang":
+				// Stop reading stdin, so that writes of later requests block.
+				time.Sleep(time.Hour)
+			default:
+				resp.Error = "unknown detector"
+			}
+		}
+		if err := out.Encode(resp); err != nil {
+			os.Exit(2)
+		}
+	}
+	os.Exit(0)
+}
+
+func newTestPluginDetectors(t *testing.T) map[string]*PluginDetector {
+	t.Helper()
+
+	dets, closer, err := NewPluginDetectors(&custom_detectorspb.DetectorPlugin{
+		Name:    "test",
+		Command: os.Args[0],
+		Args:    []string{"-test.run=TestPluginHelperProcess"},
+		Env:     map[string]string{"TRUFFLEHOG_TEST_PLUGIN": "1"},
+		Timeout: durationpb.New(200 * time.Millisecond),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = closer.Close() })
+
+	byName := make(map[string]*PluginDetector, len(dets))
+	for _, d := range dets {
+		pd := d.(*PluginDetector)
+		byName[pd.GetName()] = pd
+	}
+	return byName
+}
+
+func TestPluginDetector_Register(t *testing.T) {
+	dets := newTestPluginDetectors(t)
+
+	assert.Len(t, dets, 4)
+	checksum := dets["Checksum"]
+	require.NotNil(t, checksum)
+	assert.Equal(t, []string{"chk_"}, checksum.Keywords())
+	assert.Equal(t, "checksummed token", checksum.Description())
+	assert.Equal(t, detectorspb.DetectorType_CustomRegex, checksum.Type())
+	assert.Equal(t, defaultDescription, dets["Slow"].Description())
+}
+
+func TestPluginDetector_FromData(t *testing.T) {
+	dets := newTestPluginDetectors(t)
+	data := []byte("token chk_123455 and chk_123456")
+
+	tests := []struct {
+		name   string
+		verify bool
+		want   []detectors.Result
+	}{
+		{
+			name:   "verified",
+			verify: true,
+			want: []detectors.Result{{
+				DetectorType: detectorspb.DetectorType_CustomRegex,
+				DetectorName: "Checksum",
+				Raw:          []byte("chk_123455"),
+				Verified:     true,
+				ExtraData:    map[string]string{"name": "Checksum", "digits": "123455"},
+			}},
+		},
+		{
+			name:   "unverified",
+			verify: false,
+			want: []detectors.Result{{
+				DetectorType: detectorspb.DetectorType_CustomRegex,
+				DetectorName: "Checksum",
+				Raw:          []byte("chk_123455"),
+				ExtraData:    map[string]string{"name": "Checksum", "digits": "123455"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dets["Checksum"].FromData(context.Background(), tt.verify, data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPluginDetector_Timeout(t *testing.T) {
+	dets := newTestPluginDetectors(t)
+
+	_, err := dets["Slow"].FromData(context.Background(), false, []byte("slow"))
+	assert.ErrorIs(t, err, errPluginTimeout)
+
+	// The plugin is killed and restarted on the next request.
+	got, err := dets["Checksum"].FromData(context.Background(), false, []byte("chk_000000"))
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+}
+
+func TestPluginDetector_TimeoutWhileWriting(t *testing.T) {
+	dets := newTestPluginDetectors(t)
+
+	hung := make(chan error, 1)
+	go func() {
+		_, err := dets["Hang"].FromData(context.Background(), false, []byte("hang"))
+		hung <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// The plugin no longer reads its stdin, so this request's write fills the pipe and blocks. The timeout must
+	// still fire.
+	start := time.Now()
+	_, err := dets["Checksum"].FromData(context.Background(), false, make([]byte, 4<<20))
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+	assert.ErrorIs(t, <-hung, errPluginTimeout)
+
+	got, err := dets["Checksum"].FromData(context.Background(), false, []byte("chk_000000"))
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+}
+
+func TestPluginDetector_Restart(t *testing.T) {
+	dets := newTestPluginDetectors(t)
+
+	_, err := dets["Crash"].FromData(context.Background(), false, []byte("crash"))
+	assert.ErrorIs(t, err, errPluginExited)
+
+	// The plugin is restarted on the next request.
+	got, err := dets["Checksum"].FromData(context.Background(), false, []byte("chk_000000"))
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+}
+
+func TestNewPluginDetectors_Invalid(t *testing.T) {
+	_, _, err := NewPluginDetectors(&custom_detectorspb.DetectorPlugin{Name: "missing"})
+	assert.Error(t, err)
+
+	_, _, err = NewPluginDetectors(&custom_detectorspb.DetectorPlugin{Name: "bad", Command: "/nonexistent/plugin"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package decoders
+
+import (
+	"encoding/base32"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+type Base32 struct{}
+
+var _ Decoder = (*Base32)(nil)
+
+var (
+	b32Charset  = []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ234567=")
+	b32EndChars = "="
+	// Given characters are mostly ASCII, we can use a simple array to map.
+	b32CharsetMapping [128]bool
+)
+
+// minBase32Length is the shortest run of base32 characters that will be
+// decoded. 32 characters decode to 20 bytes.
+const minBase32Length = 32
+
+func init() {
+	for _, char := range b32Charset {
+		b32CharsetMapping[char] = true
+	}
+}
+
+func (d *Base32) Type() detectorspb.DecoderType {
+	return detectorspb.DecoderType_BASE32
+}
+
+// FromChunk decodes runs of RFC 4648 base32 characters in place. Only runs
+// that decode to printable text are replaced.
+func (d *Base32) FromChunk(chunk *sources.Chunk) *DecodableChunk {
+	if chunk == nil || len(chunk.Data) == 0 {
+		return nil
+	}
+
+	decodableChunk := &DecodableChunk{Chunk: chunk, DecoderType: d.Type()}
+	encodedSubstrings := getSubstringsOfCharacterSet(chunk.Data, minBase32Length-1, b32CharsetMapping, b32EndChars)
+	decodedSubstrings := make(map[string][]byte)
+
+	for _, str := range encodedSubstrings {
+		dec, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(str, b32EndChars))
+		if err == nil && isPrintableText(dec) {
+			decodedSubstrings[str] = dec
+		}
+	}
+
+	if len(decodedSubstrings) > 0 {
+		chunk.Data = replaceDecodedSubstrings(chunk.Data, encodedSubstrings, decodedSubstrings)
+		return decodableChunk
+	}
+
+	return nil
+}
@@ -49,28 +49,36 @@ func (d *Base64) FromChunk(chunk *sources.Chunk) *DecodableChunk {
 	}
 
 	if len(decodedSubstrings) > 0 {
-		var result bytes.Buffer
-		result.Grow(len(chunk.Data))
-
-		start := 0
-		for _, encoded := range encodedSubstrings {
-			if decoded, ok := decodedSubstrings[encoded]; ok {
-				end := bytes.Index(chunk.Data[start:], []byte(encoded))
-				if end != -1 {
-					result.Write(chunk.Data[start : start+end])
-					result.Write(decoded)
-					start += end + len(encoded)
-				}
-			}
-		}
-		result.Write(chunk.Data[start:])
-		chunk.Data = result.Bytes()
+		chunk.Data = replaceDecodedSubstrings(chunk.Data, encodedSubstrings, decodedSubstrings)
 		return decodableChunk
 	}
 
 	return nil
 }
 
+// replaceDecodedSubstrings returns a copy of data with each encoded substring
+// that has an entry in decodedSubstrings replaced by its decoded value. The
+// encoded substrings must be in the order they appear in data. Everything
+// outside the decoded spans is preserved as-is.
+func replaceDecodedSubstrings(data []byte, encodedSubstrings []string, decodedSubstrings map[string][]byte) []byte {
+	var result bytes.Buffer
+	result.Grow(len(data))
+
+	start := 0
+	for _, encoded := range encodedSubstrings {
+		if decoded, ok := decodedSubstrings[encoded]; ok {
+			end := bytes.Index(data[start:], []byte(encoded))
+			if end != -1 {
+				result.Write(data[start : start+end])
+				result.Write(decoded)
+				start += end + len(encoded)
+			}
+		}
+	}
+	result.Write(data[start:])
+	return result.Bytes()
+}
+
 func isASCII(b []byte) bool {
 	for i := 0; i < len(b); i++ {
 		if b[i] > unicode.MaxASCII {
@@ -12,6 +12,12 @@ func DefaultDecoders() []Decoder {
 		&Base64{},
 		&UTF16{},
 		&EscapedUnicode{},
+		&Hex{},
+		&PercentEncoding{},
+		&HTMLEntity{},
+		&QuotedPrintable{},
+		&Base32{},
+		&Gzip{},
 	}
 }
 
@@ -20,6 +26,9 @@ func DefaultDecoders() []Decoder {
 type DecodableChunk struct {
 	*sources.Chunk
 	DecoderType detectorspb.DecoderType
+	// DecoderChain is the sequence of decoders applied to produce this chunk,
+	// outermost first. It is only populated by a Pipeline.
+	DecoderChain []detectorspb.DecoderType
 }
 
 type Decoder interface {
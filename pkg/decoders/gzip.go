@@ -0,0 +1,81 @@
+package decoders
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"unicode/utf8"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// Gzip decodes base64-encoded gzip streams, such as the release payloads
+// stored by Helm and the compressed values found in Kubernetes manifests.
+type Gzip struct{}
+
+var _ Decoder = (*Gzip)(nil)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// maxGzipDecodedSize bounds the output of a single gzip stream so a small
+// payload cannot inflate into an arbitrarily large chunk.
+const maxGzipDecodedSize = 1 << 20 // 1MB
+
+func (d *Gzip) Type() detectorspb.DecoderType {
+	return detectorspb.DecoderType_GZIP
+}
+
+func (d *Gzip) FromChunk(chunk *sources.Chunk) *DecodableChunk {
+	if chunk == nil || len(chunk.Data) == 0 {
+		return nil
+	}
+
+	decodableChunk := &DecodableChunk{Chunk: chunk, DecoderType: d.Type()}
+	encodedSubstrings := getSubstringsOfCharacterSet(chunk.Data, 20, b64CharsetMapping, b64EndChars)
+	decodedSubstrings := make(map[string][]byte)
+
+	for _, str := range encodedSubstrings {
+		// The base64 of the gzip magic bytes always begins with "H4sI".
+		if len(str) < 4 || str[:4] != "H4sI" {
+			continue
+		}
+		compressed, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			compressed, err = base64.RawStdEncoding.DecodeString(str)
+		}
+		if err != nil || !bytes.HasPrefix(compressed, gzipMagic) {
+			continue
+		}
+		if dec, ok := gunzip(compressed); ok {
+			decodedSubstrings[str] = dec
+		}
+	}
+
+	if len(decodedSubstrings) > 0 {
+		chunk.Data = replaceDecodedSubstrings(chunk.Data, encodedSubstrings, decodedSubstrings)
+		return decodableChunk
+	}
+
+	return nil
+}
+
+// gunzip decompresses up to maxGzipDecodedSize bytes of data and reports
+// whether the result is non-empty UTF-8 text.
+func gunzip(data []byte) ([]byte, bool) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, false
+	}
+	defer r.Close()
+
+	dec, err := io.ReadAll(io.LimitReader(r, maxGzipDecodedSize))
+	if err != nil && len(dec) == 0 {
+		return nil, false
+	}
+	if len(dec) == 0 || !utf8.Valid(dec) {
+		return nil, false
+	}
+	return dec, true
+}
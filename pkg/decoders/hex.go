@@ -0,0 +1,76 @@
+package decoders
+
+import (
+	"encoding/hex"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+type Hex struct{}
+
+var _ Decoder = (*Hex)(nil)
+
+var (
+	hexCharset = []byte("0123456789abcdefABCDEF")
+	// Given characters are mostly ASCII, we can use a simple array to map.
+	hexCharsetMapping [128]bool
+)
+
+// minHexLength is the shortest run of hex digits that will be decoded. Shorter
+// runs are far more likely to be IDs or hashes than encoded credentials.
+const minHexLength = 32
+
+func init() {
+	for _, char := range hexCharset {
+		hexCharsetMapping[char] = true
+	}
+}
+
+func (d *Hex) Type() detectorspb.DecoderType {
+	return detectorspb.DecoderType_HEX
+}
+
+// FromChunk decodes runs of hex digits in place. Only runs that decode to
+// printable text are replaced, so hashes and other binary values are left
+// untouched and chunks without encoded text are not emitted at all.
+func (d *Hex) FromChunk(chunk *sources.Chunk) *DecodableChunk {
+	if chunk == nil || len(chunk.Data) == 0 {
+		return nil
+	}
+
+	decodableChunk := &DecodableChunk{Chunk: chunk, DecoderType: d.Type()}
+	encodedSubstrings := getSubstringsOfCharacterSet(chunk.Data, minHexLength-1, hexCharsetMapping, "")
+	decodedSubstrings := make(map[string][]byte)
+
+	for _, str := range encodedSubstrings {
+		if len(str)%2 != 0 {
+			continue
+		}
+		dec, err := hex.DecodeString(str)
+		if err == nil && isPrintableText(dec) {
+			decodedSubstrings[str] = dec
+		}
+	}
+
+	if len(decodedSubstrings) > 0 {
+		chunk.Data = replaceDecodedSubstrings(chunk.Data, encodedSubstrings, decodedSubstrings)
+		return decodableChunk
+	}
+
+	return nil
+}
+
+// isPrintableText reports whether b is non-empty and consists only of
+// printable ASCII and common whitespace.
+func isPrintableText(b []byte) bool {
+	if len(b) == 0 {
+		return false
+	}
+	for _, c := range b {
+		if !isPrintableByte(c) && c != '\t' && c != '\n' && c != '\r' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+package decoders
+
+import (
+	"bytes"
+	"html"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// HTMLEntity decodes named (&amp;), decimal (&#38;) and hexadecimal (&#x26;)
+// HTML character references, as found in scraped or exported web pages.
+type HTMLEntity struct{}
+
+var _ Decoder = (*HTMLEntity)(nil)
+
+func (d *HTMLEntity) Type() detectorspb.DecoderType {
+	return detectorspb.DecoderType_HTML_ENTITY
+}
+
+func (d *HTMLEntity) FromChunk(chunk *sources.Chunk) *DecodableChunk {
+	if chunk == nil || len(chunk.Data) == 0 {
+		return nil
+	}
+
+	// Cheap pre-check before the comparatively expensive unescape.
+	amp := bytes.IndexByte(chunk.Data, '&')
+	if amp == -1 || bytes.IndexByte(chunk.Data[amp:], ';') == -1 {
+		return nil
+	}
+
+	// html.UnescapeString only rewrites the entities themselves, so the
+	// surrounding data is preserved.
+	decoded := html.UnescapeString(string(chunk.Data))
+	if len(decoded) == len(chunk.Data) && decoded == string(chunk.Data) {
+		return nil
+	}
+
+	chunk.Data = []byte(decoded)
+	return &DecodableChunk{Chunk: chunk, DecoderType: d.Type()}
+}
@@ -0,0 +1,73 @@
+package decoders
+
+import (
+	"bytes"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// PercentEncoding decodes URL percent-encoded (%XX) sequences, as found in
+// query strings, form bodies and access logs.
+type PercentEncoding struct{}
+
+var _ Decoder = (*PercentEncoding)(nil)
+
+func (d *PercentEncoding) Type() detectorspb.DecoderType {
+	return detectorspb.DecoderType_PERCENT_ENCODING
+}
+
+func (d *PercentEncoding) FromChunk(chunk *sources.Chunk) *DecodableChunk {
+	if chunk == nil || len(chunk.Data) == 0 || bytes.IndexByte(chunk.Data, '%') == -1 {
+		return nil
+	}
+
+	decoded, ok := decodePercentEncoding(chunk.Data)
+	if !ok {
+		return nil
+	}
+	chunk.Data = decoded
+	return &DecodableChunk{Chunk: chunk, DecoderType: d.Type()}
+}
+
+// decodePercentEncoding replaces each valid %XX escape with the byte it
+// encodes, leaving everything else untouched. Escapes for control characters
+// other than common whitespace are left encoded to avoid introducing noise.
+// '+' is not treated as a space because it is common in credentials. The
+// returned bool reports whether anything was decoded.
+func decodePercentEncoding(data []byte) ([]byte, bool) {
+	var buf []byte
+	for i := 0; i < len(data); i++ {
+		if data[i] == '%' && i+2 < len(data) && isHexDigit(data[i+1]) && isHexDigit(data[i+2]) {
+			b := unhexDigit(data[i+1])<<4 | unhexDigit(data[i+2])
+			if b >= ' ' || b == '\t' || b == '\n' || b == '\r' {
+				if buf == nil {
+					buf = make([]byte, 0, len(data))
+					buf = append(buf, data[:i]...)
+				}
+				buf = append(buf, b)
+				i += 2
+				continue
+			}
+		}
+		if buf != nil {
+			buf = append(buf, data[i])
+		}
+	}
+	return buf, buf != nil
+}
+
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+func unhexDigit(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
@@ -0,0 +1,97 @@
+package decoders
+
+import (
+	"hash/maphash"
+	"slices"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// DefaultMaxDecodeDepth is the default number of decoder layers a Pipeline
+// will peel off a chunk. A depth of 1 runs each decoder once on the original
+// chunk only.
+const DefaultMaxDecodeDepth = 3
+
+const (
+	// maxDecodedExpansion bounds the total number of bytes a Pipeline will
+	// produce below the first layer, as a multiple of the original chunk size.
+	maxDecodedExpansion = 4
+	// minDecodedBudget is the floor for that bound, so small chunks that wrap
+	// compressed payloads can still be fully decoded.
+	minDecodedBudget = 1 << 20 // 1MB
+)
+
+// Pipeline runs decoders recursively, feeding the output of each decoder back
+// into the others up to a maximum depth. Outputs that repeat content already
+// produced for the same chunk are dropped, which both de-duplicates work and
+// breaks decoding cycles.
+type Pipeline struct {
+	decoders []Decoder
+	maxDepth int
+	seed     maphash.Seed
+}
+
+// NewPipeline creates a Pipeline over the given decoders. A maxDepth below 1
+// is treated as 1, which matches running each decoder once.
+func NewPipeline(decoders []Decoder, maxDepth int) *Pipeline {
+	if maxDepth < 1 {
+		maxDepth = 1
+	}
+	return &Pipeline{decoders: decoders, maxDepth: maxDepth, seed: maphash.MakeSeed()}
+}
+
+// Decode returns every decoded form of chunk. The first layer always contains
+// the output of each applicable decoder, exactly as if the decoders were run
+// individually. Deeper layers only contain new content. Each returned chunk's
+// DecoderChain lists the decoders applied, outermost first, and DecoderType is
+// the last decoder in that chain. The input chunk is not modified.
+func (p *Pipeline) Decode(chunk *sources.Chunk) []*DecodableChunk {
+	type layerInput struct {
+		chunk *sources.Chunk
+		chain []detectorspb.DecoderType
+	}
+
+	seen := map[uint64]struct{}{maphash.Bytes(p.seed, chunk.Data): {}}
+	budget := max(maxDecodedExpansion*len(chunk.Data), minDecodedBudget)
+
+	var out []*DecodableChunk
+	layer := []layerInput{{chunk: chunk}}
+	for depth := 0; depth < p.maxDepth && len(layer) > 0; depth++ {
+		var next []layerInput
+		for _, in := range layer {
+			for _, decoder := range p.decoders {
+				isPlain := decoder.Type() == detectorspb.DecoderType_PLAIN
+				// Plain decoding is only meaningful on the original chunk.
+				if depth > 0 && isPlain {
+					continue
+				}
+
+				// This copy is needed to preserve the input data across decoders.
+				chunkCopy := *in.chunk
+				decoded := decoder.FromChunk(&chunkCopy)
+				if decoded == nil {
+					continue
+				}
+				decoded.DecoderChain = append(slices.Clip(in.chain), decoded.DecoderType)
+
+				h := maphash.Bytes(p.seed, decoded.Chunk.Data)
+				_, dupe := seen[h]
+				if depth > 0 {
+					if dupe || len(decoded.Chunk.Data) > budget {
+						continue
+					}
+					budget -= len(decoded.Chunk.Data)
+				}
+				seen[h] = struct{}{}
+				out = append(out, decoded)
+
+				if !isPlain && !dupe {
+					next = append(next, layerInput{chunk: decoded.Chunk, chain: decoded.DecoderChain})
+				}
+			}
+		}
+		layer = next
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+package decoders
+
+import (
+	"bytes"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// QuotedPrintable decodes RFC 2045 quoted-printable text, as found in email
+// exports (.eml, .mbox).
+type QuotedPrintable struct{}
+
+var _ Decoder = (*QuotedPrintable)(nil)
+
+var (
+	qpSoftBreakLF   = []byte("=\n")
+	qpSoftBreakCRLF = []byte("=\r\n")
+	// Escapes that are almost exclusively produced by quoted-printable encoders.
+	qpEncodedEquals = []byte("=3D")
+	qpEncodedSpace  = []byte("=20")
+)
+
+func (d *QuotedPrintable) Type() detectorspb.DecoderType {
+	return detectorspb.DecoderType_QUOTED_PRINTABLE
+}
+
+// FromChunk only decodes data that looks like quoted-printable, meaning it has
+// soft line breaks or an encoded '=' or space. Without that signal, "KEY=AB"
+// style assignments would be mangled.
+func (d *QuotedPrintable) FromChunk(chunk *sources.Chunk) *DecodableChunk {
+	if chunk == nil || len(chunk.Data) == 0 || !looksQuotedPrintable(chunk.Data) {
+		return nil
+	}
+
+	decoded, ok := decodeQuotedPrintable(chunk.Data)
+	if !ok {
+		return nil
+	}
+	chunk.Data = decoded
+	return &DecodableChunk{Chunk: chunk, DecoderType: d.Type()}
+}
+
+func looksQuotedPrintable(data []byte) bool {
+	return bytes.Contains(data, qpSoftBreakLF) ||
+		bytes.Contains(data, qpSoftBreakCRLF) ||
+		bytes.Contains(data, qpEncodedEquals) ||
+		bytes.Contains(data, qpEncodedSpace)
+}
+
+// decodeQuotedPrintable removes soft line breaks and replaces =XX escapes
+// (uppercase hex, per the RFC) with the byte they encode. Malformed escapes
+// are kept verbatim rather than failing the whole chunk.
+func decodeQuotedPrintable(data []byte) ([]byte, bool) {
+	buf := make([]byte, 0, len(data))
+	decoded := false
+	for i := 0; i < len(data); i++ {
+		if data[i] != '=' {
+			buf = append(buf, data[i])
+			continue
+		}
+		switch {
+		case bytes.HasPrefix(data[i:], qpSoftBreakCRLF):
+			i += len(qpSoftBreakCRLF) - 1
+			decoded = true
+		case bytes.HasPrefix(data[i:], qpSoftBreakLF):
+			i += len(qpSoftBreakLF) - 1
+			decoded = true
+		case i+2 < len(data) && isUpperHexDigit(data[i+1]) && isUpperHexDigit(data[i+2]):
+			buf = append(buf, unhexDigit(data[i+1])<<4|unhexDigit(data[i+2]))
+			i += 2
+			decoded = true
+		default:
+			buf = append(buf, data[i])
+		}
+	}
+	return buf, decoded
+}
+
+func isUpperHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('A' <= c && c <= 'F')
+}
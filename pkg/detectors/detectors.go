@@ -213,6 +213,26 @@ type ResultWithMetadata struct {
 	DetectorDescription string
 	// DecoderType is the type of decoder that was used to generate this result's data.
 	DecoderType detectorspb.DecoderType
+	// DecoderChain is the full sequence of decoders, outermost first, that was
+	// used to generate this result's data. Its last element is DecoderType.
+	DecoderChain []detectorspb.DecoderType
+	// Lifecycle is the history of the secret in the git repository it was found
+	// in, if lifecycle tracking was requested.
+	Lifecycle *SecretLifecycle
+}
+
+// SecretLifecycle describes when a secret found in a git repository was
+// introduced and removed, correlated across all the commits it appears in.
+type SecretLifecycle struct {
+	// FirstSeenCommit is the oldest commit that introduced the secret.
+	FirstSeenCommit string
+	// FirstSeenAuthor is the author of FirstSeenCommit.
+	FirstSeenAuthor string
+	// RemovedCommit is the commit that removed the secret from the history of
+	// HEAD, if it's no longer present there.
+	RemovedCommit string `json:",omitempty"`
+	// PresentAtHead indicates whether the secret is in the tree at HEAD.
+	PresentAtHead bool
 }
 
 // CopyMetadata returns a detector result with included metadata from the source chunk.
@@ -285,7 +285,7 @@ func (ac *Core) FindDetectorMatches(chunkData []byte) []*DetectorMatch {
 }
 
 // CreateDetectorKey creates a unique key for each detector from its type, version, and, for
-// custom regex detectors, its name.
+// custom regex and plugin detectors, its name.
 func CreateDetectorKey(d detectors.Detector) DetectorKey {
 	detectorType := d.Type()
 	var version int
@@ -293,7 +293,10 @@ func CreateDetectorKey(d detectors.Detector) DetectorKey {
 		version = v.Version()
 	}
 	var customDetectorName string
-	if r, ok := d.(*custom_detectors.CustomRegexWebhook); ok {
+	switch r := d.(type) {
+	case *custom_detectors.CustomRegexWebhook:
+		customDetectorName = r.GetName()
+	case *custom_detectors.PluginDetector:
 		customDetectorName = r.GetName()
 	}
 	return DetectorKey{detectorType: detectorType, version: version, customDetectorName: customDetectorName}
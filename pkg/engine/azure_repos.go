@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"runtime"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/azurerepos"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+// ScanAzureRepos scans Azure DevOps repositories with the provided configuration.
+func (e *Engine) ScanAzureRepos(ctx context.Context, c sources.AzureReposConfig) (sources.JobProgressRef, error) {
+	scanOptions := git.NewScanOptions(git.ScanOptionFilter(c.Filter))
+
+	connection := &sourcespb.AzureRepos{
+		Endpoint:                 c.Endpoint,
+		Repositories:             c.Repos,
+		Organizations:            c.Organizations,
+		Projects:                 c.Projects,
+		IncludeRepos:             c.IncludeRepos,
+		IgnoreRepos:              c.ExcludeRepos,
+		IncludeProjects:          c.IncludeProjects,
+		IgnoreProjects:           c.ExcludeProjects,
+		IncludeForks:             c.IncludeForks,
+		IncludePipelineVariables: c.IncludePipelineVariables,
+		IncludeWikis:             c.IncludeWikis,
+		SkipBinaries:             c.SkipBinaries,
+	}
+	if len(c.Token) > 0 {
+		connection.Credential = &sourcespb.AzureRepos_Token{Token: c.Token}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal azure repos connection")
+		return sources.JobProgressRef{}, err
+	}
+
+	sourceName := "trufflehog - azure repos"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, azurerepos.SourceType)
+
+	azureSource := &azurerepos.Source{}
+	if err := azureSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
+		return sources.JobProgressRef{}, err
+	}
+	azureSource.WithScanOptions(scanOptions)
+	return e.sourceManager.EnumerateAndScan(ctx, sourceName, azureSource)
+}
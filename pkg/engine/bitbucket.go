@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"fmt"
+	"runtime"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/bitbucket"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+// ScanBitbucket scans Bitbucket Data Center or Bitbucket Cloud with the provided configuration.
+func (e *Engine) ScanBitbucket(ctx context.Context, c sources.BitbucketConfig) (sources.JobProgressRef, error) {
+	scanOptions := git.NewScanOptions(git.ScanOptionFilter(c.Filter))
+
+	connection := &sourcespb.Bitbucket{
+		Endpoint:                   c.Endpoint,
+		Repositories:               c.Repos,
+		Projects:                   c.Projects,
+		IncludeRepos:               c.IncludeRepos,
+		IgnoreRepos:                c.ExcludeRepos,
+		IncludePullRequestComments: c.IncludePullRequestComments,
+		SkipBinaries:               c.SkipBinaries,
+	}
+
+	switch c.InstallationType {
+	case "", "autodetect":
+	case "cloud":
+		connection.InstallationType = sourcespb.BitbucketInstallationType_CLOUD
+	case "data-center":
+		connection.InstallationType = sourcespb.BitbucketInstallationType_DATA_CENTER
+	default:
+		return sources.JobProgressRef{}, fmt.Errorf("invalid installation type %q", c.InstallationType)
+	}
+
+	switch {
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.Bitbucket_Token{Token: c.Token}
+	case len(c.Username) > 0:
+		connection.Credential = &sourcespb.Bitbucket_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{Username: c.Username, Password: c.Password},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal bitbucket connection")
+		return sources.JobProgressRef{}, err
+	}
+
+	sourceName := "trufflehog - bitbucket"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, bitbucket.SourceType)
+
+	bitbucketSource := &bitbucket.Source{}
+	if err := bitbucketSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
+		return sources.JobProgressRef{}, err
+	}
+	bitbucketSource.WithScanOptions(scanOptions)
+	return e.sourceManager.EnumerateAndScan(ctx, sourceName, bitbucketSource)
+}
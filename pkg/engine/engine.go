@@ -16,6 +16,7 @@ import (
 	"github.com/adrg/strutil"
 	"github.com/adrg/strutil/metrics"
 	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
@@ -31,6 +32,9 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/filesystem"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/webhook"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/verificationcache"
 )
 
@@ -111,6 +115,11 @@ type Config struct {
 	// Verify determines whether the scanner will verify candidate secrets.
 	Verify bool
 
+	// MaxDecodeDepth is the number of decoder layers to apply recursively to
+	// each chunk. Defaults to decoders.DefaultMaxDecodeDepth; 1 disables
+	// recursive decoding.
+	MaxDecodeDepth int
+
 	// Defines which results will be notified by the engine
 	// (e.g., verified, unverified, unknown)
 	Results               map[string]struct{}
@@ -163,6 +172,7 @@ type Engine struct {
 	// CLI flags.
 	concurrency       int
 	decoders          []decoders.Decoder
+	maxDecodeDepth    int
 	detectors         []detectors.Detector
 	verificationCache *verificationcache.VerificationCache
 	// Any detectors configured to override sources' verification flags
@@ -215,6 +225,19 @@ type Engine struct {
 	// Note: bad hack only used for testing.
 	verificationOverlapTracker *verificationOverlapTracker
 
+	// filesystemState is the state of incremental filesystem scans, if any. Findings in the filesystem source are
+	// recorded in it, and it is saved once all results have been reported.
+	filesystemState atomic.Pointer[filesystem.State]
+	// trackGitLifecycle is set by git scans that requested lifecycle tracking. Their results are held back in
+	// lifecycleResults until all other results have been reported, and the lifecycles of their secrets are then looked
+	// up in one pass over the history of each repository.
+	trackGitLifecycle atomic.Bool
+	lifecycleMu       sync.Mutex
+	lifecycleResults  []detectors.ResultWithMetadata
+	// webhookResponses collects the findings of webhook requests which are responded to with their findings, if any.
+	// The chunks, detections and results of those requests are tracked until they have been dispatched.
+	webhookResponses atomic.Pointer[webhook.Responses]
+
 	// detectorWorkerMultiplier is used to calculate the number of detector workers.
 	detectorWorkerMultiplier int
 	// notificationWorkerMultiplier is used to calculate the number of notification workers.
@@ -230,6 +253,7 @@ func NewEngine(ctx context.Context, cfg *Config) (*Engine, error) {
 	engine := &Engine{
 		concurrency:                         cfg.Concurrency,
 		decoders:                            cfg.Decoders,
+		maxDecodeDepth:                      cfg.MaxDecodeDepth,
 		detectors:                           cfg.Detectors,
 		verificationCache:                   verificationCache,
 		dispatcher:                          cfg.Dispatcher,
@@ -359,6 +383,10 @@ func (e *Engine) setDefaults(ctx context.Context) {
 		e.decoders = decoders.DefaultDecoders()
 	}
 
+	if e.maxDecodeDepth < 1 {
+		e.maxDecodeDepth = decoders.DefaultMaxDecodeDepth
+	}
+
 	// Only use the default detectors if none are provided.
 	if len(e.detectors) == 0 {
 		e.detectors = defaults.DefaultDetectors()
@@ -748,6 +776,14 @@ func (e *Engine) Finish(ctx context.Context) error {
 	close(e.results)    // Detector workers are done, close the results channel and call it a day.
 	e.WgNotifier.Wait() // Wait for the notifier workers to finish notifying results.
 
+	e.notifyLifecycleResults(ctx) // Git results held back for lifecycle tracking are reported last.
+
+	if state := e.filesystemState.Load(); state != nil {
+		if saveErr := state.Save(); saveErr != nil {
+			err = errors.Join(err, fmt.Errorf("unable to save filesystem state: %w", saveErr))
+		}
+	}
+
 	e.metrics.ScanDuration = time.Since(e.metrics.scanStartTime)
 
 	return err
@@ -770,10 +806,11 @@ func (e *Engine) ScanChunk(chunk *sources.Chunk) {
 
 // detectableChunk is a decoded chunk that is ready to be scanned by its detector.
 type detectableChunk struct {
-	detector *ahocorasick.DetectorMatch
-	chunk    sources.Chunk
-	decoder  detectorspb.DecoderType
-	wgDoneFn func()
+	detector     *ahocorasick.DetectorMatch
+	chunk        sources.Chunk
+	decoder      detectorspb.DecoderType
+	decoderChain []detectorspb.DecoderType
+	wgDoneFn     func()
 }
 
 // verificationOverlapChunk is a decoded chunk that has multiple detectors that match it.
@@ -782,6 +819,7 @@ type detectableChunk struct {
 type verificationOverlapChunk struct {
 	chunk                       sources.Chunk
 	decoder                     detectorspb.DecoderType
+	decoderChain                []detectorspb.DecoderType
 	detectors                   []*ahocorasick.DetectorMatch
 	verificationOverlapWgDoneFn func()
 }
@@ -790,29 +828,25 @@ func (e *Engine) scannerWorker(ctx context.Context) {
 	var wgDetect sync.WaitGroup
 	var wgVerificationOverlap sync.WaitGroup
 
+	instrumented := make([]decoders.Decoder, 0, len(e.decoders))
+	for _, decoder := range e.decoders {
+		instrumented = append(instrumented, instrumentedDecoder{decoder})
+	}
+	pipeline := decoders.NewPipeline(instrumented, e.maxDecodeDepth)
+
 	for chunk := range e.ChunksChan() {
 		startTime := time.Now()
 		sourceVerify := chunk.Verify
-		for _, decoder := range e.decoders {
-			decodeStart := time.Now()
-			// This copy is needed to preserve the original chunk.Data across multiple decoders.
-			chunkCopy := *chunk
-			decoded := decoder.FromChunk(&chunkCopy)
-			decodeTime := time.Since(decodeStart).Microseconds()
-			decodeLatency.WithLabelValues(decoder.Type().String(), chunk.SourceName).Observe(float64(decodeTime))
-
-			if decoded == nil {
-				// This means that the decoder didn't understand this chunk and isn't applicable to it.
-				continue
-			}
-
+		for _, decoded := range pipeline.Decode(chunk) {
 			matchingDetectors := e.AhoCorasickCore.FindDetectorMatches(decoded.Chunk.Data)
 			if len(matchingDetectors) > 1 && !e.verificationOverlap {
 				wgVerificationOverlap.Add(1)
+				e.trackWebhookWork(chunk.SourceType, chunk.SourceMetadata, 1)
 				e.verificationOverlapChunksChan <- verificationOverlapChunk{
 					chunk:                       *decoded.Chunk,
 					detectors:                   matchingDetectors,
 					decoder:                     decoded.DecoderType,
+					decoderChain:                decoded.DecoderChain,
 					verificationOverlapWgDoneFn: wgVerificationOverlap.Done,
 				}
 				continue
@@ -821,14 +855,17 @@ func (e *Engine) scannerWorker(ctx context.Context) {
 			for _, detector := range matchingDetectors {
 				decoded.Chunk.Verify = e.shouldVerifyChunk(sourceVerify, detector, e.detectorVerificationOverrides)
 				wgDetect.Add(1)
+				e.trackWebhookWork(chunk.SourceType, chunk.SourceMetadata, 1)
 				e.detectableChunksChan <- detectableChunk{
-					chunk:    *decoded.Chunk,
-					detector: detector,
-					decoder:  decoded.DecoderType,
-					wgDoneFn: wgDetect.Done,
+					chunk:        *decoded.Chunk,
+					detector:     detector,
+					decoder:      decoded.DecoderType,
+					decoderChain: decoded.DecoderChain,
+					wgDoneFn:     wgDetect.Done,
 				}
 			}
 		}
+		e.trackWebhookWork(chunk.SourceType, chunk.SourceMetadata, -1)
 
 		dataSize := float64(len(chunk.Data))
 
@@ -852,6 +889,18 @@ func (e *Engine) scannerWorker(ctx context.Context) {
 	ctx.Logger().V(4).Info("finished scanning chunks")
 }
 
+// instrumentedDecoder records decode latency for each call to the wrapped
+// decoder, including calls made on already-decoded data by the pipeline.
+type instrumentedDecoder struct{ decoders.Decoder }
+
+func (d instrumentedDecoder) FromChunk(chunk *sources.Chunk) *decoders.DecodableChunk {
+	decodeStart := time.Now()
+	decoded := d.Decoder.FromChunk(chunk)
+	decodeTime := time.Since(decodeStart).Microseconds()
+	decodeLatency.WithLabelValues(d.Type().String(), chunk.SourceName).Observe(float64(decodeTime))
+	return decoded
+}
+
 func (e *Engine) shouldVerifyChunk(
 	sourceVerify bool,
 	detector detectors.Detector,
@@ -1001,10 +1050,11 @@ func (e *Engine) verificationOverlapWorker(ctx context.Context) {
 						e.processResult(
 							ctx,
 							detectableChunk{
-								chunk:    chunk.chunk,
-								detector: detector,
-								decoder:  chunk.decoder,
-								wgDoneFn: wgDetect.Done,
+								chunk:        chunk.chunk,
+								detector:     detector,
+								decoder:      chunk.decoder,
+								decoderChain: chunk.decoderChain,
+								wgDoneFn:     wgDetect.Done,
 							},
 							res,
 							isFalsePositive,
@@ -1022,12 +1072,14 @@ func (e *Engine) verificationOverlapWorker(ctx context.Context) {
 
 		for _, detector := range detectorKeysWithResults {
 			wgDetect.Add(1)
+			e.trackWebhookWork(chunk.chunk.SourceType, chunk.chunk.SourceMetadata, 1)
 			chunk.chunk.Verify = e.shouldVerifyChunk(chunk.chunk.Verify, detector, e.detectorVerificationOverrides)
 			e.detectableChunksChan <- detectableChunk{
-				chunk:    chunk.chunk,
-				detector: detector,
-				decoder:  chunk.decoder,
-				wgDoneFn: wgDetect.Done,
+				chunk:        chunk.chunk,
+				detector:     detector,
+				decoder:      chunk.decoder,
+				decoderChain: chunk.decoderChain,
+				wgDoneFn:     wgDetect.Done,
 			}
 		}
 
@@ -1040,6 +1092,7 @@ func (e *Engine) verificationOverlapWorker(ctx context.Context) {
 		}
 
 		chunk.verificationOverlapWgDoneFn()
+		e.trackWebhookWork(chunk.chunk.SourceType, chunk.chunk.SourceMetadata, -1)
 	}
 
 	wgDetect.Wait()
@@ -1142,6 +1195,7 @@ func (e *Engine) detectChunk(ctx context.Context, data detectableChunk) {
 	ctx.Logger().V(5).Info("Finished detecting chunk")
 
 	data.wgDoneFn()
+	e.trackWebhookWork(data.chunk.SourceType, data.chunk.SourceMetadata, -1)
 }
 
 func (e *Engine) filterResults(
@@ -1182,6 +1236,31 @@ func (e *Engine) processResult(
 	res detectors.Result,
 	isFalsePositive func(detectors.Result) (bool, string),
 ) {
+	if line, _ := archiveMemberLocation(data.chunk.SourceMetadata); line != nil {
+		copyChunk := data.chunk
+		if copyMetaData, ok := proto.Clone(data.chunk.SourceMetadata).(*source_metadatapb.MetaData); ok {
+			copyChunk.SourceMetadata = copyMetaData
+			SetArchiveMemberLocation(&copyChunk, &res)
+			data.chunk = copyChunk
+		}
+	}
+	if line := cellLine(data.chunk.SourceMetadata); line != nil {
+		copyChunk := data.chunk
+		if copyMetaData, ok := proto.Clone(data.chunk.SourceMetadata).(*source_metadatapb.MetaData); ok {
+			copyChunk.SourceMetadata = copyMetaData
+			SetCellLine(&copyChunk, &res)
+			data.chunk = copyChunk
+		}
+	}
+	if resource, _, _ := terraformLocation(data.chunk.SourceMetadata); resource != "" {
+		copyChunk := data.chunk
+		if copyMetaData, ok := proto.Clone(data.chunk.SourceMetadata).(*source_metadatapb.MetaData); ok {
+			copyChunk.SourceMetadata = copyMetaData
+			SetTerraformLocation(&copyChunk, &res)
+			data.chunk = copyChunk
+		}
+	}
+
 	ignoreLinePresent := false
 	if SupportsLineNumbers(data.chunk.SourceType) {
 		copyChunk := data.chunk
@@ -1203,6 +1282,7 @@ func (e *Engine) processResult(
 
 	secret := detectors.CopyMetadata(&data.chunk, res)
 	secret.DecoderType = data.decoder
+	secret.DecoderChain = data.decoderChain
 	secret.DetectorDescription = data.detector.Detector.Description()
 
 	if !res.Verified && res.Raw != nil {
@@ -1210,55 +1290,126 @@ func (e *Engine) processResult(
 		secret.IsWordlistFalsePositive = isFp
 	}
 
+	e.trackWebhookWork(secret.SourceType, secret.SourceMetadata, 1)
 	e.results <- secret
 }
 
 func (e *Engine) notifierWorker(ctx context.Context) {
 	for result := range e.ResultsChan() {
-		startTime := time.Now()
-		// Filter unwanted results, based on `--results`.
-		if !result.Verified {
-			if result.VerificationError() != nil {
-				if !e.notifyUnknownResults {
-					// Skip results with verification errors.
-					continue
-				}
-			} else if !e.notifyUnverifiedResults {
-				// Skip unverified results.
-				continue
+		e.notifyResult(ctx, result)
+		e.trackWebhookWork(result.SourceType, result.SourceMetadata, -1)
+	}
+}
+
+// notifyResult dispatches a result, unless it is filtered out by `--results` or is a duplicate.
+func (e *Engine) notifyResult(ctx context.Context, result detectors.ResultWithMetadata) {
+	startTime := time.Now()
+	// Findings are recorded in the filesystem state whether or not they're reported, so that their files aren't
+	// taken for clean ones.
+	if state := e.filesystemState.Load(); state != nil && result.SourceType == filesystem.SourceType {
+		state.RecordFinding(result.SourceMetadata.GetFilesystem().GetFile())
+	}
+
+	// Filter unwanted results, based on `--results`.
+	if !result.Verified {
+		if result.VerificationError() != nil {
+			if !e.notifyUnknownResults {
+				// Skip results with verification errors.
+				return
 			}
-		} else if !e.notifyVerifiedResults {
-			// Skip verified results.
-			// TODO: Is this a legitimate use case?
-			continue
-		}
-		atomic.AddUint32(&e.numFoundResults, 1)
-
-		// Dedupe results by comparing the detector type, raw result, and source metadata.
-		// We want to avoid duplicate results with different decoder types, but we also
-		// want to include duplicate results with the same decoder type.
-		// Duplicate results with the same decoder type SHOULD have their own entry in the
-		// results list, this would happen if the same secret is found multiple times.
-		// Note: If the source type is postman, we dedupe the results regardless of decoder type.
-		key := fmt.Sprintf("%s%s%s%+v", result.DetectorType.String(), result.Raw, result.RawV2, result.SourceMetadata)
-		if val, ok := e.dedupeCache.Get(key); ok && (val != result.DecoderType ||
-			result.SourceType == sourcespb.SourceType_SOURCE_TYPE_POSTMAN) {
-			continue
+		} else if !e.notifyUnverifiedResults {
+			// Skip unverified results.
+			return
 		}
-		e.dedupeCache.Add(key, result.DecoderType)
+	} else if !e.notifyVerifiedResults {
+		// Skip verified results.
+		// TODO: Is this a legitimate use case?
+		return
+	}
+	atomic.AddUint32(&e.numFoundResults, 1)
+
+	// Dedupe results by comparing the detector type, raw result, and source metadata.
+	// We want to avoid duplicate results with different decoder types, but we also
+	// want to include duplicate results with the same decoder type.
+	// Duplicate results with the same decoder type SHOULD have their own entry in the
+	// results list, this would happen if the same secret is found multiple times.
+	// Note: If the source type is postman, we dedupe the results regardless of decoder type.
+	key := fmt.Sprintf("%s%s%s%+v", result.DetectorType.String(), result.Raw, result.RawV2, result.SourceMetadata)
+	if val, ok := e.dedupeCache.Get(key); ok && (val != result.DecoderType ||
+		result.SourceType == sourcespb.SourceType_SOURCE_TYPE_POSTMAN) {
+		return
+	}
+	e.dedupeCache.Add(key, result.DecoderType)
 
-		if result.Verified {
-			atomic.AddUint64(&e.metrics.VerifiedSecretsFound, 1)
-		} else {
-			atomic.AddUint64(&e.metrics.UnverifiedSecretsFound, 1)
-		}
+	if result.Verified {
+		atomic.AddUint64(&e.metrics.VerifiedSecretsFound, 1)
+	} else {
+		atomic.AddUint64(&e.metrics.UnverifiedSecretsFound, 1)
+	}
 
-		if err := e.dispatcher.Dispatch(ctx, result); err != nil {
-			ctx.Logger().Error(err, "error notifying result")
-		}
+	if e.trackGitLifecycle.Load() && result.SourceType == git.SourceType &&
+		result.SourceMetadata.GetGit().GetRepositoryLocalPath() != "" {
+		e.lifecycleMu.Lock()
+		e.lifecycleResults = append(e.lifecycleResults, result)
+		e.lifecycleMu.Unlock()
+		return
+	}
+	e.dispatchResult(ctx, result, startTime)
+}
 
-		chunksNotifiedLatency.Observe(float64(time.Since(startTime).Milliseconds()))
+// notifyLifecycleResults looks up the lifecycles of the secrets of the git results held back during the scan, and
+// dispatches those results. Repositories are looked up concurrently, up to the engine's concurrency.
+func (e *Engine) notifyLifecycleResults(ctx context.Context) {
+	byRepo := make(map[string][]detectors.ResultWithMetadata)
+	for _, result := range e.lifecycleResults {
+		repoPath := result.SourceMetadata.GetGit().GetRepositoryLocalPath()
+		byRepo[repoPath] = append(byRepo[repoPath], result)
 	}
+	e.lifecycleResults = nil
+
+	var wg errgroup.Group
+	wg.SetLimit(e.concurrency)
+	for repoPath, results := range byRepo {
+		wg.Go(func() error {
+			defer common.Recover(ctx)
+			startTime := time.Now()
+
+			index := make(map[string]int)
+			var secrets [][]byte
+			for _, result := range results {
+				if _, ok := index[string(result.Raw)]; !ok {
+					index[string(result.Raw)] = len(secrets)
+					secrets = append(secrets, result.Raw)
+				}
+			}
+			lifecycles, err := git.Lifecycles(ctx, repoPath, secrets)
+			if err != nil {
+				ctx.Logger().Error(err, "error looking up secret lifecycles", "repository", repoPath)
+			}
+
+			for _, result := range results {
+				if lifecycles != nil {
+					result.Lifecycle = lifecycles[index[string(result.Raw)]]
+				}
+				e.dispatchResult(ctx, result, startTime)
+			}
+			return nil
+		})
+	}
+	_ = wg.Wait()
+}
+
+// dispatchResult sends a result that has passed filtering and deduplication to the dispatcher.
+func (e *Engine) dispatchResult(ctx context.Context, result detectors.ResultWithMetadata, startTime time.Time) {
+	if responses := e.webhookResponses.Load(); responses != nil && result.SourceType == webhook.SourceType {
+		responses.Record(result)
+	}
+
+	if err := e.dispatcher.Dispatch(ctx, result); err != nil {
+		ctx.Logger().Error(err, "error notifying result")
+	}
+
+	chunksNotifiedLatency.Observe(float64(time.Since(startTime).Milliseconds()))
 }
 
 // SupportsLineNumbers determines if a line number can be found for a source type.
@@ -1305,6 +1456,140 @@ func FragmentLineOffset(chunk *sources.Chunk, result *detectors.Result) (int64,
 	return lineNumber, false
 }
 
+// SetArchiveMemberLocation moves the archive member line and offset recorded in the chunk's metadata, which locate
+// the start of the chunk within its archive member, to the location of the result's secret. The metadata is updated
+// in place, so callers must pass a chunk with its own copy of the metadata.
+func SetArchiveMemberLocation(chunk *sources.Chunk, result *detectors.Result) {
+	line, offset := archiveMemberLocation(chunk.SourceMetadata)
+	if line == nil {
+		return
+	}
+
+	before, found := secretPrefix(chunk, result)
+	if !found {
+		return
+	}
+	*line += int64(bytes.Count(before, []byte("\n")))
+	*offset += int64(len(before))
+}
+
+// archiveMemberLocation returns pointers to the archive member line and offset of the metadata, or nils if the
+// metadata does not record an archive member.
+func archiveMemberLocation(md *source_metadatapb.MetaData) (line, offset *int64) {
+	switch metadata := md.GetData().(type) {
+	case *source_metadatapb.MetaData_Filesystem:
+		if metadata.Filesystem.GetArchiveMember() != "" {
+			return &metadata.Filesystem.ArchiveMemberLine, &metadata.Filesystem.ArchiveMemberOffset
+		}
+	case *source_metadatapb.MetaData_Git:
+		if metadata.Git.GetArchiveMember() != "" {
+			return &metadata.Git.ArchiveMemberLine, &metadata.Git.ArchiveMemberOffset
+		}
+	case *source_metadatapb.MetaData_S3:
+		if metadata.S3.GetArchiveMember() != "" {
+			return &metadata.S3.ArchiveMemberLine, &metadata.S3.ArchiveMemberOffset
+		}
+	case *source_metadatapb.MetaData_Gcs:
+		if metadata.Gcs.GetArchiveMember() != "" {
+			return &metadata.Gcs.ArchiveMemberLine, &metadata.Gcs.ArchiveMemberOffset
+		}
+	case *source_metadatapb.MetaData_Docker:
+		if metadata.Docker.GetArchiveMember() != "" {
+			return &metadata.Docker.ArchiveMemberLine, &metadata.Docker.ArchiveMemberOffset
+		}
+	}
+	return nil, nil
+}
+
+// SetCellLine moves the cell line recorded in the chunk's metadata, which locates the start of the chunk within its
+// notebook cell, to the line of the result's secret. The metadata is updated in place, so callers must pass a chunk
+// with its own copy of the metadata.
+func SetCellLine(chunk *sources.Chunk, result *detectors.Result) {
+	line := cellLine(chunk.SourceMetadata)
+	if line == nil {
+		return
+	}
+
+	before, found := secretPrefix(chunk, result)
+	if !found {
+		return
+	}
+	*line += int64(bytes.Count(before, []byte("\n")))
+}
+
+// cellLine returns a pointer to the notebook cell line of the metadata, or nil if the metadata does not record a cell.
+func cellLine(md *source_metadatapb.MetaData) *int64 {
+	switch metadata := md.GetData().(type) {
+	case *source_metadatapb.MetaData_Filesystem:
+		if metadata.Filesystem.GetCell() > 0 {
+			return &metadata.Filesystem.CellLine
+		}
+	case *source_metadatapb.MetaData_Git:
+		if metadata.Git.GetCell() > 0 {
+			return &metadata.Git.CellLine
+		}
+	}
+	return nil
+}
+
+// secretPrefix returns the data of the chunk preceding the first occurrence of the result's secret, and whether the
+// secret was found in the chunk at all.
+func secretPrefix(chunk *sources.Chunk, result *detectors.Result) ([]byte, bool) {
+	secret := result.GetPrimarySecretValue()
+	if secret == "" {
+		secret = string(result.Raw)
+	}
+	before, _, found := bytes.Cut(chunk.Data, []byte(secret))
+	return before, found
+}
+
+// SetTerraformLocation records the path of the Terraform attribute holding the result's secret in the chunk's
+// metadata, and moves the resource line recorded there, which locates the start of the chunk within the resource's
+// values, to the line of the secret. The chunks of Terraform resources hold one "address.path: value" line per
+// attribute, so the path is read from the line the secret is found on. The metadata is updated in place, so callers
+// must pass a chunk with its own copy of the metadata.
+func SetTerraformLocation(chunk *sources.Chunk, result *detectors.Result) {
+	resource, attribute, resourceLine := terraformLocation(chunk.SourceMetadata)
+	if resource == "" {
+		return
+	}
+
+	before, found := secretPrefix(chunk, result)
+	if !found {
+		return
+	}
+	*resourceLine += int64(bytes.Count(before, []byte("\n")))
+
+	line, _, _ := bytes.Cut(chunk.Data[bytes.LastIndexByte(before, '\n')+1:], []byte("\n"))
+	path, ok := bytes.CutPrefix(line, []byte(resource))
+	if !ok {
+		return
+	}
+	if path, _, ok = bytes.Cut(path, []byte(": ")); ok {
+		*attribute = string(bytes.TrimPrefix(path, []byte(".")))
+	}
+}
+
+// terraformLocation returns the Terraform resource address of the metadata and pointers to its attribute path and
+// resource line, or an empty address if the metadata does not record a Terraform resource.
+func terraformLocation(md *source_metadatapb.MetaData) (resource string, attribute *string, line *int64) {
+	switch metadata := md.GetData().(type) {
+	case *source_metadatapb.MetaData_Filesystem:
+		m := metadata.Filesystem
+		return m.GetTerraformResource(), &m.TerraformAttribute, &m.TerraformResourceLine
+	case *source_metadatapb.MetaData_Git:
+		m := metadata.Git
+		return m.GetTerraformResource(), &m.TerraformAttribute, &m.TerraformResourceLine
+	case *source_metadatapb.MetaData_S3:
+		m := metadata.S3
+		return m.GetTerraformResource(), &m.TerraformAttribute, &m.TerraformResourceLine
+	case *source_metadatapb.MetaData_Gcs:
+		m := metadata.Gcs
+		return m.GetTerraformResource(), &m.TerraformAttribute, &m.TerraformResourceLine
+	}
+	return "", nil, nil
+}
+
 // FragmentFirstLineAndLink extracts the first line number and the link from the chunk metadata.
 // It returns:
 //   - The first line number of the fragment.
@@ -1334,6 +1619,7 @@ func FragmentFirstLineAndLink(chunk *sources.Chunk) (int64, *int64, string) {
 		link = metadata.Bitbucket.Link
 	case *source_metadatapb.MetaData_Gerrit:
 		fragmentStart = &metadata.Gerrit.Line
+		link = metadata.Gerrit.Link
 	case *source_metadatapb.MetaData_Filesystem:
 		fragmentStart = &metadata.Filesystem.Line
 		link = metadata.Filesystem.Link
@@ -1379,6 +1665,8 @@ func UpdateLink(ctx context.Context, metadata *source_metadatapb.MetaData, link
 		meta.Gitlab.Link = newLink
 	case *source_metadatapb.MetaData_Bitbucket:
 		meta.Bitbucket.Link = newLink
+	case *source_metadatapb.MetaData_Gerrit:
+		meta.Gerrit.Link = newLink
 	case *source_metadatapb.MetaData_Filesystem:
 		meta.Filesystem.Link = newLink
 	case *source_metadatapb.MetaData_AzureRepos:
@@ -2,12 +2,14 @@ package engine
 
 import (
 	aCtx "context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -249,6 +251,141 @@ func BenchmarkFragmentLineOffsetEnd(b *testing.B) {
 	}
 }
 
+func TestSetArchiveMemberLocation(t *testing.T) {
+	data := []byte("line1\nline2\nkey = secret here\nline4")
+	tests := []struct {
+		name       string
+		metadata   *source_metadatapb.MetaData
+		wantLine   int64
+		wantOffset int64
+	}{
+		{
+			name: "filesystem member",
+			metadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Filesystem{
+				Filesystem: &source_metadatapb.Filesystem{ArchiveMember: "app.zip/config", ArchiveMemberLine: 10, ArchiveMemberOffset: 100},
+			}},
+			wantLine:   12,
+			wantOffset: 118,
+		},
+		{
+			name: "docker member",
+			metadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Docker{
+				Docker: &source_metadatapb.Docker{ArchiveMember: "opt/app.jar/config", ArchiveMemberLine: 1},
+			}},
+			wantLine:   3,
+			wantOffset: 18,
+		},
+		{
+			name: "no member",
+			metadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_S3{
+				S3: &source_metadatapb.S3{ArchiveMemberLine: 1},
+			}},
+			wantLine:   1,
+			wantOffset: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunk := &sources.Chunk{Data: data, SourceMetadata: tt.metadata}
+			SetArchiveMemberLocation(chunk, &detectors.Result{Raw: []byte("secret here")})
+
+			line, offset := archiveMemberLocation(chunk.SourceMetadata)
+			if line == nil {
+				assert.Equal(t, tt.wantLine, tt.metadata.GetS3().GetArchiveMemberLine())
+				return
+			}
+			assert.Equal(t, tt.wantLine, *line)
+			assert.Equal(t, tt.wantOffset, *offset)
+		})
+	}
+}
+
+func TestSetCellLine(t *testing.T) {
+	data := []byte("import os\n\nkey = secret here\n")
+	tests := []struct {
+		name     string
+		metadata *source_metadatapb.MetaData
+		want     int64
+	}{
+		{
+			name: "filesystem cell",
+			metadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Filesystem{
+				Filesystem: &source_metadatapb.Filesystem{Cell: 3, CellLine: 5},
+			}},
+			want: 7,
+		},
+		{
+			name: "git cell",
+			metadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Git{
+				Git: &source_metadatapb.Git{Cell: 1, CellLine: 1},
+			}},
+			want: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunk := &sources.Chunk{Data: data, SourceMetadata: tt.metadata}
+			SetCellLine(chunk, &detectors.Result{Raw: []byte("secret here")})
+
+			assert.Equal(t, tt.want, *cellLine(chunk.SourceMetadata))
+		})
+	}
+}
+
+func TestSetTerraformLocation(t *testing.T) {
+	data := []byte(strings.Join([]string{
+		"module.db.aws_db_instance.main[0].engine: postgres",
+		"module.db.aws_db_instance.main[0].password: hunter2-secret",
+		`module.db.aws_db_instance.main[0].tags["team/owner"]: payments`,
+	}, "\n"))
+	tests := []struct {
+		name     string
+		metadata *source_metadatapb.MetaData
+		raw      string
+		want     string
+		wantLine int64
+	}{
+		{
+			name: "filesystem attribute",
+			metadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Filesystem{
+				Filesystem: &source_metadatapb.Filesystem{TerraformResource: "module.db.aws_db_instance.main[0]", TerraformResourceLine: 1},
+			}},
+			raw:      "hunter2-secret",
+			want:     "password",
+			wantLine: 2,
+		},
+		{
+			name: "s3 quoted key",
+			metadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_S3{
+				S3: &source_metadatapb.S3{TerraformResource: "module.db.aws_db_instance.main[0]", TerraformResourceLine: 4},
+			}},
+			raw:      "payments",
+			want:     `tags["team/owner"]`,
+			wantLine: 6,
+		},
+		{
+			name: "no resource",
+			metadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Gcs{
+				Gcs: &source_metadatapb.GCS{},
+			}},
+			raw: "hunter2-secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunk := &sources.Chunk{Data: data, SourceMetadata: tt.metadata}
+			SetTerraformLocation(chunk, &detectors.Result{Raw: []byte(tt.raw)})
+
+			_, attribute, line := terraformLocation(chunk.SourceMetadata)
+			assert.Equal(t, tt.want, *attribute)
+			assert.Equal(t, tt.wantLine, *line)
+		})
+	}
+}
+
 // Test to make sure that DefaultDecoders always returns the UTF8 decoder first.
 // Technically a decoder test but we want this to run and fail in CI
 func TestDefaultDecoders(t *testing.T) {
@@ -334,6 +471,47 @@ func TestEngine_DuplicateSecrets(t *testing.T) {
 	assert.Equal(t, want, e.GetMetrics().UnverifiedSecretsFound)
 }
 
+func TestEngine_FilesystemStateRecordsFilteredFindings(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	secrets, err := os.ReadFile("./testdata/secrets.txt")
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "secrets.txt")
+	require.NoError(t, os.WriteFile(path, secrets, 0o644))
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+
+	conf := Config{
+		Concurrency:   1,
+		Decoders:      decoders.DefaultDecoders(),
+		Detectors:     defaults.DefaultDetectors(),
+		Verify:        false,
+		Results:       map[string]struct{}{"verified": {}},
+		SourceManager: sources.NewManager(sources.WithSourceUnits(), sources.WithBufferedOutput(64)),
+		Dispatcher:    NewPrinterDispatcher(new(discardPrinter)),
+	}
+	e, err := NewEngine(ctx, &conf)
+	require.NoError(t, err)
+	e.Start(ctx)
+	_, err = e.ScanFileSystem(ctx, sources.FilesystemConfig{Paths: []string{path}, StateFile: stateFile})
+	require.NoError(t, err)
+	require.NoError(t, e.Finish(ctx))
+	assert.Zero(t, e.GetMetrics().UnverifiedSecretsFound)
+
+	// The unverified findings aren't reported, but the file is still recorded as having findings.
+	data, err := os.ReadFile(stateFile)
+	require.NoError(t, err)
+	var resumeInfo map[string]string
+	require.NoError(t, json.Unmarshal(data, &resumeInfo))
+	var state struct {
+		Files map[string]struct {
+			Findings int `json:"findings"`
+		} `json:"files"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(resumeInfo[""]), &state))
+	assert.Positive(t, state.Files[path].Findings)
+}
+
 // lineCaptureDispatcher is a test dispatcher that captures the line number
 // of detected secrets. It implements the Dispatcher interface and is used
 // to verify that the Engine correctly identifies and reports the line numbers
@@ -777,6 +955,21 @@ func TestFragmentFirstLineAndLink(t *testing.T) {
 			expectedLine: 5,
 			expectedLink: "https://example.github.com",
 		},
+		{
+			name: "Test Gerrit Metadata",
+			chunk: &sources.Chunk{
+				SourceMetadata: &source_metadatapb.MetaData{
+					Data: &source_metadatapb.MetaData_Gerrit{
+						Gerrit: &source_metadatapb.Gerrit{
+							Line: 5,
+							Link: "https://gerrit.example.com/c/project/+/1/1/file.go#5",
+						},
+					},
+				},
+			},
+			expectedLine: 5,
+			expectedLink: "https://gerrit.example.com/c/project/+/1/1/file.go#5",
+		},
 		{
 			name: "Test Azure Repos Metadata",
 			chunk: &sources.Chunk{
@@ -880,6 +1073,17 @@ func TestSetLink(t *testing.T) {
 			line:     3,
 			wantLink: "file:///path/to/example#L3",
 		},
+		{
+			name: "Gerrit link set",
+			input: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Gerrit{
+					Gerrit: &source_metadatapb.Gerrit{},
+				},
+			},
+			link:     "https://gerrit.example.com/c/project/+/1/1/file.go",
+			line:     3,
+			wantLink: "https://gerrit.example.com/c/project/+/1/1/file.go#3",
+		},
 		{
 			name: "Azure Repos link set",
 			input: &source_metadatapb.MetaData{
@@ -933,6 +1137,8 @@ func TestSetLink(t *testing.T) {
 				assert.Equal(t, tt.wantLink, data.Bitbucket.Link, "Bitbucket link mismatch")
 			case *source_metadatapb.MetaData_Filesystem:
 				assert.Equal(t, tt.wantLink, data.Filesystem.Link, "Filesystem link mismatch")
+			case *source_metadatapb.MetaData_Gerrit:
+				assert.Equal(t, tt.wantLink, data.Gerrit.Link, "Gerrit link mismatch")
 			case *source_metadatapb.MetaData_AzureRepos:
 				assert.Equal(t, tt.wantLink, data.AzureRepos.Link, "Azure Repos link mismatch")
 			}
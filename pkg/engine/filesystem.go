@@ -5,6 +5,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
@@ -15,9 +16,22 @@ import (
 // ScanFileSystem scans a given file system.
 func (e *Engine) ScanFileSystem(ctx context.Context, c sources.FilesystemConfig) (sources.JobProgressRef, error) {
 	connection := &sourcespb.Filesystem{
-		Paths:            c.Paths,
-		IncludePathsFile: c.IncludePathsFile,
-		ExcludePathsFile: c.ExcludePathsFile,
+		Paths:                   c.Paths,
+		IncludePathsFile:        c.IncludePathsFile,
+		ExcludePathsFile:        c.ExcludePathsFile,
+		RespectGitignore:        c.RespectGitignore,
+		StateFile:               c.StateFile,
+		RescanFilesWithFindings: c.RescanFilesWithFindings,
+		FollowSymlinks:          c.FollowSymlinks,
+		SymlinksWithinRoot:      c.SymlinksWithinRoot,
+		MaxFileSize:             c.MaxFileSize,
+		OneFileSystem:           c.OneFileSystem,
+	}
+	if !c.ModifiedSince.IsZero() {
+		connection.ModifiedSince = timestamppb.New(c.ModifiedSince)
+	}
+	if !c.ModifiedBefore.IsZero() {
+		connection.ModifiedBefore = timestamppb.New(c.ModifiedBefore)
 	}
 	var conn anypb.Any
 	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
@@ -33,5 +47,8 @@ func (e *Engine) ScanFileSystem(ctx context.Context, c sources.FilesystemConfig)
 	if err := fileSystemSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
 		return sources.JobProgressRef{}, err
 	}
+	if state := fileSystemSource.State(); state != nil {
+		e.filesystemState.Store(state)
+	}
 	return e.sourceManager.EnumerateAndScan(ctx, sourceName, fileSystemSource)
 }
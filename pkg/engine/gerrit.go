@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"runtime"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gerrit"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+// ScanGerrit scans a Gerrit server with the provided configuration.
+func (e *Engine) ScanGerrit(ctx context.Context, c sources.GerritConfig) (sources.JobProgressRef, error) {
+	scanOptions := git.NewScanOptions(git.ScanOptionFilter(c.Filter))
+
+	connection := &sourcespb.Gerrit{
+		Endpoint:         c.Endpoint,
+		Projects:         c.Projects,
+		RecentChangeDays: c.RecentChangeDays,
+		SkipComments:     c.SkipComments,
+		SkipBinaries:     c.SkipBinaries,
+	}
+	if len(c.Password) > 0 {
+		connection.Credential = &sourcespb.Gerrit_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{Username: c.Username, Password: c.Password},
+		}
+	} else {
+		connection.Credential = &sourcespb.Gerrit_Unauthenticated{}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal gerrit connection")
+		return sources.JobProgressRef{}, err
+	}
+
+	sourceName := "trufflehog - gerrit"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, gerrit.SourceType)
+
+	gerritSource := &gerrit.Source{}
+	if err := gerritSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
+		return sources.JobProgressRef{}, err
+	}
+	gerritSource.WithScanOptions(scanOptions)
+	return e.sourceManager.EnumerateAndScan(ctx, sourceName, gerritSource)
+}
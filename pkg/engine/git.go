@@ -28,6 +28,9 @@ func (e *Engine) ScanGit(ctx context.Context, c sources.GitConfig) (sources.JobP
 		NoCleanup:           c.NoCleanup,
 		PrintLegacyJson:     c.PrintLegacyJSON,
 		TrustLocalGitConfig: c.TrustLocalGitConfig,
+		ScanUnreachable:     c.ScanUnreachable,
+		TrackLifecycle:      c.TrackLifecycle,
+		Revisions:           c.Revisions,
 	}
 
 	var conn anypb.Any
@@ -39,6 +42,10 @@ func (e *Engine) ScanGit(ctx context.Context, c sources.GitConfig) (sources.JobP
 	sourceName := "trufflehog - git"
 	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, git.SourceType)
 
+	if c.TrackLifecycle {
+		e.trackGitLifecycle.Store(true)
+	}
+
 	gitSource := &git.Source{}
 	if err := gitSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
 		return sources.JobProgressRef{}, err
@@ -6,10 +6,13 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/decoders"
@@ -17,6 +20,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/engine/defaults"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/feature"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
 )
@@ -238,3 +242,87 @@ func BenchmarkGitEngine(b *testing.B) {
 	}
 	assert.Nil(b, e.Finish(ctx))
 }
+
+// resultCaptureDispatcher is a test dispatcher that captures the dispatched results.
+type resultCaptureDispatcher struct {
+	mu      sync.Mutex
+	results []detectors.ResultWithMetadata
+}
+
+func (d *resultCaptureDispatcher) Dispatch(_ context.Context, result detectors.ResultWithMetadata) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.results = append(d.results, result)
+	return nil
+}
+
+func TestGitEngineLifecycle(t *testing.T) {
+	repoPath := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"config", "user.name", "Test User"},
+		{"config", "user.email", "test@example.com"},
+	} {
+		out, err := exec.Command("git", append([]string{"-C", repoPath}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "config.txt"), []byte("token = lifecycle-secret\n"), 0o644))
+	for _, args := range [][]string{{"add", "config.txt"}, {"commit", "-q", "-m", "Add token"}} {
+		out, err := exec.Command("git", append([]string{"-C", repoPath}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	commit, err := exec.Command("git", "-C", repoPath, "rev-parse", "HEAD").Output()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dispatcher := new(resultCaptureDispatcher)
+	e, err := NewEngine(ctx, &Config{
+		Concurrency:   2,
+		Decoders:      decoders.DefaultDecoders(),
+		Detectors:     defaults.DefaultDetectors(),
+		SourceManager: sources.NewManager(),
+		Dispatcher:    dispatcher,
+	})
+	require.NoError(t, err)
+	e.Start(ctx)
+	e.trackGitLifecycle.Store(true)
+
+	gitResult := func(file string) detectors.ResultWithMetadata {
+		return detectors.ResultWithMetadata{
+			SourceType: git.SourceType,
+			SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Git{
+				Git: &source_metadatapb.Git{File: file, RepositoryLocalPath: repoPath},
+			}},
+			Result: detectors.Result{Raw: []byte("lifecycle-secret")},
+		}
+	}
+	e.results <- gitResult("config.txt")
+	e.results <- gitResult("copy.txt")
+	e.results <- detectors.ResultWithMetadata{
+		SourceType: sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM,
+		SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Filesystem{
+			Filesystem: &source_metadatapb.Filesystem{File: "config.txt"},
+		}},
+		Result: detectors.Result{Raw: []byte("lifecycle-secret")},
+	}
+
+	// Git results are held back until the scan is done, and Finish waits for their lifecycle lookups.
+	require.NoError(t, e.Finish(ctx))
+	require.Len(t, dispatcher.results, 3)
+	assert.Equal(t, sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM, dispatcher.results[0].SourceType)
+
+	want := &detectors.SecretLifecycle{
+		FirstSeenCommit: strings.TrimSpace(string(commit)),
+		FirstSeenAuthor: "Test User <test@example.com>",
+		PresentAtHead:   true,
+	}
+	for _, result := range dispatcher.results {
+		if result.SourceType == git.SourceType {
+			assert.Equal(t, want, result.Lifecycle)
+		} else {
+			assert.Nil(t, result.Lifecycle)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"fmt"
+	"runtime"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/jira"
+)
+
+// ScanJira scans the issues of a Jira site with the provided configuration.
+func (e *Engine) ScanJira(ctx context.Context, c sources.JiraConfig) (sources.JobProgressRef, error) {
+	connection := &sourcespb.JIRA{
+		Endpoint:              c.Endpoint,
+		Projects:              c.Projects,
+		IgnoreProjects:        c.IgnoreProjects,
+		Jql:                   c.JQL,
+		IncludeAttachments:    c.IncludeAttachments,
+		InsecureSkipVerifyTls: c.InsecureSkipVerifyTLS,
+	}
+	if !c.Since.IsZero() {
+		connection.Since = timestamppb.New(c.Since)
+	}
+
+	switch c.InstallationType {
+	case "", "autodetect":
+	case "cloud":
+		connection.InstallationType = sourcespb.JiraInstallationType_JIRA_INSTALLATION_TYPE_CLOUD
+	case "data-center":
+		connection.InstallationType = sourcespb.JiraInstallationType_JIRA_INSTALLATION_TYPE_DATA_CENTER
+	default:
+		return sources.JobProgressRef{}, fmt.Errorf("invalid installation type %q", c.InstallationType)
+	}
+
+	switch {
+	case len(c.Token) > 0:
+		connection.Credential = &sourcespb.JIRA_Token{Token: c.Token}
+	case len(c.Password) > 0:
+		connection.Credential = &sourcespb.JIRA_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{Username: c.Username, Password: c.Password},
+		}
+	default:
+		connection.Credential = &sourcespb.JIRA_Unauthenticated{}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal jira connection")
+		return sources.JobProgressRef{}, err
+	}
+
+	sourceName := "trufflehog - jira"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, jira.SourceType)
+
+	jiraSource := &jira.Source{}
+	if err := jiraSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
+		return sources.JobProgressRef{}, err
+	}
+	return e.sourceManager.EnumerateAndScan(ctx, sourceName, jiraSource)
+}
@@ -0,0 +1,41 @@
+package engine
+
+import (
+	"runtime"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/kubernetes"
+)
+
+// ScanKubernetes scans the objects of a Kubernetes cluster with the provided configuration.
+func (e *Engine) ScanKubernetes(ctx context.Context, c sources.KubernetesConfig) (sources.JobProgressRef, error) {
+	connection := &sourcespb.Kubernetes{
+		Kubeconfig:       c.Kubeconfig,
+		Context:          c.Context,
+		InCluster:        c.InCluster,
+		Namespaces:       c.Namespaces,
+		IgnoreNamespaces: c.IgnoreNamespaces,
+		IncludeSecrets:   c.IncludeSecrets,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal kubernetes connection")
+		return sources.JobProgressRef{}, err
+	}
+
+	sourceName := "trufflehog - kubernetes"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, kubernetes.SourceType)
+
+	kubernetesSource := &kubernetes.Source{}
+	if err := kubernetesSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
+		return sources.JobProgressRef{}, err
+	}
+	return e.sourceManager.EnumerateAndScan(ctx, sourceName, kubernetesSource)
+}
@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"runtime"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/npm"
+)
+
+// ScanNPM scans every published version of npm packages with the provided configuration.
+func (e *Engine) ScanNPM(ctx context.Context, c sources.NPMConfig) (sources.JobProgressRef, error) {
+	connection := &sourcespb.NPMUnauthenticatedPackage{
+		Credential: &sourcespb.NPMUnauthenticatedPackage_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		},
+		RegistryUrl: c.RegistryURL,
+		Packages:    c.Packages,
+		Scope:       c.Scope,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal npm connection")
+		return sources.JobProgressRef{}, err
+	}
+
+	sourceName := "trufflehog - npm"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, npm.SourceType)
+
+	npmSource := &npm.Source{}
+	if err := npmSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
+		return sources.JobProgressRef{}, err
+	}
+	return e.sourceManager.EnumerateAndScan(ctx, sourceName, npmSource)
+}
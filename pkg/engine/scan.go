@@ -4,6 +4,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/postman"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/webhook"
 )
 
 // ScanConfig starts a scan of all of the configured (but not initialized)
@@ -24,6 +25,11 @@ func (e *Engine) ScanConfig(ctx context.Context, configuredSources ...sources.Co
 		if postmanSource, ok := source.(*postman.Source); ok {
 			postmanSource.DetectorKeywords = e.AhoCorasickCoreKeywords()
 		}
+		// Webhook sources responding with findings need the engine to
+		// report the findings of each request.
+		if webhookSource, ok := source.(*webhook.Source); ok && webhookSource.Responses() != nil {
+			e.webhookResponses.Store(webhookSource.Responses())
+		}
 
 		// Start the scan.
 		ref, err := e.sourceManager.EnumerateAndScan(ctx, configuredSource.Name, source)
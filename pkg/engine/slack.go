@@ -0,0 +1,39 @@
+package engine
+
+import (
+	"runtime"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/slack"
+)
+
+// ScanSlackExport scans Slack workspace exports with the provided configuration.
+func (e *Engine) ScanSlackExport(ctx context.Context, c sources.SlackExportConfig) (sources.JobProgressRef, error) {
+	connection := &sourcespb.Slack{
+		Endpoint:    c.WorkspaceURL,
+		ExportPaths: c.Paths,
+		Channels:    c.Channels,
+		IgnoreList:  c.IgnoreChannels,
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal slack export connection")
+		return sources.JobProgressRef{}, err
+	}
+
+	sourceName := "trufflehog - slack export"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, slack.SourceType)
+
+	slackSource := &slack.Source{}
+	if err := slackSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
+		return sources.JobProgressRef{}, err
+	}
+	return e.sourceManager.EnumerateAndScan(ctx, sourceName, slackSource)
+}
@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"runtime"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/webhook"
+)
+
+// ScanWebhook listens for webhook requests and scans their bodies with the provided configuration, until the context
+// is cancelled.
+func (e *Engine) ScanWebhook(ctx context.Context, c sources.WebhookConfig) (sources.JobProgressRef, error) {
+	connection := &sourcespb.Webhook{
+		ListenAddress:       c.ListenAddress,
+		HmacSecret:          c.HMACSecret,
+		SignatureHeader:     c.SignatureHeader,
+		MetadataHeaders:     c.MetadataHeaders,
+		MaxBodySize:         c.MaxBodySize,
+		RespondWithFindings: c.RespondWithFindings,
+	}
+	if len(c.HeaderName) > 0 {
+		connection.Credential = &sourcespb.Webhook_Header{
+			Header: &credentialspb.Header{Key: c.HeaderName, Value: c.HeaderValue},
+		}
+	}
+	if c.ResponseTimeout > 0 {
+		connection.ResponseTimeout = durationpb.New(c.ResponseTimeout)
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal webhook connection")
+		return sources.JobProgressRef{}, err
+	}
+
+	sourceName := "trufflehog - webhook"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, webhook.SourceType)
+
+	webhookSource := &webhook.Source{}
+	if err := webhookSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
+		return sources.JobProgressRef{}, err
+	}
+	if responses := webhookSource.Responses(); responses != nil {
+		e.webhookResponses.Store(responses)
+	}
+	return e.sourceManager.EnumerateAndScan(ctx, sourceName, webhookSource)
+}
+
+// trackWebhookWork adds delta to the work in flight for the webhook request a chunk or result came from, if requests
+// are responded to with their findings, so the request can be responded to once all of its findings are dispatched.
+func (e *Engine) trackWebhookWork(sourceType sourcespb.SourceType, metadata *source_metadatapb.MetaData, delta int) {
+	if responses := e.webhookResponses.Load(); responses != nil && sourceType == webhook.SourceType {
+		responses.Track(metadata.GetWebhook().GetRequestId(), delta)
+	}
+}
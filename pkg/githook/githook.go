@@ -0,0 +1,234 @@
+// Package githook reads the input git passes to pre-receive, update and pre-push hooks and computes the commits a
+// push adds, so they can be scanned before the push is accepted.
+package githook
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// Hook is the name of a git hook.
+type Hook string
+
+const (
+	// PreReceive runs on the server before any ref of a push is updated. It reads "<old> <new> <ref>" lines from
+	// stdin, one for each pushed ref.
+	PreReceive Hook = "pre-receive"
+	// Update runs on the server before each ref of a push is updated, with the arguments "<ref> <old> <new>".
+	Update Hook = "update"
+	// PrePush runs on the client before a push, with the arguments "<remote> <url>". It reads
+	// "<local ref> <local hash> <remote ref> <remote hash>" lines from stdin, one for each pushed ref.
+	PrePush Hook = "pre-push"
+)
+
+// Hooks are the supported hooks.
+var Hooks = []Hook{PreReceive, Update, PrePush}
+
+// RefUpdate is the update of a ref by a push. Old is the zero hash if the push creates the ref, and New is the zero
+// hash if the push deletes it.
+type RefUpdate struct {
+	Ref string
+	Old string
+	New string
+}
+
+// Creates reports whether the update creates the ref.
+func (u RefUpdate) Creates() bool { return isZeroHash(u.Old) }
+
+// Deletes reports whether the update deletes the ref.
+func (u RefUpdate) Deletes() bool { return isZeroHash(u.New) }
+
+// Push is a push, as seen by a hook.
+type Push struct {
+	Hook Hook
+	// Remote is the name of the remote pushed to, or its URL if the push doesn't use a named remote. It's only set
+	// for pre-push hooks.
+	Remote  string
+	Updates []RefUpdate
+}
+
+// Read reads a push from the arguments and stdin of a hook.
+func Read(hook Hook, args []string, stdin io.Reader) (*Push, error) {
+	push := &Push{Hook: hook}
+	switch hook {
+	case PreReceive:
+		lines, err := readLines(stdin, 3)
+		if err != nil {
+			return nil, err
+		}
+		for _, fields := range lines {
+			push.Updates = append(push.Updates, RefUpdate{Ref: fields[2], Old: fields[0], New: fields[1]})
+		}
+	case Update:
+		if len(args) != 3 {
+			return nil, fmt.Errorf("update hook expects 3 arguments, got %d", len(args))
+		}
+		push.Updates = []RefUpdate{{Ref: args[0], Old: args[1], New: args[2]}}
+	case PrePush:
+		if len(args) < 1 {
+			return nil, errors.New("pre-push hook expects the remote as its first argument")
+		}
+		push.Remote = args[0]
+		lines, err := readLines(stdin, 4)
+		if err != nil {
+			return nil, err
+		}
+		for _, fields := range lines {
+			push.Updates = append(push.Updates, RefUpdate{Ref: fields[2], Old: fields[3], New: fields[1]})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported hook: %q", hook)
+	}
+	return push, nil
+}
+
+// readLines reads lines of n space separated fields, skipping blank lines.
+func readLines(r io.Reader, n int) ([][]string, error) {
+	var lines [][]string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != n {
+			return nil, fmt.Errorf("malformed hook input %q: expected %d fields", scanner.Text(), n)
+		}
+		lines = append(lines, fields)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading hook input: %w", err)
+	}
+	return lines, nil
+}
+
+// Refs returns the refs the push creates or updates.
+func (p *Push) Refs() []string {
+	var refs []string
+	for _, u := range p.Updates {
+		if !u.Deletes() {
+			refs = append(refs, u.Ref)
+		}
+	}
+	return refs
+}
+
+// Revisions returns the git log revision arguments selecting the commits the push adds to the repository at
+// repoPath, or nil if it adds none, as when it only deletes refs.
+//
+// On the server, the pushed commits are those not reachable from any existing ref; refs are only updated once the
+// hooks accept the push. On the client, they're those the remote doesn't have yet, as far as the client knows: the
+// commits not reachable from the remote's tracking refs or from the remote refs being updated. When pushing to a URL
+// rather than to a named remote, the tracking refs of every remote are used instead.
+func (p *Push) Revisions(ctx context.Context, repoPath string) ([]string, error) {
+	var revisions []string
+	seen := make(map[string]bool)
+	for _, u := range p.Updates {
+		if !u.Deletes() && !seen[u.New] {
+			seen[u.New] = true
+			revisions = append(revisions, u.New)
+		}
+	}
+	if len(revisions) == 0 {
+		return nil, nil
+	}
+
+	if p.Hook != PrePush {
+		return append(revisions, "--not", "--all"), nil
+	}
+	named, err := isRemote(ctx, repoPath, p.Remote)
+	if err != nil {
+		return nil, err
+	}
+	if named {
+		revisions = append(revisions, "--not", "--remotes="+p.Remote)
+	} else {
+		revisions = append(revisions, "--not", "--remotes")
+	}
+	for _, u := range p.Updates {
+		if u.Creates() || seen[u.Old] {
+			continue
+		}
+		seen[u.Old] = true
+		// The client may not have the remote's commit, if someone else pushed it.
+		ok, err := hasCommit(ctx, repoPath, u.Old)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			revisions = append(revisions, u.Old)
+		}
+	}
+	return revisions, nil
+}
+
+// RepoPath returns the path of the repository a hook runs in: its git directory if it's bare, as server repositories
+// usually are, or its working tree otherwise.
+func RepoPath(ctx context.Context) (path string, bare bool, err error) {
+	out, err := gitOutput(ctx, "", "rev-parse", "--is-bare-repository")
+	if err != nil {
+		return "", false, err
+	}
+	bare = strings.TrimSpace(string(out)) == "true"
+
+	arg := "--show-toplevel"
+	if bare {
+		arg = "--absolute-git-dir"
+	}
+	if out, err = gitOutput(ctx, "", "rev-parse", arg); err != nil {
+		return "", false, err
+	}
+	return strings.TrimSpace(string(out)), bare, nil
+}
+
+// isRemote reports whether name is one of the remotes of the repository at repoPath. The pre-push hook is given
+// the URL in place of the remote's name when pushing to a URL.
+func isRemote(ctx context.Context, repoPath, name string) (bool, error) {
+	out, err := gitOutput(ctx, repoPath, "remote")
+	if err != nil {
+		return false, err
+	}
+	for _, remote := range strings.Fields(string(out)) {
+		if remote == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// hasCommit reports whether the repository at repoPath has a commit.
+func hasCommit(ctx context.Context, repoPath, hash string) (bool, error) {
+	_, err := gitOutput(ctx, repoPath, "cat-file", "-e", hash+"^{commit}")
+	if exitErr := (*exec.ExitError)(nil); errors.As(err, &exitErr) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// gitOutput runs a git command in the repository at repoPath, or the current directory if it's empty, and returns
+// its output.
+func gitOutput(ctx context.Context, repoPath string, args ...string) ([]byte, error) {
+	if repoPath != "" {
+		args = append([]string{"-C", repoPath}, args...)
+	}
+	cmd := exec.CommandContext(ctx, "git", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("error running git %s: %w\n%s", strings.Join(args, " "), err, stderr.Bytes())
+	}
+	return out, nil
+}
+
+// isZeroHash reports whether a hash is git's zero hash, which stands for a missing ref.
+func isZeroHash(hash string) bool {
+	return strings.Trim(hash, "0") == ""
+}
@@ -0,0 +1,142 @@
+package githook
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const zero = "0000000000000000000000000000000000000000"
+
+func TestRead(t *testing.T) {
+	const (
+		a = "1111111111111111111111111111111111111111"
+		b = "2222222222222222222222222222222222222222"
+	)
+	tests := []struct {
+		name    string
+		hook    Hook
+		args    []string
+		stdin   string
+		want    *Push
+		wantErr bool
+	}{
+		{
+			name:  "pre-receive",
+			hook:  PreReceive,
+			stdin: a + " " + b + " refs/heads/main\n\n" + zero + " " + a + " refs/heads/new\n",
+			want: &Push{Hook: PreReceive, Updates: []RefUpdate{
+				{Ref: "refs/heads/main", Old: a, New: b},
+				{Ref: "refs/heads/new", Old: zero, New: a},
+			}},
+		},
+		{
+			name: "update",
+			hook: Update,
+			args: []string{"refs/heads/main", a, b},
+			want: &Push{Hook: Update, Updates: []RefUpdate{{Ref: "refs/heads/main", Old: a, New: b}}},
+		},
+		{
+			name:  "pre-push",
+			hook:  PrePush,
+			args:  []string{"origin", "git@example.com:repo.git"},
+			stdin: "refs/heads/main " + b + " refs/heads/main " + a + "\n(delete) " + zero + " refs/heads/old " + a + "\n",
+			want: &Push{Hook: PrePush, Remote: "origin", Updates: []RefUpdate{
+				{Ref: "refs/heads/main", Old: a, New: b},
+				{Ref: "refs/heads/old", Old: a, New: zero},
+			}},
+		},
+		{name: "malformed input", hook: PreReceive, stdin: a + " refs/heads/main\n", wantErr: true},
+		{name: "missing arguments", hook: Update, args: []string{"refs/heads/main"}, wantErr: true},
+		{name: "unsupported hook", hook: "post-receive", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Read(tt.hook, tt.args, strings.NewReader(tt.stdin))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPush_Revisions(t *testing.T) {
+	ctx := context.Background()
+	repoPath := t.TempDir()
+	git := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", repoPath}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	commit := func(content string) string {
+		t.Helper()
+		require.NoError(t, os.WriteFile(filepath.Join(repoPath, "file.txt"), []byte(content), 0644))
+		git("add", "file.txt")
+		git("commit", "-q", "-m", content)
+		return git("rev-parse", "HEAD")
+	}
+	git("init", "-q")
+	git("remote", "add", "origin", "git@example.com:repo.git")
+	first := commit("first")
+	git("update-ref", "refs/remotes/origin/main", first)
+	second := commit("second")
+	// A hash the repository doesn't have, like a commit someone else pushed to the remote.
+	missing := "3333333333333333333333333333333333333333"
+
+	t.Run("server", func(t *testing.T) {
+		push := &Push{Hook: PreReceive, Updates: []RefUpdate{
+			{Ref: "refs/heads/main", Old: first, New: second},
+			{Ref: "refs/heads/copy", Old: zero, New: second},
+			{Ref: "refs/heads/old", Old: first, New: zero},
+		}}
+		revisions, err := push.Revisions(ctx, repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, []string{second, "--not", "--all"}, revisions)
+		assert.Equal(t, []string{"refs/heads/main", "refs/heads/copy"}, push.Refs())
+	})
+
+	t.Run("client", func(t *testing.T) {
+		push := &Push{Hook: PrePush, Remote: "origin", Updates: []RefUpdate{
+			{Ref: "refs/heads/main", Old: first, New: second},
+			{Ref: "refs/heads/other", Old: missing, New: second},
+		}}
+		revisions, err := push.Revisions(ctx, repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, []string{second, "--not", "--remotes=origin", first}, revisions)
+
+		// The revisions select exactly the pushed commit.
+		assert.Equal(t, second, git(append([]string{"rev-list"}, revisions...)...))
+	})
+
+	t.Run("client pushing to a URL", func(t *testing.T) {
+		// Pushing a new branch to a URL rather than to a named remote only selects the commits no remote has.
+		push := &Push{Hook: PrePush, Remote: "git@example.com:fork.git", Updates: []RefUpdate{
+			{Ref: "refs/heads/feature", Old: zero, New: second},
+		}}
+		revisions, err := push.Revisions(ctx, repoPath)
+		require.NoError(t, err)
+		assert.Equal(t, []string{second, "--not", "--remotes"}, revisions)
+		assert.Equal(t, second, git(append([]string{"rev-list"}, revisions...)...))
+	})
+
+	t.Run("deletions only", func(t *testing.T) {
+		push := &Push{Hook: Update, Updates: []RefUpdate{{Ref: "refs/heads/main", Old: first, New: zero}}}
+		revisions, err := push.Revisions(ctx, repoPath)
+		require.NoError(t, err)
+		assert.Nil(t, revisions)
+	})
+}
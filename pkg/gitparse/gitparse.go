@@ -253,28 +253,65 @@ func (c *Parser) RepoPath(
 	}
 
 	cmd := exec.Command("git", args...)
-	absPath, err := filepath.Abs(source)
-	if err == nil {
-		if !isBare {
-			cmd.Env = append(cmd.Env, "GIT_DIR="+filepath.Join(absPath, ".git"))
-		} else {
-			cmd.Env = append(cmd.Env,
-				"GIT_DIR="+absPath,
-			)
-			// We need those variables to handle incoming commits
-			// while using trufflehog in pre-receive hooks
-			if dir := os.Getenv("GIT_OBJECT_DIRECTORY"); dir != "" {
-				cmd.Env = append(cmd.Env, "GIT_OBJECT_DIRECTORY="+dir)
-			}
-			if dir := os.Getenv("GIT_ALTERNATE_OBJECT_DIRECTORIES"); dir != "" {
-				cmd.Env = append(cmd.Env, "GIT_ALTERNATE_OBJECT_DIRECTORIES="+dir)
-			}
-		}
+	cmd.Env = gitDirEnv(source, isBare)
+
+	return c.executeCommand(ctx, cmd, false)
+}
+
+// Unreachable parses the output of the `git log` command for the commits of the `source` repository that can't be
+// reached from any branch, tag or other ref but the stash: every stash entry, the commits recorded only in reflogs,
+// and the given commits, typically the dangling commits reported by `git fsck`. Each commit's history is followed
+// until it joins the reachable history. Stash entries are merge commits, so merges are diffed against their first
+// parent to show the stashed changes.
+func (c *Parser) Unreachable(ctx context.Context, source string, commits []string, isBare bool) (chan *Diff, error) {
+	args := []string{
+		"-C", source,
+		"log",
+		"--patch",
+		"--full-history",
+		"--date=format:%a %b %d %H:%M:%S %Y %z",
+		"--pretty=fuller",
+		"--notes",
+		"--diff-merges=first-parent",
+		"--stdin", // The commits are read from stdin, as there may be too many for the command line.
+		"--reflog",
+		// Every ref is included and all but the stash, which clones fetching all refs hold as a remote ref, excluded,
+		// leaving the commits only the latest stash entry reaches.
+		"--all",
+		"--not",
+		"--exclude=refs/stash",
+		"--exclude=refs/remotes/*/stash",
+		"--all",
 	}
 
+	cmd := exec.Command("git", args...)
+	cmd.Env = gitDirEnv(source, isBare)
+	cmd.Stdin = strings.NewReader(strings.Join(commits, "\n") + "\n")
+
 	return c.executeCommand(ctx, cmd, false)
 }
 
+// gitDirEnv returns the environment for a git command run against the repository at `source`.
+func gitDirEnv(source string, isBare bool) []string {
+	absPath, err := filepath.Abs(source)
+	if err != nil {
+		return nil
+	}
+	env := []string{"GIT_DIR=" + absPath}
+	if !isBare {
+		env = []string{"GIT_DIR=" + filepath.Join(absPath, ".git")}
+	}
+	// We need those variables to handle incoming commits
+	// while using trufflehog in pre-receive hooks
+	if dir := os.Getenv("GIT_OBJECT_DIRECTORY"); dir != "" {
+		env = append(env, "GIT_OBJECT_DIRECTORY="+dir)
+	}
+	if dir := os.Getenv("GIT_ALTERNATE_OBJECT_DIRECTORIES"); dir != "" {
+		env = append(env, "GIT_ALTERNATE_OBJECT_DIRECTORIES="+dir)
+	}
+	return env
+}
+
 // Staged parses the output of the `git diff` command for the `source` path.
 func (c *Parser) Staged(ctx context.Context, source string) (chan *Diff, error) {
 	// Provide the --cached flag to diff to get the diff of the staged changes.
@@ -19,11 +19,16 @@ const (
 	providerGitlab    provider = "Gitlab"
 	providerBitbucket provider = "Bitbucket"
 	providerAzure     provider = "Azure"
+	providerGerrit    provider = "Gerrit"
 
 	urlGithub    = "github.com/"
 	urlGitlab    = "gitlab.com/"
 	urlBitbucket = "bitbucket.org/"
 	urlAzure     = "dev.azure.com/"
+	// pathAzure is in the path of Azure DevOps Server repositories, which are hosted on any domain.
+	pathAzure = "/_git/"
+	// pathGerrit is in the path of the links to Gerrit changes and Gitiles pages.
+	pathGerrit = "/+/"
 )
 
 func determineProvider(repo string) provider {
@@ -34,8 +39,10 @@ func determineProvider(repo string) provider {
 		return providerGitlab
 	case strings.Contains(repo, urlBitbucket):
 		return providerBitbucket
-	case strings.Contains(repo, urlAzure):
+	case strings.Contains(repo, urlAzure), strings.Contains(repo, pathAzure):
 		return providerAzure
+	case strings.Contains(repo, pathGerrit):
+		return providerGerrit
 	default:
 		return ""
 	}
@@ -227,6 +234,10 @@ func UpdateLinkLineNumber(ctx context.Context, link string, newLine int64) strin
 		query.Set("lineStartColumn", "1")
 		parsedURL.RawQuery = query.Encode()
 
+	case providerGerrit:
+		// For Gerrit, the fragment is the bare line number.
+		parsedURL.Fragment = strconv.FormatInt(newLine, 10)
+
 	case providerGithub, providerGitlab:
 		// If the provider name isn't one of the cloud defaults, it is probably an on-prem github or gitlab.
 		// So do the same thing.
@@ -171,6 +171,16 @@ func TestGenerateLink(t *testing.T) {
 			},
 			want: "https://dev.azure.com/org/project/_git/repo?version=GCabcdef",
 		},
+		{
+			name: "Azure DevOps Server link gen with line",
+			args: args{
+				repo:   "https://tfs.example.com/tfs/collection/project/_git/repo",
+				commit: "abcdef",
+				file:   "main.go",
+				line:   int64(20),
+			},
+			want: "https://tfs.example.com/tfs/collection/project/_git/repo?path=/main.go&version=GCabcdef&line=20&lineEnd=21&lineStartColumn=1",
+		},
 		{
 			name: "Unknown provider on-prem instance",
 			args: args{
@@ -293,6 +303,22 @@ func TestUpdateLinkLineNumber(t *testing.T) {
 			},
 			want: "https://dev.azure.com/org/project/_git/repo?line=40&lineEnd=41&lineStartColumn=1&path=%2Fmain.go&version=GCabcdef",
 		},
+		{
+			name: "Update Azure DevOps Server link with line",
+			args: args{
+				link:    "https://tfs.example.com/tfs/collection/project/_git/repo?path=/main.go&version=GCabcdef",
+				newLine: int64(40),
+			},
+			want: "https://tfs.example.com/tfs/collection/project/_git/repo?line=40&lineEnd=41&lineStartColumn=1&path=%2Fmain.go&version=GCabcdef",
+		},
+		{
+			name: "Update Gerrit link with line",
+			args: args{
+				link:    "https://gerrit.example.com/c/platform/build/+/1234/2/main.go#12",
+				newLine: int64(40),
+			},
+			want: "https://gerrit.example.com/c/platform/build/+/1234/2/main.go#40",
+		},
 		{
 			name: "Add line to github link without line",
 			args: args{
@@ -277,7 +277,7 @@ func (h *apkHandler) handleAPKFileContent(
 		return fmt.Errorf("failed to create mimeTypeReader for file %s: %w", fileName, err)
 	}
 	ctx = logContext.WithValues(
-		ctx,
+		withArchiveMember(ctx, fileName),
 		"filename", fileName,
 	)
 	return h.handleNonArchiveContent(ctx, mimeReader, apkChan)
@@ -93,7 +93,7 @@ func (h *arHandler) processARFiles(ctx logContext.Context, reader *deb.Ar, dataO
 			}
 
 			fileSize := arEntry.Size
-			fileCtx := logContext.WithValues(ctx, "filename", arEntry.Name, "size", fileSize)
+			fileCtx := logContext.WithValues(withArchiveMember(ctx, arEntry.Name), "filename", arEntry.Name, "size", fileSize)
 
 			rdr, err := newMimeTypeReader(arEntry.Data)
 			if err != nil {
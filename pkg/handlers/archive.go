@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/mholt/archives"
@@ -16,8 +18,10 @@ import (
 type ctxKey int
 
 const (
-	depthKey          ctxKey = iota
-	defaultBufferSize        = 512
+	depthKey ctxKey = iota
+	memberKey
+
+	defaultBufferSize = 512
 )
 
 var (
@@ -126,6 +130,13 @@ func (h *archiveHandler) openArchive(
 		return ErrMaxDepthReached
 	}
 
+	if depth > 0 {
+		// Nested documents, and JARs, which are also generic zip archives, are processed by their own handlers.
+		if ok, err := h.openDocument(ctx, depth, reader, dataOrErrChan); ok {
+			return err
+		}
+	}
+
 	if reader.format == nil {
 		if depth > 0 {
 			return h.handleNonArchiveContent(ctx, newMimeTypeReaderFromFileReader(reader), dataOrErrChan)
@@ -179,7 +190,7 @@ func (h *archiveHandler) extractorHandler(dataOrErrChan chan DataOrErr) func(con
 		}
 
 		lCtx := logContext.WithValues(
-			logContext.AddLogger(ctx),
+			withArchiveMember(logContext.AddLogger(ctx), file.NameInArchive),
 			"filename", file.Name(),
 			"size", file.Size(),
 		)
@@ -246,3 +257,20 @@ func (h *archiveHandler) extractorHandler(dataOrErrChan chan DataOrErr) func(con
 		return h.openArchive(lCtx, depth, rdr, dataOrErrChan)
 	}
 }
+
+// withArchiveMember returns a context recording that content read under it comes from the named archive member.
+// Members of nested archives are recorded as the path of each enclosing member joined by "/", such as
+// "app.tar.gz/app/config.yaml" for a file in a tarball that is itself in the scanned archive.
+func withArchiveMember(ctx logContext.Context, name string) logContext.Context {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if parent := archiveMember(ctx); parent != "" {
+		name = parent + "/" + name
+	}
+	return logContext.WithValue(ctx, memberKey, name)
+}
+
+// archiveMember returns the archive member recorded in the context by withArchiveMember, if any.
+func archiveMember(ctx context.Context) string {
+	member, _ := ctx.Value(memberKey).(string)
+	return member
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/feature"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 )
 
@@ -106,6 +108,10 @@ func (h *defaultHandler) handleNonArchiveContent(
 		return nil
 	}
 
+	// Content extracted from an archive records the member it came from and where in the member each chunk starts.
+	member := archiveMember(ctx)
+	var line, offset int64 = 1, 0
+
 	chunkReader := sources.NewChunkReader()
 	for data := range chunkReader(ctx, reader) {
 		dataOrErr := DataOrErr{}
@@ -119,6 +125,14 @@ func (h *defaultHandler) handleNonArchiveContent(
 		}
 
 		dataOrErr.Data = data.Bytes()
+		if member != "" {
+			dataOrErr.Member, dataOrErr.MemberLine, dataOrErr.MemberOffset = member, line, offset
+
+			// The next chunk starts where this one's peek into the following data begins.
+			advance := data.Bytes()[:min(len(data.Bytes()), sources.DefaultChunkSize)]
+			line += int64(bytes.Count(advance, []byte("\n")))
+			offset += int64(len(advance))
+		}
 		if err := common.CancellableWrite(ctx, dataOrErrChan, dataOrErr); err != nil {
 			return err
 		}
@@ -126,3 +140,33 @@ func (h *defaultHandler) handleNonArchiveContent(
 	}
 	return nil
 }
+
+// handleEmbeddedFile processes a file embedded in a document, such as a PDF attachment or an object embedded in an
+// office document. The file is processed at the given depth like an archive member, so nested documents and
+// archives are extracted recursively and share the archive depth limit.
+func (h *defaultHandler) handleEmbeddedFile(
+	ctx logContext.Context,
+	depth int,
+	data []byte,
+	dataOrErrChan chan DataOrErr,
+) error {
+	rdr, err := newFileReader(ctx, bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, ErrEmptyReader) {
+			ctx.Logger().V(5).Info("empty reader, skipping embedded file")
+			return nil
+		}
+		return fmt.Errorf("error creating reader for embedded file: %w", err)
+	}
+	defer rdr.Close()
+
+	if rdr.isGenericArchive && feature.ForceSkipArchives.Load() {
+		return nil
+	}
+
+	h.metrics.incFilesProcessed()
+	h.metrics.observeFileSize(int64(len(data)))
+
+	archive := &archiveHandler{defaultHandler: h}
+	return archive.openArchive(ctx, depth, rdr, dataOrErrChan)
+}
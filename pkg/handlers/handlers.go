@@ -3,6 +3,7 @@ package handlers
 import (
 	"archive/zip"
 	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -11,7 +12,9 @@ import (
 
 	"github.com/gabriel-vasile/mimetype"
 	"github.com/mholt/archives"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/feature"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/iobuf"
@@ -135,6 +138,22 @@ func newFileReader(ctx context.Context, r io.Reader, options ...readerOption) (f
 		return fReader, fmt.Errorf("error resetting reader after MIME detection: %w", err)
 	}
 
+	// Check for Jupyter notebooks, which are otherwise detected as JSON or plain text.
+	if shouldHandleAsNotebook(cfg, fReader) {
+		fReader.mime = notebookMIME()
+		return fReader, nil
+	}
+
+	// Check for Terraform state and plan files, which are otherwise detected as JSON or plain text.
+	isTerraform, err := isTerraformFile(cfg, &fReader)
+	if err != nil {
+		return fReader, fmt.Errorf("error checking for Terraform file: %w", err)
+	}
+	if isTerraform {
+		fReader.mime = terraformMIME()
+		return fReader, nil
+	}
+
 	// Check for APK files
 	if shouldHandleAsAPK(cfg, fReader) {
 		isAPK, err := isAPKFile(&fReader)
@@ -184,6 +203,24 @@ func newFileReader(ctx context.Context, r io.Reader, options ...readerOption) (f
 type DataOrErr struct {
 	Data []byte
 	Err  error
+
+	// Cell and CellLine locate Data within a Jupyter notebook: the 1-based index of the cell it was read from and the
+	// line of the cell it starts on. Both are zero for data that does not come from a notebook cell.
+	Cell     int64
+	CellLine int64
+
+	// Resource is the address of the Terraform resource, output or variable Data holds the values of, and
+	// ResourceLine the line of the resource's values it starts on. Both are zero for data that does not come from a
+	// Terraform state or plan.
+	Resource     string
+	ResourceLine int64
+
+	// Member is the path of the archive member Data was read from, with the members of nested archives joined by "/".
+	// MemberLine and MemberOffset are the 1-based line and the byte offset within the member that Data starts at.
+	// All are zero for data that does not come from an archive.
+	Member       string
+	MemberLine   int64
+	MemberOffset int64
 }
 
 // FileHandler represents a handler for files.
@@ -216,12 +253,20 @@ func WithSkipArchives(skip bool) func(*fileHandlingConfig) {
 type handlerType string
 
 const (
-	archiveHandlerType handlerType = "archive"
-	arHandlerType      handlerType = "ar"
-	rpmHandlerType     handlerType = "rpm"
-	apkHandlerType     handlerType = "apk"
-	defaultHandlerType handlerType = "default"
-	apkExt                         = ".apk"
+	archiveHandlerType   handlerType = "archive"
+	arHandlerType        handlerType = "ar"
+	rpmHandlerType       handlerType = "rpm"
+	apkHandlerType       handlerType = "apk"
+	pdfHandlerType       handlerType = "pdf"
+	officeHandlerType    handlerType = "office"
+	sqliteHandlerType    handlerType = "sqlite"
+	notebookHandlerType  handlerType = "notebook"
+	terraformHandlerType handlerType = "terraform"
+	javaHandlerType      handlerType = "java"
+	defaultHandlerType   handlerType = "default"
+	apkExt                           = ".apk"
+	notebookExt                      = ".ipynb"
+	terraformStateExt                = ".tfstate"
 )
 
 type mimeType string
@@ -261,6 +306,15 @@ const (
 	jarMime      mimeType = "application/java-archive"
 	msgMime      mimeType = "application/vnd.ms-outlook"
 	docMime      mimeType = "application/msword"
+	pdfMime      mimeType = "application/pdf"
+	docxMime     mimeType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	xlsxMime     mimeType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	pptxMime     mimeType = "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+	oleMime      mimeType = "application/x-ole-storage"
+	sqliteMime   mimeType = "application/vnd.sqlite3"
+	ipynbMime    mimeType = "application/x-ipynb+json"
+	tfstateMime  mimeType = "application/x-terraform+json"
+	classMime    mimeType = "application/x-java-applet"
 )
 
 // skipArchiverMimeTypes is a set of MIME types that should bypass archiver library processing because they are either
@@ -298,6 +352,14 @@ var skipArchiverMimeTypes = map[mimeType]struct{}{
 	apkMime:      {},
 	msgMime:      {},
 	docMime:      {},
+	pdfMime:      {},
+	docxMime:     {},
+	xlsxMime:     {},
+	pptxMime:     {},
+	oleMime:      {},
+	sqliteMime:   {},
+	ipynbMime:    {},
+	classMime:    {},
 }
 
 // selectHandler dynamically selects and configures a FileHandler based on the provided |mimetype| type and archive flag.
@@ -306,6 +368,13 @@ var skipArchiverMimeTypes = map[mimeType]struct{}{
 // - arHandler is used for Unix archives and Debian packages ('arMime', 'unixArMime', and 'debMime').
 // - rpmHandler is used for RPM and CPIO archives ('rpmMime' and 'cpioMime').
 // - apkHandler is used for APK archives ('apkMime').
+// - pdfHandler is used for PDF documents ('pdfMime').
+// - officeHandler is used for Office documents and Outlook messages ('docxMime', 'xlsxMime', 'pptxMime', 'docMime',
+// 'msgMime' and 'oleMime').
+// - sqliteHandler is used for SQLite databases ('sqliteMime').
+// - notebookHandler is used for Jupyter notebooks ('ipynbMime').
+// - terraformHandler is used for Terraform state and plan files ('tfstateMime').
+// - javaHandler is used for JAR archives and Java class files ('jarMime' and 'classMime').
 // - archiveHandler is used for common archive formats supported by the archiver library (.zip, .tar, .gz, etc.).
 // - defaultHandler is used for non-archive files.
 // The selected handler is then returned, ready to handle the file according to its specific format and requirements.
@@ -317,6 +386,18 @@ func selectHandler(mimeT mimeType, isGenericArchive bool) FileHandler {
 		return newRPMHandler()
 	case apkMime:
 		return newAPKHandler()
+	case pdfMime:
+		return newPDFHandler()
+	case docxMime, xlsxMime, pptxMime, docMime, msgMime, oleMime:
+		return newOfficeHandler()
+	case sqliteMime:
+		return newSQLiteHandler()
+	case ipynbMime:
+		return newNotebookHandler()
+	case tfstateMime:
+		return newTerraformHandler()
+	case jarMime, classMime:
+		return newJavaHandler()
 	default:
 		if isGenericArchive {
 			return newArchiveHandler()
@@ -325,6 +406,70 @@ func selectHandler(mimeT mimeType, isGenericArchive bool) FileHandler {
 	}
 }
 
+// openDocument processes documents nested in archives or other documents with the handler selectHandler would
+// use for them at the top level, so that their content is extracted rather than scanned as raw bytes.
+// It reports whether the reader held such a document.
+func (h *defaultHandler) openDocument(
+	ctx logContext.Context,
+	depth int,
+	reader fileReader,
+	dataOrErrChan chan DataOrErr,
+) (bool, error) {
+	switch mimeType(reader.mime.String()) {
+	case pdfMime:
+		pdf := &pdfHandler{defaultHandler: h}
+		return true, pdf.processPDF(ctx, depth, reader, dataOrErrChan)
+	case docxMime, xlsxMime, pptxMime, docMime, msgMime, oleMime:
+		office := &officeHandler{defaultHandler: h}
+		return true, office.processOffice(ctx, depth, reader, dataOrErrChan)
+	case sqliteMime:
+		sqlite := &sqliteHandler{defaultHandler: h}
+		return true, sqlite.processSQLite(ctx, depth, reader, dataOrErrChan)
+	case jarMime:
+		java := &javaHandler{defaultHandler: h}
+		return true, java.processJAR(ctx, depth, reader, dataOrErrChan)
+	case classMime:
+		java := &javaHandler{defaultHandler: h}
+		return true, java.processClass(ctx, depth, reader, dataOrErrChan)
+	default:
+		return false, nil
+	}
+}
+
+// handleLocatedText sends text pulled out of a file, such as a notebook cell or the values of a Terraform resource,
+// in chunks. setLocation records on each chunk where it was read from, given the line of the text it starts on.
+func (h *defaultHandler) handleLocatedText(
+	ctx logContext.Context,
+	text []byte,
+	dataOrErrChan chan DataOrErr,
+	setLocation func(dataOrErr *DataOrErr, line int64),
+) error {
+	line := int64(1)
+	chunkReader := sources.NewChunkReader()
+	for data := range chunkReader(ctx, bytes.NewReader(text)) {
+		if err := data.Error(); err != nil {
+			h.metrics.incErrors()
+			dataOrErr := DataOrErr{Err: fmt.Errorf("%w: error reading chunk: %v", ErrProcessingWarning, err)}
+			if writeErr := common.CancellableWrite(ctx, dataOrErrChan, dataOrErr); writeErr != nil {
+				return fmt.Errorf("%w: error writing to data channel: %v", ErrProcessingFatal, writeErr)
+			}
+			continue
+		}
+
+		chunk := data.Bytes()
+		dataOrErr := DataOrErr{Data: chunk}
+		setLocation(&dataOrErr, line)
+		if err := common.CancellableWrite(ctx, dataOrErrChan, dataOrErr); err != nil {
+			return err
+		}
+		h.metrics.incBytesProcessed(len(chunk))
+
+		// The next chunk starts where this one's peek into the following data begins.
+		line += int64(bytes.Count(chunk[:min(len(chunk), sources.DefaultChunkSize)], []byte("\n")))
+	}
+	return nil
+}
+
 // HandleFile orchestrates the complete file handling process for a given file.
 // It determines the MIME type of the file,
 // selects the appropriate handler based on this type, and processes the file.
@@ -427,6 +572,7 @@ func handleChunksWithError(
 			if len(dataOrErr.Data) > 0 {
 				chunk := *chunkSkel
 				chunk.Data = dataOrErr.Data
+				setChunkLocation(&chunk, dataOrErr)
 				if err := reporter.ChunkOk(ctx, chunk); err != nil {
 					return fmt.Errorf("error reporting chunk: %w", err)
 				}
@@ -533,6 +679,50 @@ func getFileExtension(chunkSkel *sources.Chunk) string {
 	return ext
 }
 
+// setChunkLocation records where within the file a chunk was read from in the chunk's metadata: the notebook cell
+// and the line of the cell the chunk starts on, the Terraform resource and the line of its values the chunk starts
+// on, and the archive member and the line and offset of the member the chunk starts on. The metadata is copied since
+// the chunk skeleton, and its metadata, is shared by all chunks of the file. Only filesystem and git metadata can
+// carry a cell, filesystem, git, S3 and GCS metadata can carry a Terraform resource, and filesystem, git, S3, GCS,
+// Docker, npm and PyPI metadata can carry an archive member.
+func setChunkLocation(chunk *sources.Chunk, dataOrErr DataOrErr) {
+	if dataOrErr.Cell == 0 && dataOrErr.Resource == "" && dataOrErr.Member == "" {
+		return
+	}
+
+	metadata, ok := proto.Clone(chunk.SourceMetadata).(*source_metadatapb.MetaData)
+	if !ok {
+		return
+	}
+
+	member, line, offset := dataOrErr.Member, dataOrErr.MemberLine, dataOrErr.MemberOffset
+	switch m := metadata.GetData().(type) {
+	case *source_metadatapb.MetaData_Filesystem:
+		m.Filesystem.Cell, m.Filesystem.CellLine = dataOrErr.Cell, dataOrErr.CellLine
+		m.Filesystem.TerraformResource, m.Filesystem.TerraformResourceLine = dataOrErr.Resource, dataOrErr.ResourceLine
+		m.Filesystem.ArchiveMember, m.Filesystem.ArchiveMemberLine, m.Filesystem.ArchiveMemberOffset = member, line, offset
+	case *source_metadatapb.MetaData_Git:
+		m.Git.Cell, m.Git.CellLine = dataOrErr.Cell, dataOrErr.CellLine
+		m.Git.TerraformResource, m.Git.TerraformResourceLine = dataOrErr.Resource, dataOrErr.ResourceLine
+		m.Git.ArchiveMember, m.Git.ArchiveMemberLine, m.Git.ArchiveMemberOffset = member, line, offset
+	case *source_metadatapb.MetaData_S3:
+		m.S3.TerraformResource, m.S3.TerraformResourceLine = dataOrErr.Resource, dataOrErr.ResourceLine
+		m.S3.ArchiveMember, m.S3.ArchiveMemberLine, m.S3.ArchiveMemberOffset = member, line, offset
+	case *source_metadatapb.MetaData_Gcs:
+		m.Gcs.TerraformResource, m.Gcs.TerraformResourceLine = dataOrErr.Resource, dataOrErr.ResourceLine
+		m.Gcs.ArchiveMember, m.Gcs.ArchiveMemberLine, m.Gcs.ArchiveMemberOffset = member, line, offset
+	case *source_metadatapb.MetaData_Docker:
+		m.Docker.ArchiveMember, m.Docker.ArchiveMemberLine, m.Docker.ArchiveMemberOffset = member, line, offset
+	case *source_metadatapb.MetaData_Npm:
+		m.Npm.ArchiveMember, m.Npm.ArchiveMemberLine, m.Npm.ArchiveMemberOffset = member, line, offset
+	case *source_metadatapb.MetaData_Pypi:
+		m.Pypi.ArchiveMember, m.Pypi.ArchiveMemberLine, m.Pypi.ArchiveMemberOffset = member, line, offset
+	default:
+		return
+	}
+	chunk.SourceMetadata = metadata
+}
+
 // shouldHandleAsAPK checks if the file should be handled as an APK based on config and MIME type.
 // Note: We can't extend the mimetype package with an APK detection function b/c it would require adjusting settings
 // so that all files are fully read into a byte slice for detection (mimetype.SetLimit(0)), which would bloat memory.
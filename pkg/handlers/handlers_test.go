@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"archive/tar"
 	"archive/zip"
 	"bytes"
 	stdctx "context"
@@ -21,6 +22,7 @@ import (
 	diskbufferreader "github.com/trufflesecurity/disk-buffer-reader"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 )
 
@@ -296,8 +298,9 @@ func TestHandleFileAR(t *testing.T) {
 }
 
 func TestHandleFileMSG(t *testing.T) {
-	wantChunkCount := 5
-	reporter := sources.ChanReporter{Ch: make(chan *sources.Chunk, wantChunkCount)}
+	wantChunkCount := 2
+	chunkCh := make(chan *sources.Chunk, wantChunkCount)
+	reporter := sources.ChanReporter{Ch: chunkCh}
 
 	file, err := os.Open("testdata/test.msg")
 	require.NoError(t, err)
@@ -305,11 +308,24 @@ func TestHandleFileMSG(t *testing.T) {
 	assert.Empty(t, reporter.Ch)
 	assert.NoError(t, HandleFile(context.Background(), file, &sources.Chunk{}, reporter))
 	assert.Equal(t, wantChunkCount, len(reporter.Ch))
+
+	// The chunks hold the message's properties and bodies as text rather than the raw bytes of the compound file.
+	close(chunkCh)
+	var text strings.Builder
+	for chunk := range chunkCh {
+		text.Write(chunk.Data)
+	}
+	assert.Contains(t, text.String(), "Subject: test data\n")
+	assert.Contains(t, text.String(), "DisplayTo: example@gmail.com\n")
+	assert.Contains(t, text.String(), "SenderName: Jordan Tunstill\n")
+	assert.Contains(t, text.String(), "Lorem ipsum dolor sit amet")
+	assert.Contains(t, text.String(), "jttunstill@outlook.com")
 }
 
 func TestHandleFileDOC(t *testing.T) {
-	wantChunkCount := 3
-	reporter := sources.ChanReporter{Ch: make(chan *sources.Chunk, wantChunkCount)}
+	wantChunkCount := 2
+	chunkCh := make(chan *sources.Chunk, wantChunkCount)
+	reporter := sources.ChanReporter{Ch: chunkCh}
 
 	file, err := os.Open("testdata/test.doc")
 	require.NoError(t, err)
@@ -317,6 +333,17 @@ func TestHandleFileDOC(t *testing.T) {
 	assert.Empty(t, reporter.Ch)
 	assert.NoError(t, HandleFile(context.Background(), file, &sources.Chunk{}, reporter))
 	assert.Equal(t, wantChunkCount, len(reporter.Ch))
+
+	// The chunks hold the document's text and properties as text rather than the raw bytes of the compound file.
+	close(chunkCh)
+	var text strings.Builder
+	for chunk := range chunkCh {
+		text.Write(chunk.Data)
+	}
+	assert.Contains(t, text.String(), "Lorem ipsum dolor sit amet, consectetur adipiscing elit")
+	assert.Contains(t, text.String(), "id est laborum\n\nLorem ipsum")
+	assert.Contains(t, text.String(), "Author: jordan tunstill\n")
+	assert.Contains(t, text.String(), "ApplicationName: Microsoft Office Word\n")
 }
 
 func BenchmarkHandleAR(b *testing.B) {
@@ -884,3 +911,57 @@ func TestHandleChunksWithError(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleFileArchiveMember(t *testing.T) {
+	var config strings.Builder
+	for i := 1; i <= 2000; i++ {
+		fmt.Fprintf(&config, "setting_%d: value\n", i)
+	}
+	config.WriteString("password: Sup3rS3cret\n")
+
+	var inner bytes.Buffer
+	zw := zip.NewWriter(&inner)
+	w, err := zw.Create("./app/config.yaml")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(config.String()))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	var outer bytes.Buffer
+	tw := tar.NewWriter(&outer)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "inner.zip", Mode: 0o600, Size: int64(inner.Len())}))
+	_, err = tw.Write(inner.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	chunkSkel := &sources.Chunk{
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Filesystem{
+				Filesystem: &source_metadatapb.Filesystem{File: "release.tar"},
+			},
+		},
+	}
+	chunkCh := make(chan *sources.Chunk, 16)
+	reporter := sources.ChanReporter{Ch: chunkCh}
+	require.NoError(t, HandleFile(context.Background(), &outer, chunkSkel, reporter))
+	close(chunkCh)
+
+	data := []byte(config.String())
+	var chunks int
+	for chunk := range chunkCh {
+		fs := chunk.SourceMetadata.GetFilesystem()
+		require.NotNil(t, fs)
+		assert.Equal(t, "release.tar", fs.GetFile())
+		assert.Equal(t, "inner.zip/app/config.yaml", fs.GetArchiveMember())
+
+		// Each chunk records the line and offset of the member it starts at.
+		offset := fs.GetArchiveMemberOffset()
+		assert.Equal(t, int64(chunks*sources.DefaultChunkSize), offset)
+		assert.Equal(t, int64(bytes.Count(data[:offset], []byte("\n"))+1), fs.GetArchiveMemberLine())
+		assert.True(t, bytes.HasPrefix(data[offset:], chunk.Data))
+		chunks++
+	}
+	assert.Greater(t, chunks, 2)
+	// The shared skeleton must not be modified.
+	assert.Empty(t, chunkSkel.SourceMetadata.GetFilesystem().GetArchiveMember())
+}
@@ -0,0 +1,433 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/feature"
+)
+
+// javaHandler handles Java class files and JAR archives. Class files are binary, so scanned as is their string
+// constants are missed or mangled. Instead the handler parses the constant pool of each class and scans its strings,
+// one per line. This covers string literals, including the values of static final fields, along with the names of
+// the fields and methods they are used next to.
+//
+// JARs, including WARs, EARs and fat JARs bundling their dependencies as nested JARs, are walked entry by entry.
+// Classes are parsed, manifests have their folded lines joined and properties files have their escapes decoded, so
+// values split or escaped by those formats are scanned whole. All other entries, including nested JARs, are processed
+// like the members of any other archive.
+type javaHandler struct{ *defaultHandler }
+
+// newJavaHandler creates a javaHandler.
+func newJavaHandler() *javaHandler {
+	return &javaHandler{defaultHandler: newDefaultHandler(javaHandlerType)}
+}
+
+// HandleFile processes Java class files and JAR archives and returns a channel of DataOrErr.
+//
+// Fatal errors that will terminate processing include:
+// - Context cancellation
+// - Context deadline exceeded
+// - Maximum archive depth exceeded
+// - Errors reading the file or the JAR directory
+// - Invalid class files
+// - Panics during processing (recovered and returned as fatal errors)
+//
+// Non-fatal errors that will be logged but allow processing to continue include:
+// - Errors processing individual JAR entries
+func (h *javaHandler) HandleFile(ctx logContext.Context, input fileReader) chan DataOrErr {
+	dataOrErrChan := make(chan DataOrErr, defaultBufferSize)
+
+	if mimeType(input.mime.String()) == jarMime && feature.ForceSkipArchives.Load() {
+		close(dataOrErrChan)
+		return dataOrErrChan
+	}
+
+	go func() {
+		defer close(dataOrErrChan)
+
+		// Defer a panic recovery to handle any panics that occur while parsing malformed classes.
+		defer func() {
+			if r := recover(); r != nil {
+				var panicErr error
+				if e, ok := r.(error); ok {
+					panicErr = e
+				} else {
+					panicErr = fmt.Errorf("panic occurred: %v", r)
+				}
+				dataOrErrChan <- DataOrErr{
+					Err: fmt.Errorf("%w: panic error: %v", ErrProcessingFatal, panicErr),
+				}
+			}
+		}()
+
+		start := time.Now()
+		var err error
+		if mimeType(input.mime.String()) == classMime {
+			err = h.processClass(ctx, 0, input, dataOrErrChan)
+		} else {
+			err = h.processJAR(ctx, 0, input, dataOrErrChan)
+		}
+		if err == nil {
+			h.metrics.incFilesProcessed()
+		}
+
+		// Update the metrics for the file processing and handle any errors.
+		h.measureLatencyAndHandleErrors(ctx, start, err, dataOrErrChan)
+	}()
+
+	return dataOrErrChan
+}
+
+// processJAR walks the entries of a JAR and sends their content to the provided channel.
+func (h *javaHandler) processJAR(
+	ctx logContext.Context,
+	depth int,
+	input fileReader,
+	dataOrErrChan chan DataOrErr,
+) error {
+	if common.IsDone(ctx) {
+		return ctx.Err()
+	}
+
+	if depth >= maxDepth {
+		h.metrics.incMaxArchiveDepthCount()
+		return ErrMaxDepthReached
+	}
+
+	zipReader, err := createZipReader(input)
+	if err != nil {
+		return fmt.Errorf("error reading jar: %w", err)
+	}
+
+	for _, file := range zipReader.File {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		lCtx := logContext.WithValues(withArchiveMember(ctx, file.Name), "filename", file.Name, "size", file.UncompressedSize64)
+		if err := h.processJAREntry(lCtx, depth+1, file, dataOrErrChan); err != nil {
+			if isFatal(err) || errors.Is(err, ErrMaxDepthReached) {
+				return err
+			}
+			h.metrics.incErrors()
+			warn := DataOrErr{Err: fmt.Errorf("%w: error processing jar entry %s: %v", ErrProcessingWarning, file.Name, err)}
+			if writeErr := common.CancellableWrite(lCtx, dataOrErrChan, warn); writeErr != nil {
+				return writeErr
+			}
+		}
+	}
+	return nil
+}
+
+// processJAREntry processes a single entry of a JAR.
+func (h *javaHandler) processJAREntry(
+	ctx logContext.Context,
+	depth int,
+	file *zip.File,
+	dataOrErrChan chan DataOrErr,
+) error {
+	if file.FileInfo().IsDir() || file.UncompressedSize64 == 0 {
+		return nil
+	}
+
+	if file.UncompressedSize64 > uint64(maxSize) {
+		ctx.Logger().V(2).Info("skipping file: size exceeds max allowed", "limit", maxSize)
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+
+	if common.SkipFile(file.Name) {
+		ctx.Logger().V(4).Info("skipping file: extension is ignored")
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("error opening file: %w", err)
+	}
+	defer f.Close()
+
+	h.metrics.observeFileSize(int64(file.UncompressedSize64))
+
+	name := strings.ToLower(file.Name)
+	switch {
+	case name == "meta-inf/manifest.mf":
+		return h.handleText(ctx, unfoldManifest(f), dataOrErrChan)
+	case path.Ext(name) == ".properties":
+		return h.handleText(ctx, decodeProperties(f), dataOrErrChan)
+	case path.Ext(name) == ".class":
+		return h.processClass(ctx, depth, f, dataOrErrChan)
+	}
+
+	rdr, err := newFileReader(ctx, f)
+	if err != nil {
+		if errors.Is(err, ErrEmptyReader) {
+			ctx.Logger().V(5).Info("empty reader, skipping file")
+			return nil
+		}
+		return fmt.Errorf("error creating reader: %w", err)
+	}
+	defer rdr.Close()
+
+	if rdr.isGenericArchive && feature.ForceSkipArchives.Load() {
+		return nil
+	}
+
+	h.metrics.incFilesProcessed()
+	archive := &archiveHandler{defaultHandler: h.defaultHandler}
+	return archive.openArchive(ctx, depth, rdr, dataOrErrChan)
+}
+
+// processClass sends the strings of a class file's constant pool to the provided channel.
+func (h *javaHandler) processClass(
+	ctx logContext.Context,
+	depth int,
+	reader io.Reader,
+	dataOrErrChan chan DataOrErr,
+) error {
+	if common.IsDone(ctx) {
+		return ctx.Err()
+	}
+
+	if depth >= maxDepth {
+		h.metrics.incMaxArchiveDepthCount()
+		return ErrMaxDepthReached
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, int64(maxSize)+1))
+	if err != nil {
+		return fmt.Errorf("error reading class: %w", err)
+	}
+	if len(data) > maxSize {
+		ctx.Logger().V(2).Info("skipping class: size exceeds max allowed", "limit", maxSize)
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+
+	constants, err := parseClassConstants(data)
+	if err != nil {
+		return err
+	}
+
+	var text bytes.Buffer
+	for _, c := range constants {
+		text.WriteString(c)
+		text.WriteByte('\n')
+	}
+	return h.handleText(ctx, &text, dataOrErrChan)
+}
+
+// handleText sends extracted text to the provided channel.
+func (h *javaHandler) handleText(ctx logContext.Context, text io.Reader, dataOrErrChan chan DataOrErr) error {
+	rdr := mimeTypeReader{mimeExt: ".txt", mimeName: textMime, Reader: text}
+	return h.handleNonArchiveContent(ctx, rdr, dataOrErrChan)
+}
+
+// Constant pool tags, see https://docs.oracle.com/javase/specs/jvms/se21/html/jvms-4.html#jvms-4.4.
+const (
+	classConstantUtf8               = 1
+	classConstantInteger            = 3
+	classConstantFloat              = 4
+	classConstantLong               = 5
+	classConstantDouble             = 6
+	classConstantClass              = 7
+	classConstantString             = 8
+	classConstantFieldref           = 9
+	classConstantMethodref          = 10
+	classConstantInterfaceMethodref = 11
+	classConstantNameAndType        = 12
+	classConstantMethodHandle       = 15
+	classConstantMethodType         = 16
+	classConstantDynamic            = 17
+	classConstantInvokeDynamic      = 18
+	classConstantModule             = 19
+	classConstantPackage            = 20
+)
+
+// classConstantSizes are the sizes of the constants other than Utf8, excluding the tag.
+var classConstantSizes = map[byte]int{
+	classConstantInteger:            4,
+	classConstantFloat:              4,
+	classConstantLong:               8,
+	classConstantDouble:             8,
+	classConstantClass:              2,
+	classConstantString:             2,
+	classConstantFieldref:           4,
+	classConstantMethodref:          4,
+	classConstantInterfaceMethodref: 4,
+	classConstantNameAndType:        4,
+	classConstantMethodHandle:       3,
+	classConstantMethodType:         2,
+	classConstantDynamic:            4,
+	classConstantInvokeDynamic:      4,
+	classConstantModule:             2,
+	classConstantPackage:            2,
+}
+
+var errInvalidClass = errors.New("invalid class file")
+
+// parseClassConstants returns the Utf8 constants of a class file's constant pool, in pool order. Utf8 constants hold
+// the values of String constants as well as the names and descriptors of classes, fields and methods.
+func parseClassConstants(data []byte) ([]string, error) {
+	if len(data) < 10 || binary.BigEndian.Uint32(data) != 0xCAFEBABE {
+		return nil, errInvalidClass
+	}
+
+	count := int(binary.BigEndian.Uint16(data[8:]))
+	var constants []string
+	off := 10
+	for i := 1; i < count; i++ {
+		if off >= len(data) {
+			return nil, fmt.Errorf("%w: truncated constant pool", errInvalidClass)
+		}
+		tag := data[off]
+		off++
+
+		if tag == classConstantUtf8 {
+			if off+2 > len(data) {
+				return nil, fmt.Errorf("%w: truncated constant pool", errInvalidClass)
+			}
+			n := int(binary.BigEndian.Uint16(data[off:]))
+			off += 2
+			if off+n > len(data) {
+				return nil, fmt.Errorf("%w: truncated constant pool", errInvalidClass)
+			}
+			constants = append(constants, decodeModifiedUTF8(data[off:off+n]))
+			off += n
+			continue
+		}
+
+		size, ok := classConstantSizes[tag]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown constant tag %d", errInvalidClass, tag)
+		}
+		off += size
+		// Long and Double constants take up two entries of the pool.
+		if tag == classConstantLong || tag == classConstantDouble {
+			i++
+		}
+	}
+	return constants, nil
+}
+
+// decodeModifiedUTF8 decodes the modified UTF-8 of class files, which encodes NUL in two bytes and characters outside
+// the Basic Multilingual Plane as surrogate pairs of three bytes each.
+func decodeModifiedUTF8(b []byte) string {
+	units := make([]uint16, 0, len(b))
+	for i := 0; i < len(b); {
+		c := b[i]
+		switch {
+		case c < 0x80:
+			units = append(units, uint16(c))
+			i++
+		case c&0xE0 == 0xC0 && i+1 < len(b):
+			units = append(units, uint16(c&0x1F)<<6|uint16(b[i+1]&0x3F))
+			i += 2
+		case c&0xF0 == 0xE0 && i+2 < len(b):
+			units = append(units, uint16(c&0x0F)<<12|uint16(b[i+1]&0x3F)<<6|uint16(b[i+2]&0x3F))
+			i += 3
+		default:
+			units = append(units, 0xFFFD)
+			i++
+		}
+	}
+	return string(utf16.Decode(units))
+}
+
+// unfoldManifest joins the continuation lines of a JAR manifest, which wraps lines at 72 bytes and starts the
+// continuation with a space.
+func unfoldManifest(r io.Reader) io.Reader {
+	var out bytes.Buffer
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxSize)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if strings.HasPrefix(line, " ") && out.Len() > 0 {
+			out.Truncate(out.Len() - 1)
+			line = line[1:]
+		}
+		out.WriteString(line)
+		out.WriteByte('\n')
+	}
+	return &out
+}
+
+// decodeProperties decodes the escapes of a Java properties file, joining continued lines and replacing \uXXXX
+// escapes with the characters they encode, while leaving the keys and separators in place.
+func decodeProperties(r io.Reader) io.Reader {
+	var out bytes.Buffer
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxSize)
+	continued := false
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if continued {
+			line = strings.TrimLeft(line, " \t\f")
+		}
+
+		// A line ending in an odd number of backslashes continues on the next line.
+		trailing := len(line) - len(strings.TrimRight(line, `\`))
+		continued = trailing%2 == 1
+		if continued {
+			line = line[:len(line)-1]
+		}
+
+		out.WriteString(unescapeProperty(line))
+		if !continued {
+			out.WriteByte('\n')
+		}
+	}
+	return &out
+}
+
+// unescapeProperty replaces the escape sequences of a properties file line.
+func unescapeProperty(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch c := s[i]; c {
+		case 't':
+			sb.WriteByte('\t')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'u':
+			if i+4 < len(s) {
+				if v, err := strconv.ParseUint(s[i+1:i+5], 16, 16); err == nil {
+					sb.WriteRune(rune(v))
+					i += 4
+					continue
+				}
+			}
+			sb.WriteString(`\u`)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
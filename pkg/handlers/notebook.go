@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// notebookHandler scans Jupyter notebooks cell by cell. Notebooks are JSON, so scanned as text their base64 encoded
+// image outputs add noise and slow the scan, and line numbers point into the JSON rather than into a cell. Instead
+// the handler scans the source and the textual outputs of each cell, skipping images and other binary outputs, and
+// reports the cell and the line within the cell of each chunk. The lines of a cell's outputs follow the lines of
+// its source.
+type notebookHandler struct{ *defaultHandler }
+
+// newNotebookHandler creates a notebookHandler.
+func newNotebookHandler() *notebookHandler {
+	return &notebookHandler{defaultHandler: newDefaultHandler(notebookHandlerType)}
+}
+
+// HandleFile processes Jupyter notebooks and returns a channel of DataOrErr.
+//
+// Fatal errors that will terminate processing include:
+// - Context cancellation
+// - Context deadline exceeded
+// - Errors reading the file
+// - Errors writing to the data channel
+//
+// Notebooks that are not valid JSON are scanned as plain text.
+func (h *notebookHandler) HandleFile(ctx logContext.Context, input fileReader) chan DataOrErr {
+	dataOrErrChan := make(chan DataOrErr, defaultBufferSize)
+
+	go func() {
+		defer close(dataOrErrChan)
+
+		start := time.Now()
+		err := h.processNotebook(ctx, 0, input, dataOrErrChan)
+		if err == nil {
+			h.metrics.incFilesProcessed()
+		}
+
+		// Update the metrics for the file processing and handle any errors.
+		h.measureLatencyAndHandleErrors(ctx, start, err, dataOrErrChan)
+	}()
+
+	return dataOrErrChan
+}
+
+// processNotebook sends the cells of a single notebook to the provided channel.
+func (h *notebookHandler) processNotebook(
+	ctx logContext.Context,
+	depth int,
+	reader io.Reader,
+	dataOrErrChan chan DataOrErr,
+) error {
+	if common.IsDone(ctx) {
+		return ctx.Err()
+	}
+
+	if depth >= maxDepth {
+		h.metrics.incMaxArchiveDepthCount()
+		return ErrMaxDepthReached
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, int64(maxSize)+1))
+	if err != nil {
+		return fmt.Errorf("error reading notebook: %w", err)
+	}
+	if len(data) > maxSize {
+		ctx.Logger().V(2).Info("skipping notebook: size exceeds max allowed", "limit", maxSize)
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+
+	var nb notebook
+	if err := json.Unmarshal(data, &nb); err != nil {
+		ctx.Logger().V(3).Info("notebook is not valid JSON, scanning as text", "error", err)
+		rdr := mimeTypeReader{mimeExt: ".txt", mimeName: textMime, Reader: bytes.NewReader(data)}
+		return h.handleNonArchiveContent(ctx, rdr, dataOrErrChan)
+	}
+
+	// Notebook metadata, such as kernel and extension settings, has no cell and is scanned as is.
+	if len(nb.Metadata) > 0 {
+		rdr := mimeTypeReader{mimeExt: ".json", mimeName: jsonMime, Reader: bytes.NewReader(nb.Metadata)}
+		if err := h.handleNonArchiveContent(ctx, rdr, dataOrErrChan); err != nil {
+			return err
+		}
+	}
+
+	for i, cell := range nb.cells() {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		text := cell.text()
+		h.metrics.observeFileSize(int64(len(text)))
+		if err := h.handleCell(logContext.WithValues(ctx, "cell", i+1), int64(i+1), text, dataOrErrChan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleCell sends the text of a cell in chunks, each carrying the cell and the line of the cell it starts on.
+func (h *notebookHandler) handleCell(
+	ctx logContext.Context,
+	cell int64,
+	text []byte,
+	dataOrErrChan chan DataOrErr,
+) error {
+	return h.handleLocatedText(ctx, text, dataOrErrChan, func(dataOrErr *DataOrErr, line int64) {
+		dataOrErr.Cell, dataOrErr.CellLine = cell, line
+	})
+}
+
+// notebook is the subset of the Jupyter notebook format holding content worth scanning. Both nbformat 4 and the
+// older nbformat 3, which nests cells in worksheets, are supported.
+type notebook struct {
+	Cells      []notebookCell `json:"cells"`
+	Worksheets []struct {
+		Cells []notebookCell `json:"cells"`
+	} `json:"worksheets"`
+	Metadata json.RawMessage `json:"metadata"`
+}
+
+// cells returns the cells of the notebook in order.
+func (nb *notebook) cells() []notebookCell {
+	cells := nb.Cells
+	for _, ws := range nb.Worksheets {
+		cells = append(cells, ws.Cells...)
+	}
+	return cells
+}
+
+type notebookCell struct {
+	Source notebookText `json:"source"`
+	// Input holds the source of code cells in nbformat 3.
+	Input   notebookText     `json:"input"`
+	Outputs []notebookOutput `json:"outputs"`
+}
+
+type notebookOutput struct {
+	Text      notebookText               `json:"text"`
+	Data      map[string]json.RawMessage `json:"data"`
+	EName     string                     `json:"ename"`
+	EValue    string                     `json:"evalue"`
+	Traceback []string                   `json:"traceback"`
+}
+
+// ansiEscapePat matches the terminal color codes used in error tracebacks.
+var ansiEscapePat = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// text returns the source of the cell followed by its textual outputs, each starting on a new line.
+func (c *notebookCell) text() []byte {
+	var buf bytes.Buffer
+	write := func(s string) {
+		if s == "" {
+			return
+		}
+		if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		buf.WriteString(s)
+	}
+
+	write(string(c.Source))
+	write(string(c.Input))
+	for _, out := range c.Outputs {
+		write(string(out.Text))
+		// An output holds the same result in several representations, which are written in a stable order.
+		for _, mime := range slices.Sorted(maps.Keys(out.Data)) {
+			if !isTextualNotebookOutput(mime) {
+				continue
+			}
+			raw := out.Data[mime]
+			var text notebookText
+			if err := json.Unmarshal(raw, &text); err != nil {
+				// JSON outputs hold arbitrary JSON rather than text.
+				text = notebookText(raw)
+			}
+			write(string(text))
+		}
+		if out.EName != "" {
+			write(out.EName + ": " + out.EValue)
+		}
+		for _, line := range out.Traceback {
+			write(ansiEscapePat.ReplaceAllString(line, ""))
+		}
+	}
+	return buf.Bytes()
+}
+
+// isTextualNotebookOutput reports whether a rich output of the given MIME type holds text worth scanning, as opposed
+// to images and other base64 encoded binary data.
+func isTextualNotebookOutput(mime string) bool {
+	switch {
+	case strings.HasPrefix(mime, "image/"):
+		return false
+	case strings.HasPrefix(mime, "text/"):
+		return true
+	case mime == "application/javascript", strings.HasSuffix(mime, "json"):
+		return true
+	default:
+		return false
+	}
+}
+
+// notebookText is a multiline string, which notebooks store either as a single string or as a list of lines.
+type notebookText string
+
+func (t *notebookText) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*t = notebookText(s)
+		return nil
+	}
+	var lines []string
+	if err := json.Unmarshal(b, &lines); err != nil {
+		return err
+	}
+	*t = notebookText(strings.Join(lines, ""))
+	return nil
+}
+
+var registerNotebookMIME sync.Once
+
+// notebookMIME returns the MIME type of Jupyter notebooks, registering it with the mimetype library as a child of
+// JSON on first use. The registered type never matches during detection; it is assigned based on the file extension.
+func notebookMIME() *mimetype.MIME {
+	registerNotebookMIME.Do(func() {
+		mimetype.Lookup(string(jsonMime)).Extend(func([]byte, uint32) bool { return false }, string(ipynbMime), notebookExt)
+	})
+	return mimetype.Lookup(string(ipynbMime))
+}
+
+// shouldHandleAsNotebook reports whether a file is a Jupyter notebook. Detection relies on the extension, as the
+// content of a notebook is plain JSON, which may not be recognized as such when the notebook is large.
+func shouldHandleAsNotebook(cfg readerConfig, fReader fileReader) bool {
+	return cfg.fileExtension == notebookExt &&
+		(fReader.mime.Is(string(jsonMime)) || strings.HasPrefix(fReader.mime.String(), "text/plain"))
+}
@@ -0,0 +1,1027 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// officeHandler extracts scannable content from Office documents and Outlook messages.
+//
+// Office Open XML documents (.docx, .xlsx and .pptx) are zip packages of XML parts. Scanning the raw XML splits
+// text across formatting runs and leaves spreadsheet cells as indexes into a shared string table, so instead
+// the handler reassembles:
+// - Document text, including headers, footers, footnotes, comments and tracked deletions.
+// - Spreadsheet rows, with shared strings resolved and cells separated by tabs.
+// - Slide text and speaker notes.
+// - Document properties and external link targets.
+// - Embedded objects, which are handled recursively like archive members.
+//
+// Legacy Word documents (.doc) and Outlook messages (.msg) are compound files. For Word documents the text is
+// read through the piece table, and for messages the string properties, HTML and RTF bodies, recipients and
+// attachments are extracted, including attached messages.
+type officeHandler struct{ *defaultHandler }
+
+// newOfficeHandler creates an officeHandler.
+func newOfficeHandler() *officeHandler {
+	return &officeHandler{defaultHandler: newDefaultHandler(officeHandlerType)}
+}
+
+// HandleFile processes Office documents and Outlook messages and returns a channel of DataOrErr.
+//
+// Fatal errors that will terminate processing include:
+// - Context cancellation
+// - Context deadline exceeded
+// - Maximum archive depth exceeded
+// - Errors reading or parsing the document container
+// - Panics during processing (recovered and returned as fatal errors)
+//
+// Non-fatal errors that will be logged but allow processing to continue include:
+// - Parts or streams that fail to parse
+// - Errors handling embedded objects and attachments
+func (h *officeHandler) HandleFile(ctx logContext.Context, input fileReader) chan DataOrErr {
+	dataOrErrChan := make(chan DataOrErr, defaultBufferSize)
+
+	go func() {
+		defer close(dataOrErrChan)
+
+		// Defer a panic recovery to handle any panics that occur while parsing malformed documents.
+		defer func() {
+			if r := recover(); r != nil {
+				var panicErr error
+				if e, ok := r.(error); ok {
+					panicErr = e
+				} else {
+					panicErr = fmt.Errorf("panic occurred: %v", r)
+				}
+				dataOrErrChan <- DataOrErr{
+					Err: fmt.Errorf("%w: panic error: %v", ErrProcessingFatal, panicErr),
+				}
+			}
+		}()
+
+		start := time.Now()
+		err := h.processOffice(ctx, 0, input, dataOrErrChan)
+		if err == nil {
+			h.metrics.incFilesProcessed()
+		}
+
+		// Update the metrics for the file processing and handle any errors.
+		h.measureLatencyAndHandleErrors(ctx, start, err, dataOrErrChan)
+	}()
+
+	return dataOrErrChan
+}
+
+// processOffice extracts the content of a single document and sends it to the provided channel.
+// Embedded objects are processed at depth+1, so nested documents share the archive depth limit.
+func (h *officeHandler) processOffice(
+	ctx logContext.Context,
+	depth int,
+	reader fileReader,
+	dataOrErrChan chan DataOrErr,
+) error {
+	if common.IsDone(ctx) {
+		return ctx.Err()
+	}
+
+	if depth >= maxDepth {
+		h.metrics.incMaxArchiveDepthCount()
+		return ErrMaxDepthReached
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, int64(maxSize)+1))
+	if err != nil {
+		return fmt.Errorf("error reading document: %w", err)
+	}
+	if len(data) > maxSize {
+		ctx.Logger().V(2).Info("skipping document: size exceeds max allowed", "limit", maxSize)
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+
+	switch mimeType(reader.mime.String()) {
+	case docxMime, xlsxMime, pptxMime:
+		return h.processOOXML(ctx, depth, data, dataOrErrChan)
+	}
+
+	f, err := parseOLE(ctx, data)
+	if err != nil {
+		return fmt.Errorf("error parsing compound file: %w", err)
+	}
+
+	// MIME detection only looks at the start of the file, which often is not enough to tell Word documents and
+	// messages apart from other compound files, so they are identified by their streams instead.
+	if _, ok := f.child(0, "WordDocument"); ok {
+		return h.processDOC(ctx, depth, f, dataOrErrChan)
+	}
+	if _, ok := f.child(0, "__properties_version1.0"); ok {
+		return h.processMSG(ctx, depth, f, 0, dataOrErrChan)
+	}
+
+	// Other compound files, such as Excel workbooks or OLE objects embedded in OOXML packages, are scanned as is
+	// in addition to extracting the files of any OLE objects they contain.
+	if err := h.processOLEObjects(ctx, depth+1, f, 0, dataOrErrChan); err != nil {
+		return err
+	}
+	rdr := mimeTypeReader{mimeExt: reader.mime.Extension(), mimeName: mimeType(reader.mime.String()), Reader: bytes.NewReader(data)}
+	return h.handleNonArchiveContent(ctx, rdr, dataOrErrChan)
+}
+
+// handleText sends text extracted from a document to the provided channel.
+func (h *officeHandler) handleText(ctx logContext.Context, text []byte, dataOrErrChan chan DataOrErr) error {
+	if len(bytes.TrimSpace(text)) == 0 {
+		return nil
+	}
+	h.metrics.observeFileSize(int64(len(text)))
+	rdr := mimeTypeReader{mimeExt: ".txt", mimeName: textMime, Reader: bytes.NewReader(text)}
+	return h.handleNonArchiveContent(ctx, rdr, dataOrErrChan)
+}
+
+// handleWarning reports a recoverable error while processing part of a document.
+func (h *officeHandler) handleWarning(ctx logContext.Context, err error, dataOrErrChan chan DataOrErr) error {
+	if isFatal(err) {
+		return err
+	}
+	h.metrics.incErrors()
+	warn := DataOrErr{Err: fmt.Errorf("%w: %v", ErrProcessingWarning, err)}
+	return common.CancellableWrite(ctx, dataOrErrChan, warn)
+}
+
+// processOOXML extracts the content of an Office Open XML package. Each part is sent separately so that the
+// text of one part is never joined with the text of another.
+func (h *officeHandler) processOOXML(
+	ctx logContext.Context,
+	depth int,
+	data []byte,
+	dataOrErrChan chan DataOrErr,
+) error {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return fmt.Errorf("error opening OOXML package: %w", err)
+	}
+
+	// Worksheets reference cell text by index into the shared string table, so it is loaded first.
+	var sharedStrings []string
+	for _, file := range zr.File {
+		if file.Name != "xl/sharedStrings.xml" {
+			continue
+		}
+		part, err := readOOXMLPart(file)
+		if err == nil {
+			sharedStrings, err = extractOOXMLSharedStrings(part)
+		}
+		if err != nil {
+			if err := h.handleWarning(ctx, fmt.Errorf("error reading shared strings: %w", err), dataOrErrChan); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, file := range zr.File {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		kind := ooxmlPartKindOf(file.Name)
+		if kind == ooxmlPartSkipped {
+			continue
+		}
+		lCtx := logContext.WithValues(ctx, "part", file.Name)
+
+		part, err := readOOXMLPart(file)
+		if err != nil {
+			if err := h.handleWarning(lCtx, err, dataOrErrChan); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var text []byte
+		switch kind {
+		case ooxmlPartText:
+			text, err = extractOOXMLText(part)
+		case ooxmlPartSheet:
+			text, err = extractOOXMLSheet(part, sharedStrings)
+		case ooxmlPartProperties:
+			text, err = extractOOXMLProperties(part)
+		case ooxmlPartRelationships:
+			text, err = extractOOXMLExternalTargets(part)
+		case ooxmlPartEmbedding:
+			err = h.handleEmbeddedFile(lCtx, depth+1, part, dataOrErrChan)
+		}
+		if err != nil {
+			err = fmt.Errorf("error processing part %s: %w", file.Name, err)
+			if err := h.handleWarning(lCtx, err, dataOrErrChan); err != nil {
+				return err
+			}
+		}
+		if err := h.handleText(lCtx, text, dataOrErrChan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ooxmlPartKind int
+
+const (
+	ooxmlPartSkipped ooxmlPartKind = iota
+	ooxmlPartText
+	ooxmlPartSheet
+	ooxmlPartProperties
+	ooxmlPartRelationships
+	ooxmlPartEmbedding
+)
+
+// ooxmlPartKindOf classifies a part of an OOXML package by its name. Parts that only hold styles, themes,
+// layouts or media are skipped.
+func ooxmlPartKindOf(name string) ooxmlPartKind {
+	dir, base := path.Split(name)
+	switch {
+	case strings.Contains(dir, "/embeddings/"):
+		return ooxmlPartEmbedding
+	case !strings.HasSuffix(base, ".xml") && !strings.HasSuffix(base, ".rels"):
+		return ooxmlPartSkipped
+	case strings.HasSuffix(dir, "_rels/"):
+		return ooxmlPartRelationships
+	case dir == "docProps/":
+		return ooxmlPartProperties
+	case dir == "xl/worksheets/":
+		return ooxmlPartSheet
+	case dir == "word/":
+		for _, prefix := range []string{"document", "header", "footer", "footnotes", "endnotes", "comments"} {
+			if strings.HasPrefix(base, prefix) {
+				return ooxmlPartText
+			}
+		}
+	case dir == "ppt/slides/", dir == "ppt/notesSlides/", dir == "ppt/comments/",
+		dir == "xl/threadedComments/", dir == "xl/" && strings.HasPrefix(base, "comments"):
+		return ooxmlPartText
+	}
+	return ooxmlPartSkipped
+}
+
+// readOOXMLPart reads a part of an OOXML package, enforcing the archive max size.
+func readOOXMLPart(file *zip.File) ([]byte, error) {
+	if file.UncompressedSize64 > uint64(maxSize) {
+		return nil, fmt.Errorf("part %s exceeds max size", file.Name)
+	}
+	rc, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("error opening part %s: %w", file.Name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, int64(maxSize)))
+	if err != nil {
+		return nil, fmt.Errorf("error reading part %s: %w", file.Name, err)
+	}
+	return data, nil
+}
+
+var (
+	// ooxmlTextElements hold text in WordprocessingML, SpreadsheetML, PresentationML and DrawingML. delText holds
+	// text removed under change tracking, and instrText holds field codes such as hyperlink targets.
+	ooxmlTextElements = map[string]struct{}{"t": {}, "delText": {}, "instrText": {}, "text": {}}
+
+	// ooxmlBreakElements end a line of text.
+	ooxmlBreakElements = map[string]struct{}{"p": {}, "si": {}, "br": {}, "cr": {}, "comment": {}, "text": {}}
+)
+
+// extractOOXMLText returns the text of a document, slide, notes or comments part, one paragraph per line.
+// Text runs are joined so that values split by formatting or spell-check boundaries are reassembled.
+func extractOOXMLText(part []byte) ([]byte, error) {
+	var (
+		out   bytes.Buffer
+		stack []string
+	)
+	newline := func() {
+		if out.Len() > 0 && out.Bytes()[out.Len()-1] != '\n' {
+			out.WriteByte('\n')
+		}
+	}
+
+	dec := xml.NewDecoder(bytes.NewReader(part))
+	for {
+		tok, err := dec.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			newline()
+			return out.Bytes(), err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name.Local)
+			if t.Name.Local == "tab" {
+				out.WriteByte('\t')
+			}
+		case xml.EndElement:
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+			if _, ok := ooxmlBreakElements[t.Name.Local]; ok {
+				newline()
+			}
+		case xml.CharData:
+			if len(stack) == 0 {
+				continue
+			}
+			if _, ok := ooxmlTextElements[stack[len(stack)-1]]; ok {
+				out.Write(t)
+			}
+		}
+	}
+	newline()
+	return out.Bytes(), nil
+}
+
+// extractOOXMLSharedStrings returns the entries of a workbook's shared string table in order.
+func extractOOXMLSharedStrings(part []byte) ([]string, error) {
+	var (
+		out   []string
+		cur   strings.Builder
+		stack []string
+	)
+
+	dec := xml.NewDecoder(bytes.NewReader(part))
+	for {
+		tok, err := dec.RawToken()
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+		if err != nil {
+			return out, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name.Local)
+		case xml.EndElement:
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+			if t.Name.Local == "si" {
+				out = append(out, cur.String())
+				cur.Reset()
+			}
+		case xml.CharData:
+			// Phonetic runs (rPh) repeat the text as reading hints and are not part of the value.
+			if len(stack) > 0 && stack[len(stack)-1] == "t" && !containsString(stack, "rPh") {
+				cur.Write(t)
+			}
+		}
+	}
+}
+
+// extractOOXMLSheet returns the cell values of a worksheet, one row per line with cells separated by tabs, so
+// that a key in one column stays next to its value in the following column.
+func extractOOXMLSheet(part []byte, sharedStrings []string) ([]byte, error) {
+	var (
+		out      bytes.Buffer
+		row      []string
+		cellType string
+		value    strings.Builder
+		stack    []string
+	)
+
+	dec := xml.NewDecoder(bytes.NewReader(part))
+	for {
+		tok, err := dec.RawToken()
+		if errors.Is(err, io.EOF) {
+			return out.Bytes(), nil
+		}
+		if err != nil {
+			return out.Bytes(), err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name.Local)
+			if t.Name.Local == "c" {
+				cellType = ""
+				value.Reset()
+				for _, attr := range t.Attr {
+					if attr.Name.Local == "t" {
+						cellType = attr.Value
+					}
+				}
+			}
+		case xml.EndElement:
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+			switch t.Name.Local {
+			case "c":
+				v := value.String()
+				if cellType == "s" {
+					if i, err := strconv.Atoi(strings.TrimSpace(v)); err == nil && i >= 0 && i < len(sharedStrings) {
+						v = sharedStrings[i]
+					}
+				}
+				if v != "" {
+					row = append(row, v)
+				}
+			case "row":
+				if len(row) > 0 {
+					out.WriteString(strings.Join(row, "\t"))
+					out.WriteByte('\n')
+				}
+				row = row[:0]
+			}
+		case xml.CharData:
+			if len(stack) == 0 {
+				continue
+			}
+			// Cached values are in v elements and inline strings in is/t elements. Formulas are skipped.
+			if top := stack[len(stack)-1]; top == "v" || (top == "t" && containsString(stack, "is")) {
+				value.Write(t)
+			}
+		}
+	}
+}
+
+// extractOOXMLProperties returns the document properties, one "name: value" per line. Custom properties are
+// named by their name attribute and the others by their element name, e.g. "creator: jdoe".
+func extractOOXMLProperties(part []byte) ([]byte, error) {
+	var (
+		out      bytes.Buffer
+		stack    []string
+		propName string
+	)
+
+	dec := xml.NewDecoder(bytes.NewReader(part))
+	for {
+		tok, err := dec.RawToken()
+		if errors.Is(err, io.EOF) {
+			return out.Bytes(), nil
+		}
+		if err != nil {
+			return out.Bytes(), err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name.Local)
+			if t.Name.Local == "property" {
+				propName = ""
+				for _, attr := range t.Attr {
+					if attr.Name.Local == "name" {
+						propName = attr.Value
+					}
+				}
+			}
+		case xml.EndElement:
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			value := bytes.TrimSpace(t)
+			if len(value) == 0 || len(stack) == 0 {
+				continue
+			}
+			name := stack[len(stack)-1]
+			if containsString(stack, "property") && propName != "" {
+				name = propName
+			}
+			fmt.Fprintf(&out, "%s: %s\n", name, value)
+		}
+	}
+}
+
+// extractOOXMLExternalTargets returns the targets of external relationships, such as hyperlinks and linked
+// data sources, one per line.
+func extractOOXMLExternalTargets(part []byte) ([]byte, error) {
+	var out bytes.Buffer
+
+	dec := xml.NewDecoder(bytes.NewReader(part))
+	for {
+		tok, err := dec.RawToken()
+		if errors.Is(err, io.EOF) {
+			return out.Bytes(), nil
+		}
+		if err != nil {
+			return out.Bytes(), err
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "Relationship" {
+			continue
+		}
+		var target, mode string
+		for _, attr := range start.Attr {
+			switch attr.Name.Local {
+			case "Target":
+				target = attr.Value
+			case "TargetMode":
+				mode = attr.Value
+			}
+		}
+		if mode == "External" && target != "" {
+			fmt.Fprintf(&out, "Target: %s\n", target)
+		}
+	}
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// processDOC extracts the text, summary properties and embedded objects of a Word 97-2003 document.
+func (h *officeHandler) processDOC(
+	ctx logContext.Context,
+	depth int,
+	f *oleFile,
+	dataOrErrChan chan DataOrErr,
+) error {
+	text, err := extractDOCText(f)
+	if err != nil {
+		if errors.Is(err, errDOCEncrypted) {
+			ctx.Logger().V(2).Info("skipping document: document is encrypted")
+			h.metrics.incFilesSkipped()
+			return nil
+		}
+		if err := h.handleWarning(ctx, fmt.Errorf("error extracting document text: %w", err), dataOrErrChan); err != nil {
+			return err
+		}
+	}
+	if err := h.handleText(ctx, text, dataOrErrChan); err != nil {
+		return err
+	}
+
+	if err := h.handleText(ctx, extractOLESummaryProperties(f), dataOrErrChan); err != nil {
+		return err
+	}
+
+	if pool, ok := f.child(0, "ObjectPool"); ok {
+		return h.processOLEObjects(ctx, depth+1, f, pool, dataOrErrChan)
+	}
+	return nil
+}
+
+var errDOCEncrypted = errors.New("document is encrypted")
+
+// extractDOCText returns the text of a Word 97-2003 document. The text is stored in the WordDocument stream
+// as a sequence of pieces, either 8-bit or UTF-16, which are located through the piece table in the table
+// stream. The piece table covers the main text as well as headers, footnotes, comments and text boxes.
+func extractDOCText(f *oleFile) ([]byte, error) {
+	wordDoc, ok := f.childStream(0, "WordDocument")
+	if !ok || len(wordDoc) < 0x22 {
+		return nil, errors.New("missing WordDocument stream")
+	}
+
+	le := binary.LittleEndian
+	if le.Uint16(wordDoc) != 0xA5EC {
+		return nil, errors.New("invalid FIB signature")
+	}
+	flags := le.Uint16(wordDoc[0x0A:])
+	if flags&0x0100 != 0 {
+		return nil, errDOCEncrypted
+	}
+	tableName := "0Table"
+	if flags&0x0200 != 0 {
+		tableName = "1Table"
+	}
+	table, ok := f.childStream(0, tableName)
+	if !ok {
+		return nil, fmt.Errorf("missing %s stream", tableName)
+	}
+
+	// The FIB is made of variable length arrays. The piece table location (fcClx/lcbClx) is the 34th pair in
+	// the fcLcb array.
+	const clxIndex = 33
+	off := 32
+	if off+2 > len(wordDoc) {
+		return nil, errors.New("truncated FIB")
+	}
+	off += 2 + 2*int(le.Uint16(wordDoc[off:]))
+	if off+2 > len(wordDoc) {
+		return nil, errors.New("truncated FIB")
+	}
+	off += 2 + 4*int(le.Uint16(wordDoc[off:]))
+	if off+2 > len(wordDoc) {
+		return nil, errors.New("truncated FIB")
+	}
+	if int(le.Uint16(wordDoc[off:])) <= clxIndex {
+		return nil, errors.New("FIB has no piece table")
+	}
+	off += 2 + 8*clxIndex
+	if off+8 > len(wordDoc) {
+		return nil, errors.New("truncated FIB")
+	}
+	fcClx, lcbClx := int(le.Uint32(wordDoc[off:])), int(le.Uint32(wordDoc[off+4:]))
+	if fcClx < 0 || lcbClx < 0 || fcClx+lcbClx > len(table) {
+		return nil, errors.New("piece table out of range")
+	}
+	clx := table[fcClx : fcClx+lcbClx]
+
+	// Skip the property modifiers (Prc) that precede the piece table (Pcdt).
+	i := 0
+	for i+3 <= len(clx) && clx[i] == 0x01 {
+		i += 3 + int(le.Uint16(clx[i+1:]))
+	}
+	if i+5 > len(clx) || clx[i] != 0x02 {
+		return nil, errors.New("invalid piece table")
+	}
+	plc := clx[i+5:]
+	if n := int(le.Uint32(clx[i+1:])); n <= len(plc) {
+		plc = plc[:n]
+	}
+
+	// The PlcPcd holds n+1 character positions followed by n 8-byte piece descriptors.
+	n := (len(plc) - 4) / 12
+	var out bytes.Buffer
+	for k := 0; k < n; k++ {
+		cpStart, cpEnd := le.Uint32(plc[4*k:]), le.Uint32(plc[4*(k+1):])
+		if cpEnd <= cpStart {
+			continue
+		}
+		count := int(cpEnd - cpStart)
+		fc := le.Uint32(plc[4*(n+1)+8*k+2:])
+
+		if fc&0x40000000 != 0 {
+			start := int(fc&^0x40000000) / 2
+			if start+count > len(wordDoc) || start+count < 0 {
+				continue
+			}
+			for _, c := range wordDoc[start : start+count] {
+				writeDOCChar(&out, rune(c))
+			}
+		} else {
+			start := int(fc)
+			if start+2*count > len(wordDoc) || start+2*count < 0 {
+				continue
+			}
+			for _, r := range utf16.Decode(utf16LEUnits(wordDoc[start : start+2*count])) {
+				writeDOCChar(&out, r)
+			}
+		}
+		if out.Len() > maxSize {
+			break
+		}
+	}
+	return out.Bytes(), nil
+}
+
+// writeDOCChar writes a character of Word document text, translating the control characters Word uses for
+// paragraph, cell and field boundaries.
+func writeDOCChar(out *bytes.Buffer, r rune) {
+	switch r {
+	case 0x0D, 0x0B, 0x0C:
+		out.WriteByte('\n')
+	case 0x07, 0x09:
+		out.WriteByte('\t')
+	case 0x14:
+		// Separates a field's code from its displayed result.
+		out.WriteByte(' ')
+	default:
+		if r >= 0x20 {
+			out.WriteRune(r)
+		}
+	}
+}
+
+// processOLEObjects extracts the files held by OLE objects below the storage at index storage, such as the
+// ObjectPool of a Word document or an oleObject part of an OOXML package. Packaged files are stored in an
+// Ole10Native stream and embedded OOXML documents in a Package stream.
+func (h *officeHandler) processOLEObjects(
+	ctx logContext.Context,
+	depth int,
+	f *oleFile,
+	storage int,
+	dataOrErrChan chan DataOrErr,
+) error {
+	for _, c := range f.descendants(storage) {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		e := f.entries[c]
+		var payload []byte
+		switch e.name {
+		case "\x01Ole10Native":
+			data, err := f.stream(e)
+			if err != nil {
+				if err := h.handleWarning(ctx, err, dataOrErrChan); err != nil {
+					return err
+				}
+				continue
+			}
+			payload = parseOle10Native(data)
+		case "Package", "CONTENTS":
+			data, err := f.stream(e)
+			if err != nil {
+				if err := h.handleWarning(ctx, err, dataOrErrChan); err != nil {
+					return err
+				}
+				continue
+			}
+			payload = data
+		default:
+			continue
+		}
+
+		if err := h.handleEmbeddedFile(ctx, depth, payload, dataOrErrChan); err != nil {
+			err = fmt.Errorf("error handling embedded object: %w", err)
+			if err := h.handleWarning(ctx, err, dataOrErrChan); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parseOle10Native returns the file held by an Ole10Native stream. The stream starts with the package label,
+// the original and temporary paths of the file, and the file size. If the header cannot be parsed the whole
+// stream is returned.
+func parseOle10Native(data []byte) []byte {
+	le := binary.LittleEndian
+	i := 6 // total size and flags
+	cstring := func() bool {
+		end := bytes.IndexByte(data[min(i, len(data)):], 0)
+		if end < 0 {
+			return false
+		}
+		i += end + 1
+		return true
+	}
+	// Label and source path.
+	if !cstring() || !cstring() {
+		return data
+	}
+	i += 8 // unknown fields
+	// Temporary path.
+	if i > len(data) || !cstring() || i+4 > len(data) {
+		return data
+	}
+	size := int(le.Uint32(data[i:]))
+	i += 4
+	if size < 0 || i+size > len(data) {
+		return data
+	}
+	return data[i : i+size]
+}
+
+// processMSG extracts the content of the Outlook message in the storage at index storage: its string
+// properties, HTML and RTF bodies, recipients and attachments. Attached messages are processed recursively.
+func (h *officeHandler) processMSG(
+	ctx logContext.Context,
+	depth int,
+	f *oleFile,
+	storage int,
+	dataOrErrChan chan DataOrErr,
+) error {
+	if depth >= maxDepth {
+		h.metrics.incMaxArchiveDepthCount()
+		return ErrMaxDepthReached
+	}
+
+	var text bytes.Buffer
+	writeMSGProperties(&text, f, storage)
+
+	var attachments []int
+	for _, c := range f.children(storage) {
+		e := f.entries[c]
+		if e.typ != oleEntryStorage {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(e.name, "__recip_version1.0_"):
+			writeMSGProperties(&text, f, c)
+		case strings.HasPrefix(e.name, "__attach_version1.0_"):
+			writeMSGProperties(&text, f, c)
+			attachments = append(attachments, c)
+		}
+	}
+	if err := h.handleText(ctx, text.Bytes(), dataOrErrChan); err != nil {
+		return err
+	}
+
+	for _, attach := range attachments {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		if err := h.processMSGAttachment(ctx, depth+1, f, attach, dataOrErrChan); err != nil {
+			err = fmt.Errorf("error handling message attachment: %w", err)
+			if err := h.handleWarning(ctx, err, dataOrErrChan); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// processMSGAttachment processes the data of a message attachment. Files are stored in the binary
+// PR_ATTACH_DATA_BIN property, attached messages in a PR_ATTACH_DATA_OBJ storage and other OLE objects in a
+// PR_ATTACH_DATA_OBJ stream.
+func (h *officeHandler) processMSGAttachment(
+	ctx logContext.Context,
+	depth int,
+	f *oleFile,
+	attach int,
+	dataOrErrChan chan DataOrErr,
+) error {
+	if data, ok := f.childStream(attach, "__substg1.0_37010102"); ok {
+		return h.handleEmbeddedFile(ctx, depth, data, dataOrErrChan)
+	}
+	if obj, ok := f.child(attach, "__substg1.0_3701000D"); ok {
+		if _, isMsg := f.child(obj, "__properties_version1.0"); isMsg {
+			return h.processMSG(ctx, depth, f, obj, dataOrErrChan)
+		}
+		return h.processOLEObjects(ctx, depth, f, obj, dataOrErrChan)
+	}
+	return nil
+}
+
+// msgPropertyNames labels well-known MAPI properties in the extracted text.
+var msgPropertyNames = map[uint16]string{
+	0x0037: "Subject",
+	0x0042: "SentRepresentingName",
+	0x0065: "SentRepresentingEmailAddress",
+	0x007D: "TransportMessageHeaders",
+	0x0C1A: "SenderName",
+	0x0C1F: "SenderEmailAddress",
+	0x0E02: "DisplayBcc",
+	0x0E03: "DisplayCc",
+	0x0E04: "DisplayTo",
+	0x1000: "Body",
+	0x1009: "RtfBody",
+	0x1013: "HtmlBody",
+	0x3001: "DisplayName",
+	0x3003: "EmailAddress",
+	0x3704: "AttachFilename",
+	0x3707: "AttachLongFilename",
+	0x39FE: "SmtpAddress",
+}
+
+const (
+	msgTypeString8  = 0x001E
+	msgTypeUnicode  = 0x001F
+	msgTypeBinary   = 0x0102
+	msgPropRTF      = 0x1009
+	msgPropHTML     = 0x1013
+	msgSubstgPrefix = "__substg1.0_"
+)
+
+// writeMSGProperties writes the string properties of the message, recipient or attachment storage at index
+// storage, along with the HTML and compressed RTF bodies, which are stored as binary properties. Each property
+// is written as a line, prefixed with its name when it is a well-known property.
+func writeMSGProperties(out *bytes.Buffer, f *oleFile, storage int) {
+	for _, c := range f.children(storage) {
+		e := f.entries[c]
+		if e.typ != oleEntryStream || !strings.HasPrefix(e.name, msgSubstgPrefix) {
+			continue
+		}
+		tag, err := strconv.ParseUint(strings.TrimPrefix(e.name, msgSubstgPrefix), 16, 32)
+		if err != nil {
+			continue
+		}
+		id, typ := uint16(tag>>16), uint16(tag)
+
+		switch {
+		case typ == msgTypeUnicode, typ == msgTypeString8,
+			typ == msgTypeBinary && (id == msgPropHTML || id == msgPropRTF):
+		default:
+			continue
+		}
+
+		data, err := f.stream(e)
+		if err != nil {
+			continue
+		}
+
+		var value []byte
+		switch {
+		case typ == msgTypeUnicode:
+			value = []byte(string(utf16.Decode(utf16LEUnits(data))))
+		case id == msgPropRTF:
+			if value, err = decompressRTF(data); err != nil {
+				continue
+			}
+		default:
+			value = data
+		}
+		value = bytes.TrimSpace(bytes.TrimRight(value, "\x00"))
+		if len(value) == 0 {
+			continue
+		}
+
+		if name, ok := msgPropertyNames[id]; ok {
+			out.WriteString(name)
+			out.WriteString(": ")
+		}
+		out.Write(value)
+		out.WriteByte('\n')
+	}
+}
+
+// rtfPrebuf initializes the dictionary used by compressed RTF.
+const rtfPrebuf = "{\\rtf1\\ansi\\mac\\deff0\\deftab720{\\fonttbl;}{\\f0\\fnil \\froman \\fswiss \\fmodern \\fscript " +
+	"\\fdecor MS Sans SerifSymbolArialTimes New RomanCourier{\\colortbl\\red0\\green0\\blue0\r\n\\par " +
+	"\\pard\\plain\\f0\\fs20\\b\\i\\u\\tab\\tx"
+
+// decompressRTF decompresses an RTF body stored in the compressed RTF format (MS-OXRTFCP), an LZ77 variant
+// with a 4 KiB dictionary.
+func decompressRTF(data []byte) ([]byte, error) {
+	const (
+		headerSize   = 16
+		compressed   = 0x75465A4C // "LZFu"
+		uncompressed = 0x414C454D // "MELA"
+		dictSize     = 4096
+	)
+	if len(data) < headerSize {
+		return nil, errors.New("compressed RTF header is truncated")
+	}
+
+	le := binary.LittleEndian
+	rawSize := int(le.Uint32(data[4:]))
+	if rawSize > maxSize {
+		return nil, errors.New("compressed RTF exceeds max size")
+	}
+	in := data[headerSize:]
+
+	switch le.Uint32(data[8:]) {
+	case uncompressed:
+		if rawSize < len(in) {
+			in = in[:rawSize]
+		}
+		return in, nil
+	case compressed:
+	default:
+		return nil, errors.New("unknown compressed RTF type")
+	}
+
+	// A control byte followed by eight 2-byte references expands 17 bytes of input to at most 8*17 bytes, so a
+	// header claiming more than 9 bytes per input byte is forged. Checking it bounds the output buffer by the input
+	// rather than by the header.
+	if rawSize > len(in)*9 {
+		return nil, errors.New("compressed RTF size exceeds what its data can expand to")
+	}
+
+	var dict [dictSize]byte
+	copy(dict[:], rtfPrebuf)
+	writePos := len(rtfPrebuf)
+	out := make([]byte, 0, rawSize)
+
+	for i := 0; i < len(in); {
+		control := in[i]
+		i++
+		for bit := 0; bit < 8 && i < len(in); bit++ {
+			if control&(1<<bit) == 0 {
+				out = append(out, in[i])
+				dict[writePos] = in[i]
+				writePos = (writePos + 1) % dictSize
+				i++
+				continue
+			}
+
+			if i+2 > len(in) {
+				return out, nil
+			}
+			ref := int(binary.BigEndian.Uint16(in[i:]))
+			i += 2
+			offset, length := ref>>4, ref&0xF+2
+			if offset == writePos {
+				return out, nil
+			}
+			for j := 0; j < length; j++ {
+				c := dict[(offset+j)%dictSize]
+				out = append(out, c)
+				dict[writePos] = c
+				writePos = (writePos + 1) % dictSize
+			}
+		}
+		if len(out) > rawSize {
+			return out[:rawSize], nil
+		}
+	}
+	return out, nil
+}
+
+func utf16LEUnits(b []byte) []uint16 {
+	units := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		units = append(units, binary.LittleEndian.Uint16(b[i:]))
+	}
+	return units
+}
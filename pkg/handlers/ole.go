@@ -0,0 +1,444 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// oleFile is a minimal reader for the Compound File Binary format (also known as OLE2 structured storage),
+// the container used by legacy Office documents such as .doc and Outlook .msg files. A compound file is a
+// small FAT file system: streams are chains of fixed-size sectors and the directory is a tree of storages
+// (directories) and streams (files).
+//
+// Only reading is supported, and the whole file is expected to be in memory.
+type oleFile struct {
+	data       []byte
+	sectorSize int
+	fat        []uint32
+	miniFAT    []uint32
+	miniStream []byte
+	entries    []oleEntry
+}
+
+// oleEntry is an entry in the directory of a compound file.
+type oleEntry struct {
+	name  string
+	typ   byte
+	left  uint32
+	right uint32
+	child uint32
+	start uint32
+	size  uint64
+}
+
+const (
+	oleEntryStorage byte = 1
+	oleEntryStream  byte = 2
+	oleEntryRoot    byte = 5
+
+	oleEndOfChain uint32 = 0xFFFFFFFE
+	oleNoStream   uint32 = 0xFFFFFFFF
+
+	oleHeaderSize       = 512
+	oleDirEntrySize     = 128
+	oleMiniSectorSize   = 64
+	oleMiniStreamCutoff = 4096
+)
+
+var (
+	oleSignature = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+	errOLEInvalid = errors.New("invalid compound file")
+)
+
+// parseOLE parses the header, allocation tables and directory of a compound file.
+func parseOLE(ctx logContext.Context, data []byte) (*oleFile, error) {
+	if len(data) < oleHeaderSize || !bytes.HasPrefix(data, oleSignature) {
+		return nil, fmt.Errorf("%w: missing signature", errOLEInvalid)
+	}
+
+	le := binary.LittleEndian
+	shift := le.Uint16(data[0x1E:])
+	if shift != 9 && shift != 12 {
+		return nil, fmt.Errorf("%w: unsupported sector shift %d", errOLEInvalid, shift)
+	}
+	f := &oleFile{data: data, sectorSize: 1 << shift}
+
+	// The sectors holding the FAT are listed in the DIFAT, whose first 109 entries are in the header and the
+	// rest in a chain of DIFAT sectors. The file can't hold more sectors than fit in it, which bounds both the
+	// FAT and the DIFAT chain, so forged counts and cyclic chains in corrupt files don't exhaust memory.
+	maxSectors := len(data) / f.sectorSize
+	numFAT := min(int(le.Uint32(data[0x2C:])), maxSectors)
+	numDIFAT := min(int(le.Uint32(data[0x48:])), maxSectors)
+	difat := make([]uint32, 0, 109)
+	for i := 0; i < 109; i++ {
+		difat = append(difat, le.Uint32(data[0x4C+4*i:]))
+	}
+	perSector := f.sectorSize/4 - 1
+	visited := make(map[uint32]struct{})
+	for sect, i := le.Uint32(data[0x44:]), 0; sect < oleEndOfChain-3 && i < numDIFAT && len(difat) < numFAT; i++ {
+		if common.IsDone(ctx) {
+			return nil, ctx.Err()
+		}
+		if _, ok := visited[sect]; ok {
+			return nil, fmt.Errorf("%w: DIFAT chain is cyclic", errOLEInvalid)
+		}
+		visited[sect] = struct{}{}
+		buf, ok := f.sector(sect)
+		if !ok {
+			return nil, fmt.Errorf("%w: DIFAT sector out of range", errOLEInvalid)
+		}
+		for j := 0; j < perSector; j++ {
+			difat = append(difat, le.Uint32(buf[4*j:]))
+		}
+		sect = le.Uint32(buf[4*perSector:])
+	}
+	if numFAT > len(difat) {
+		numFAT = len(difat)
+	}
+
+	for _, sect := range difat[:numFAT] {
+		buf, ok := f.sector(sect)
+		if !ok {
+			return nil, fmt.Errorf("%w: FAT sector out of range", errOLEInvalid)
+		}
+		for j := 0; j+4 <= len(buf); j += 4 {
+			f.fat = append(f.fat, le.Uint32(buf[j:]))
+		}
+	}
+
+	dir, err := f.chain(le.Uint32(data[0x30:]), f.fat, f.sectorSize, f.sector)
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory: %w", err)
+	}
+	for off := 0; off+oleDirEntrySize <= len(dir); off += oleDirEntrySize {
+		f.entries = append(f.entries, parseOLEEntry(dir[off:off+oleDirEntrySize]))
+	}
+	if len(f.entries) == 0 || f.entries[0].typ != oleEntryRoot {
+		return nil, fmt.Errorf("%w: missing root entry", errOLEInvalid)
+	}
+
+	if numMiniFAT := le.Uint32(data[0x40:]); numMiniFAT > 0 {
+		buf, err := f.chain(le.Uint32(data[0x3C:]), f.fat, f.sectorSize, f.sector)
+		if err != nil {
+			return nil, fmt.Errorf("error reading mini FAT: %w", err)
+		}
+		for j := 0; j+4 <= len(buf); j += 4 {
+			f.miniFAT = append(f.miniFAT, le.Uint32(buf[j:]))
+		}
+
+		root := f.entries[0]
+		f.miniStream, err = f.chain(root.start, f.fat, f.sectorSize, f.sector)
+		if err != nil {
+			return nil, fmt.Errorf("error reading mini stream: %w", err)
+		}
+		if uint64(len(f.miniStream)) > root.size {
+			f.miniStream = f.miniStream[:root.size]
+		}
+	}
+
+	return f, nil
+}
+
+func parseOLEEntry(b []byte) oleEntry {
+	le := binary.LittleEndian
+	nameLen := int(le.Uint16(b[64:]))
+	if nameLen > 64 {
+		nameLen = 64
+	}
+	units := make([]uint16, 0, nameLen/2)
+	for i := 0; i+1 < nameLen; i += 2 {
+		if u := le.Uint16(b[i:]); u != 0 {
+			units = append(units, u)
+		}
+	}
+	return oleEntry{
+		name:  string(utf16.Decode(units)),
+		typ:   b[66],
+		left:  le.Uint32(b[68:]),
+		right: le.Uint32(b[72:]),
+		child: le.Uint32(b[76:]),
+		start: le.Uint32(b[116:]),
+		size:  le.Uint64(b[120:]),
+	}
+}
+
+// sector returns the contents of the regular sector with the given index.
+func (f *oleFile) sector(sect uint32) ([]byte, bool) {
+	off := (int(sect) + 1) * f.sectorSize
+	if sect >= oleEndOfChain-3 || off < 0 || off+f.sectorSize > len(f.data) {
+		return nil, false
+	}
+	return f.data[off : off+f.sectorSize], true
+}
+
+// miniSector returns the contents of the mini sector with the given index.
+func (f *oleFile) miniSector(sect uint32) ([]byte, bool) {
+	off := int(sect) * oleMiniSectorSize
+	if sect >= oleEndOfChain-3 || off < 0 || off+oleMiniSectorSize > len(f.miniStream) {
+		return nil, false
+	}
+	return f.miniStream[off : off+oleMiniSectorSize], true
+}
+
+// chain concatenates the sectors of the chain starting at start. Chains are bounded by the size of the
+// allocation table, so cyclic chains in corrupt files terminate.
+func (f *oleFile) chain(start uint32, table []uint32, size int, read func(uint32) ([]byte, bool)) ([]byte, error) {
+	var out []byte
+	for sect, n := start, 0; sect != oleEndOfChain; n++ {
+		if n > len(table) {
+			return nil, fmt.Errorf("%w: sector chain is cyclic", errOLEInvalid)
+		}
+		buf, ok := read(sect)
+		if !ok || int(sect) >= len(table) {
+			return nil, fmt.Errorf("%w: sector %d out of range", errOLEInvalid, sect)
+		}
+		out = append(out, buf[:size]...)
+		sect = table[sect]
+	}
+	return out, nil
+}
+
+// stream returns the contents of a stream entry.
+func (f *oleFile) stream(e oleEntry) ([]byte, error) {
+	if e.typ != oleEntryStream {
+		return nil, fmt.Errorf("%q is not a stream", e.name)
+	}
+	if e.size == 0 {
+		return nil, nil
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if e.size < oleMiniStreamCutoff {
+		data, err = f.chain(e.start, f.miniFAT, oleMiniSectorSize, f.miniSector)
+	} else {
+		data, err = f.chain(e.start, f.fat, f.sectorSize, f.sector)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading stream %q: %w", e.name, err)
+	}
+	if uint64(len(data)) > e.size {
+		data = data[:e.size]
+	}
+	return data, nil
+}
+
+// children returns the indexes of the entries directly inside the storage at index i. The entries of a
+// storage form a red-black tree, which is walked in order.
+func (f *oleFile) children(i int) []int {
+	if i < 0 || i >= len(f.entries) {
+		return nil
+	}
+
+	var (
+		out  []int
+		walk func(uint32)
+	)
+	seen := make(map[uint32]struct{})
+	walk = func(id uint32) {
+		if id == oleNoStream || int(id) >= len(f.entries) {
+			return
+		}
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+
+		e := f.entries[id]
+		walk(e.left)
+		out = append(out, int(id))
+		walk(e.right)
+	}
+	walk(f.entries[i].child)
+	return out
+}
+
+// descendants returns the indexes of the streams inside the storage at index i and, recursively, inside its
+// sub-storages. Each entry is visited once, so directories of corrupt files that link back to a parent
+// storage terminate.
+func (f *oleFile) descendants(i int) []int {
+	var (
+		out  []int
+		walk func(int)
+	)
+	seen := map[int]struct{}{i: {}}
+	walk = func(storage int) {
+		for _, c := range f.children(storage) {
+			if _, ok := seen[c]; ok {
+				continue
+			}
+			seen[c] = struct{}{}
+			switch f.entries[c].typ {
+			case oleEntryStream:
+				out = append(out, c)
+			case oleEntryStorage:
+				walk(c)
+			}
+		}
+	}
+	walk(i)
+	return out
+}
+
+// child returns the index of the entry named name inside the storage at index i. Names are compared
+// case-insensitively, as in the format itself.
+func (f *oleFile) child(i int, name string) (int, bool) {
+	for _, c := range f.children(i) {
+		if strings.EqualFold(f.entries[c].name, name) {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+// childStream returns the contents of the stream named name inside the storage at index i.
+func (f *oleFile) childStream(i int, name string) ([]byte, bool) {
+	c, ok := f.child(i, name)
+	if !ok {
+		return nil, false
+	}
+	data, err := f.stream(f.entries[c])
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// oleSummaryPropertyNames labels the properties of the SummaryInformation property set.
+var oleSummaryPropertyNames = map[uint32]string{
+	2:  "Title",
+	3:  "Subject",
+	4:  "Author",
+	5:  "Keywords",
+	6:  "Comments",
+	7:  "Template",
+	8:  "LastAuthor",
+	18: "ApplicationName",
+}
+
+// extractOLESummaryProperties returns the string properties of the SummaryInformation and
+// DocumentSummaryInformation streams, which hold document metadata such as the author and keywords as well as
+// user defined custom properties, one "name: value" per line.
+func extractOLESummaryProperties(f *oleFile) []byte {
+	var out bytes.Buffer
+	if data, ok := f.childStream(0, "\x05SummaryInformation"); ok {
+		writeOLEPropertySets(&out, data, oleSummaryPropertyNames)
+	}
+	if data, ok := f.childStream(0, "\x05DocumentSummaryInformation"); ok {
+		writeOLEPropertySets(&out, data, nil)
+	}
+	return out.Bytes()
+}
+
+// writeOLEPropertySets writes the string properties of a property set stream (MS-OLEPS). Properties are named
+// by the set's dictionary, which names custom properties, and otherwise by names.
+func writeOLEPropertySets(out *bytes.Buffer, data []byte, names map[uint32]string) {
+	const (
+		vtI2     = 0x0002
+		vtLPSTR  = 0x001E
+		vtLPWSTR = 0x001F
+
+		codepageUTF16 = 1200
+	)
+
+	le := binary.LittleEndian
+	if len(data) < 28 {
+		return
+	}
+	numSets := int(le.Uint32(data[24:]))
+	for s := 0; s < numSets && 28+20*(s+1) <= len(data); s++ {
+		base := int(le.Uint32(data[28+20*s+16:]))
+		if base < 0 || base+8 > len(data) {
+			continue
+		}
+		set := data[base:]
+		numProps := int(le.Uint32(set[4:]))
+		if numProps < 0 || 8+8*numProps > len(set) {
+			continue
+		}
+
+		offsets := make(map[uint32]int, numProps)
+		for p := 0; p < numProps; p++ {
+			offsets[le.Uint32(set[8+8*p:])] = int(le.Uint32(set[8+8*p+4:]))
+		}
+
+		codepage := 0
+		if off, ok := offsets[1]; ok && off >= 0 && off+6 <= len(set) && le.Uint16(set[off:]) == vtI2 {
+			codepage = int(le.Uint16(set[off+4:]))
+		}
+
+		// readString reads a string of n characters at off, returning the string and its size in bytes.
+		readString := func(off, n int, wide bool) (string, int) {
+			size := n
+			if wide {
+				size *= 2
+			}
+			if n < 0 || off < 0 || off+size > len(set) {
+				return "", 0
+			}
+			b := set[off : off+size]
+			var s string
+			if wide {
+				s = string(utf16.Decode(utf16LEUnits(b)))
+			} else {
+				s = string(b)
+			}
+			return strings.TrimRight(s, "\x00"), size
+		}
+
+		// The dictionary (property 0) maps custom property IDs to their names.
+		dict := make(map[uint32]string)
+		if off, ok := offsets[0]; ok && off >= 0 && off+4 <= len(set) {
+			pos := off + 4
+			for e := 0; e < int(le.Uint32(set[off:])) && pos+8 <= len(set); e++ {
+				id := le.Uint32(set[pos:])
+				name, size := readString(pos+8, int(le.Uint32(set[pos+4:])), codepage == codepageUTF16)
+				pos += 8 + size
+				if codepage == codepageUTF16 && size%4 != 0 {
+					pos += 4 - size%4
+				}
+				dict[id] = name
+			}
+		}
+
+		for p := 0; p < numProps; p++ {
+			id := le.Uint32(set[8+8*p:])
+			off := offsets[id]
+			if id < 2 || off < 0 || off+8 > len(set) {
+				continue
+			}
+
+			var value string
+			switch le.Uint16(set[off:]) {
+			case vtLPSTR:
+				value, _ = readString(off+8, int(le.Uint32(set[off+4:])), codepage == codepageUTF16)
+			case vtLPWSTR:
+				value, _ = readString(off+8, int(le.Uint32(set[off+4:])), true)
+			default:
+				continue
+			}
+			value = strings.TrimSpace(value)
+			if value == "" {
+				continue
+			}
+
+			if name, ok := dict[id]; ok {
+				out.WriteString(name + ": ")
+			} else if name, ok := names[id]; ok && s == 0 {
+				out.WriteString(name + ": ")
+			}
+			out.WriteString(value)
+			out.WriteByte('\n')
+		}
+	}
+}
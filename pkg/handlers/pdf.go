@@ -0,0 +1,748 @@
+package handlers
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/zlib"
+	"encoding/ascii85"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// pdfHandler extracts scannable content from PDF documents. Rather than scanning the raw file, which is mostly
+// compressed binary streams, it inflates each stream and extracts:
+// - Text shown by the text operators (Tj, TJ, ' and ") of page and form content streams.
+// - String values from object dictionaries, which includes the document info metadata, annotations,
+// link targets and form field values.
+// - XMP metadata streams.
+// - Embedded file attachments, which are handled recursively like archive members.
+//
+// The parser is intentionally lenient. It does not rely on the cross-reference table, so truncated or slightly
+// malformed documents still yield whatever content can be recovered.
+type pdfHandler struct{ *defaultHandler }
+
+// newPDFHandler creates a pdfHandler.
+func newPDFHandler() *pdfHandler {
+	return &pdfHandler{defaultHandler: newDefaultHandler(pdfHandlerType)}
+}
+
+// HandleFile processes PDF files and returns a channel of DataOrErr.
+//
+// Fatal errors that will terminate processing include:
+// - Context cancellation
+// - Context deadline exceeded
+// - Maximum archive depth exceeded
+// - Errors reading the PDF
+// - Panics during processing (recovered and returned as fatal errors)
+//
+// Non-fatal errors that will be logged but allow processing to continue include:
+// - Streams that fail to decode
+// - Errors handling embedded files
+func (h *pdfHandler) HandleFile(ctx logContext.Context, input fileReader) chan DataOrErr {
+	dataOrErrChan := make(chan DataOrErr, defaultBufferSize)
+
+	go func() {
+		defer close(dataOrErrChan)
+
+		// Defer a panic recovery to handle any panics that occur while parsing malformed documents.
+		defer func() {
+			if r := recover(); r != nil {
+				var panicErr error
+				if e, ok := r.(error); ok {
+					panicErr = e
+				} else {
+					panicErr = fmt.Errorf("panic occurred: %v", r)
+				}
+				dataOrErrChan <- DataOrErr{
+					Err: fmt.Errorf("%w: panic error: %v", ErrProcessingFatal, panicErr),
+				}
+			}
+		}()
+
+		start := time.Now()
+		err := h.processPDF(ctx, 0, input, dataOrErrChan)
+		if err == nil {
+			h.metrics.incFilesProcessed()
+		}
+
+		// Update the metrics for the file processing and handle any errors.
+		h.measureLatencyAndHandleErrors(ctx, start, err, dataOrErrChan)
+	}()
+
+	return dataOrErrChan
+}
+
+// processPDF extracts the content of a single PDF document and sends it to the provided channel.
+// Embedded files are processed at depth+1, so nested documents share the archive depth limit.
+func (h *pdfHandler) processPDF(
+	ctx logContext.Context,
+	depth int,
+	reader io.Reader,
+	dataOrErrChan chan DataOrErr,
+) error {
+	if common.IsDone(ctx) {
+		return ctx.Err()
+	}
+
+	if depth >= maxDepth {
+		h.metrics.incMaxArchiveDepthCount()
+		return ErrMaxDepthReached
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, int64(maxSize)+1))
+	if err != nil {
+		return fmt.Errorf("error reading PDF: %w", err)
+	}
+	if len(data) > maxSize {
+		ctx.Logger().V(2).Info("skipping PDF: size exceeds max allowed", "limit", maxSize)
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+
+	if pdfEncryptPat.Match(data) {
+		ctx.Logger().V(2).Info("skipping PDF: document is encrypted")
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+
+	var text, meta bytes.Buffer
+	// budget is what is left of the max size for the decoded streams of the whole document, so that many small
+	// compressed streams cannot together inflate beyond what a single one may.
+	budget := maxSize
+	for _, obj := range parsePDFObjects(data) {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		meta.Write(extractPDFStrings(obj.dict))
+		if obj.stream == nil {
+			continue
+		}
+
+		kind := obj.streamKind()
+		if kind == pdfStreamSkipped {
+			continue
+		}
+
+		if budget <= 0 {
+			ctx.Logger().V(2).Info("skipping remaining PDF streams: decoded size exceeds max allowed", "limit", maxSize)
+			h.metrics.incFilesSkipped()
+			break
+		}
+		decoded, err := decodePDFStream(obj.dict, obj.stream, budget)
+		if err != nil {
+			if !errors.Is(err, errPDFUnsupportedFilter) {
+				h.metrics.incErrors()
+				warn := DataOrErr{Err: fmt.Errorf("%w: error decoding PDF stream: %v", ErrProcessingWarning, err)}
+				if writeErr := common.CancellableWrite(ctx, dataOrErrChan, warn); writeErr != nil {
+					return writeErr
+				}
+			}
+			continue
+		}
+		budget -= len(decoded)
+
+		switch kind {
+		case pdfStreamContent:
+			text.Write(extractPDFText(decoded))
+		case pdfStreamObjects:
+			meta.Write(extractPDFStrings(decoded))
+		case pdfStreamMetadata:
+			meta.Write(decoded)
+			meta.WriteByte('\n')
+		case pdfStreamEmbeddedFile:
+			if err := h.handleEmbeddedFile(ctx, depth+1, decoded, dataOrErrChan); err != nil {
+				if isFatal(err) {
+					return err
+				}
+				h.metrics.incErrors()
+				warn := DataOrErr{Err: fmt.Errorf("%w: error handling PDF embedded file: %v", ErrProcessingWarning, err)}
+				if writeErr := common.CancellableWrite(ctx, dataOrErrChan, warn); writeErr != nil {
+					return writeErr
+				}
+			}
+		}
+	}
+
+	for _, buf := range []*bytes.Buffer{&text, &meta} {
+		if buf.Len() == 0 {
+			continue
+		}
+		h.metrics.observeFileSize(int64(buf.Len()))
+		rdr := mimeTypeReader{mimeExt: ".txt", mimeName: textMime, Reader: buf}
+		if err := h.handleNonArchiveContent(ctx, rdr, dataOrErrChan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	errPDFUnsupportedFilter = errors.New("unsupported PDF stream filter")
+
+	pdfEncryptPat   = regexp.MustCompile(`/Encrypt\s*(?:\d+\s+\d+\s+R|<<)`)
+	pdfObjHeaderPat = regexp.MustCompile(`(\d+)\s+\d+\s+obj\b`)
+	pdfStreamPat    = regexp.MustCompile(`>>\s*stream(?:\r\n|\n|\r)`)
+	pdfIntObjPat    = regexp.MustCompile(`(\d+)\s+\d+\s+obj\s*(\d+)\s*endobj`)
+	pdfLengthPat    = regexp.MustCompile(`/Length\s+(\d+)(?:\s+\d+\s+R)?`)
+	pdfFilterPat    = regexp.MustCompile(`/Filter\s*(\[[^\]]*\]|/[^\s/\[\]<>()]+)`)
+	pdfNamePat      = regexp.MustCompile(`/([^\s/\[\]<>()]+)`)
+	pdfTypePat      = regexp.MustCompile(`/Type\s*/([^\s/\[\]<>()]+)`)
+	pdfSubtypePat   = regexp.MustCompile(`/Subtype\s*/([^\s/\[\]<>()]+)`)
+	pdfFontFilePat  = regexp.MustCompile(`/Length[123]\s`)
+)
+
+type pdfStreamKind int
+
+const (
+	pdfStreamContent pdfStreamKind = iota
+	pdfStreamObjects
+	pdfStreamMetadata
+	pdfStreamEmbeddedFile
+	pdfStreamSkipped
+)
+
+// pdfObject is an indirect object in a PDF file. dict holds the object's body, or its stream dictionary
+// when the object is a stream, in which case stream holds the raw (still encoded) stream data.
+type pdfObject struct {
+	dict   []byte
+	stream []byte
+}
+
+// streamKind classifies a stream object by its dictionary to decide how its decoded data is handled.
+func (o pdfObject) streamKind() pdfStreamKind {
+	var typ, subtype string
+	if m := pdfTypePat.FindSubmatch(o.dict); m != nil {
+		typ = string(m[1])
+	}
+	if m := pdfSubtypePat.FindSubmatch(o.dict); m != nil {
+		subtype = string(m[1])
+	}
+
+	switch {
+	case typ == "EmbeddedFile":
+		return pdfStreamEmbeddedFile
+	case typ == "ObjStm":
+		return pdfStreamObjects
+	case typ == "Metadata":
+		return pdfStreamMetadata
+	case typ == "XRef", subtype == "Image", pdfFontFilePat.Match(o.dict),
+		subtype == "Type1C", subtype == "CIDFontType0C", subtype == "OpenType":
+		return pdfStreamSkipped
+	default:
+		return pdfStreamContent
+	}
+}
+
+// parsePDFObjects scans data for indirect objects in file order. Stream lengths are taken from the /Length
+// entry when it is consistent with the data and otherwise from the position of the endstream keyword.
+func parsePDFObjects(data []byte) []pdfObject {
+	// Resolve indirect stream lengths, which are stored as separate integer objects.
+	lengths := make(map[string]int)
+	for _, m := range pdfIntObjPat.FindAllSubmatch(data, -1) {
+		if n, err := strconv.Atoi(string(m[2])); err == nil {
+			lengths[string(m[1])] = n
+		}
+	}
+
+	var objs []pdfObject
+	pos := 0
+	for pos < len(data) {
+		loc := pdfObjHeaderPat.FindIndex(data[pos:])
+		if loc == nil {
+			break
+		}
+		start := pos + loc[1]
+		body := data[start:]
+
+		end := bytes.Index(body, []byte("endobj"))
+		streamLoc := pdfStreamPat.FindIndex(body)
+		if streamLoc == nil || (end >= 0 && streamLoc[0] > end) {
+			if end < 0 {
+				objs = append(objs, pdfObject{dict: body})
+				break
+			}
+			objs = append(objs, pdfObject{dict: body[:end]})
+			pos = start + end + len("endobj")
+			continue
+		}
+
+		dict := body[:streamLoc[0]+2]
+		streamStart := start + streamLoc[1]
+		streamEnd := pdfStreamEnd(data, streamStart, dict, lengths)
+		objs = append(objs, pdfObject{dict: dict, stream: data[streamStart:streamEnd]})
+
+		pos = streamEnd
+		if next := bytes.Index(data[pos:], []byte("endstream")); next >= 0 {
+			pos += next + len("endstream")
+		}
+	}
+	return objs
+}
+
+// pdfStreamEnd returns the offset just past the stream data beginning at start.
+func pdfStreamEnd(data []byte, start int, dict []byte, lengths map[string]int) int {
+	if m := pdfLengthPat.FindSubmatch(dict); m != nil {
+		n, err := strconv.Atoi(string(m[1]))
+		if bytes.HasSuffix(bytes.TrimSpace(m[0]), []byte("R")) {
+			var ok bool
+			n, ok = lengths[string(m[1])]
+			if !ok {
+				err = errors.New("unresolved length")
+			}
+		}
+		if err == nil && n >= 0 && start+n <= len(data) &&
+			bytes.HasPrefix(bytes.TrimLeft(data[start+n:], "\r\n\t "), []byte("endstream")) {
+			return start + n
+		}
+	}
+
+	end := bytes.Index(data[start:], []byte("endstream"))
+	if end < 0 {
+		return len(data)
+	}
+	return start + len(bytes.TrimRight(data[start:start+end], "\r\n"))
+}
+
+// decodePDFStream applies the stream's filters in order, inflating at most limit bytes. Filters used for images,
+// such as DCTDecode, are not supported and result in errPDFUnsupportedFilter.
+func decodePDFStream(dict, stream []byte, limit int) ([]byte, error) {
+	m := pdfFilterPat.FindSubmatch(dict)
+	if m == nil {
+		return stream, nil
+	}
+
+	data := stream
+	for _, name := range pdfNamePat.FindAllSubmatch(m[1], -1) {
+		var err error
+		switch string(name[1]) {
+		case "FlateDecode", "Fl":
+			data, err = inflatePDFStream(data, limit)
+		case "ASCIIHexDecode", "AHx":
+			data, err = decodePDFHex(data)
+		case "ASCII85Decode", "A85":
+			data, err = decodePDFASCII85(data)
+		default:
+			return nil, fmt.Errorf("%w: %s", errPDFUnsupportedFilter, name[1])
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// inflatePDFStream inflates zlib data, falling back to raw deflate for streams written without a zlib header.
+// Output is capped at limit, the part of the max size the document has left. Truncated streams are common in the
+// wild, so whatever was inflated before an unexpected EOF is returned.
+func inflatePDFStream(data []byte, limit int) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		r = flate.NewReader(bytes.NewReader(data))
+	}
+	defer r.Close()
+
+	out, err := io.ReadAll(io.LimitReader(r, int64(limit)))
+	if err != nil && !(errors.Is(err, io.ErrUnexpectedEOF) && len(out) > 0) {
+		return nil, fmt.Errorf("error inflating stream: %w", err)
+	}
+	return out, nil
+}
+
+func decodePDFHex(data []byte) ([]byte, error) {
+	if end := bytes.IndexByte(data, '>'); end >= 0 {
+		data = data[:end]
+	}
+	out := make([]byte, 0, len(data)/2)
+	var hi byte
+	var haveHi bool
+	for _, c := range data {
+		v, ok := pdfHexValue(c)
+		if !ok {
+			if isPDFWhitespace(c) {
+				continue
+			}
+			return nil, fmt.Errorf("invalid hex digit %q", c)
+		}
+		if haveHi {
+			out = append(out, hi<<4|v)
+		} else {
+			hi = v
+		}
+		haveHi = !haveHi
+	}
+	if haveHi {
+		out = append(out, hi<<4)
+	}
+	return out, nil
+}
+
+func decodePDFASCII85(data []byte) ([]byte, error) {
+	data = bytes.TrimSpace(data)
+	data = bytes.TrimPrefix(data, []byte("<~"))
+	if end := bytes.Index(data, []byte("~>")); end >= 0 {
+		data = data[:end]
+	}
+	// Each "z" stands for four zero bytes, so the output can be four times the size of the input.
+	out := make([]byte, 4*len(data)+4)
+	n, _, err := ascii85.Decode(out, data, true)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ASCII85: %w", err)
+	}
+	return out[:n], nil
+}
+
+// extractPDFText returns the text shown by the text operators of a content stream, one text line per line.
+// Large negative adjustments inside TJ arrays are the usual way of encoding word spacing, so they are
+// emitted as spaces.
+func extractPDFText(content []byte) []byte {
+	var (
+		out     bytes.Buffer
+		pending []byte
+		nums    []float64
+		inArray bool
+	)
+
+	newline := func() {
+		if out.Len() > 0 && out.Bytes()[out.Len()-1] != '\n' {
+			out.WriteByte('\n')
+		}
+	}
+
+	lex := pdfLexer{data: content}
+	for {
+		tok, ok := lex.next()
+		if !ok {
+			break
+		}
+
+		switch tok.kind {
+		case pdfTokString:
+			pending = append(pending, pdfTextString(tok.value)...)
+		case pdfTokNumber:
+			n, _ := strconv.ParseFloat(string(tok.value), 64)
+			if inArray && n < -200 {
+				pending = append(pending, ' ')
+			}
+			nums = append(nums, n)
+		case pdfTokArrayStart:
+			inArray = true
+		case pdfTokArrayEnd:
+			inArray = false
+		case pdfTokOperator:
+			switch string(tok.value) {
+			case "Tj", "TJ":
+				out.Write(pending)
+			case "'", `"`:
+				newline()
+				out.Write(pending)
+			case "Td", "TD":
+				if len(nums) >= 2 && nums[len(nums)-1] != 0 {
+					newline()
+				} else if out.Len() > 0 {
+					out.WriteByte(' ')
+				}
+			case "T*", "Tm", "ET":
+				newline()
+			case "BI":
+				lex.skipInlineImage()
+			}
+			pending, nums, inArray = pending[:0], nums[:0], false
+		}
+	}
+	newline()
+	return out.Bytes()
+}
+
+// extractPDFStrings returns the string values found in PDF object syntax, one per line. When a string is
+// the value of a dictionary key the key is included, e.g. "Author: jdoe".
+func extractPDFStrings(body []byte) []byte {
+	var (
+		out     bytes.Buffer
+		lastKey []byte
+	)
+
+	lex := pdfLexer{data: body}
+	for {
+		tok, ok := lex.next()
+		if !ok {
+			break
+		}
+
+		switch tok.kind {
+		case pdfTokName:
+			lastKey = tok.value
+			continue
+		case pdfTokString:
+			// Hex strings are mostly binary identifiers, such as the document /ID, unless they hold UTF-16 text.
+			if tok.hex && !isPDFPrintable(tok.value) && !bytes.HasPrefix(tok.value, []byte{0xFE, 0xFF}) {
+				break
+			}
+			s := pdfTextString(tok.value)
+			if len(bytes.TrimSpace(s)) == 0 {
+				break
+			}
+			if lastKey != nil {
+				out.Write(lastKey)
+				out.WriteString(": ")
+			}
+			out.Write(s)
+			out.WriteByte('\n')
+		}
+		lastKey = nil
+	}
+	return out.Bytes()
+}
+
+// pdfTextString converts a PDF string to UTF-8. Strings with a UTF-16BE or UTF-8 byte order mark are
+// decoded accordingly and other strings are treated as Latin-1, which matches PDFDocEncoding for the
+// printable ASCII range. Control characters other than whitespace are dropped.
+func pdfTextString(b []byte) []byte {
+	var runes []rune
+	switch {
+	case len(b) >= 2 && b[0] == 0xFE && b[1] == 0xFF:
+		units := make([]uint16, 0, (len(b)-2)/2)
+		for i := 2; i+1 < len(b); i += 2 {
+			units = append(units, uint16(b[i])<<8|uint16(b[i+1]))
+		}
+		runes = utf16.Decode(units)
+	case len(b) >= 3 && b[0] == 0xEF && b[1] == 0xBB && b[2] == 0xBF:
+		runes = bytes.Runes(b[3:])
+	default:
+		runes = make([]rune, len(b))
+		for i, c := range b {
+			runes[i] = rune(c)
+		}
+	}
+
+	out := make([]byte, 0, len(b))
+	for _, r := range runes {
+		if r < 0x20 && r != '\n' && r != '\r' && r != '\t' {
+			continue
+		}
+		out = utf8.AppendRune(out, r)
+	}
+	return out
+}
+
+func isPDFPrintable(b []byte) bool {
+	for _, c := range b {
+		if (c < 0x20 || c > 0x7E) && !isPDFWhitespace(c) {
+			return false
+		}
+	}
+	return true
+}
+
+type pdfTokenKind int
+
+const (
+	pdfTokString pdfTokenKind = iota
+	pdfTokName
+	pdfTokNumber
+	pdfTokOperator
+	pdfTokArrayStart
+	pdfTokArrayEnd
+	pdfTokDictStart
+	pdfTokDictEnd
+)
+
+type pdfToken struct {
+	kind pdfTokenKind
+	// value holds the decoded bytes for strings, the name without its leading slash for names, and the raw
+	// token otherwise.
+	value []byte
+	hex   bool
+}
+
+// pdfLexer tokenizes PDF object and content stream syntax.
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func (l *pdfLexer) next() (pdfToken, bool) {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		switch {
+		case isPDFWhitespace(c):
+			l.pos++
+		case c == '%':
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+		case c == '(':
+			return pdfToken{kind: pdfTokString, value: l.literalString()}, true
+		case c == '<' && l.peek(1) == '<':
+			l.pos += 2
+			return pdfToken{kind: pdfTokDictStart}, true
+		case c == '>' && l.peek(1) == '>':
+			l.pos += 2
+			return pdfToken{kind: pdfTokDictEnd}, true
+		case c == '<':
+			start := l.pos + 1
+			end := bytes.IndexByte(l.data[start:], '>')
+			if end < 0 {
+				l.pos = len(l.data)
+				return pdfToken{}, false
+			}
+			l.pos = start + end + 1
+			value, err := decodePDFHex(l.data[start : start+end])
+			if err != nil {
+				continue
+			}
+			return pdfToken{kind: pdfTokString, value: value, hex: true}, true
+		case c == '[':
+			l.pos++
+			return pdfToken{kind: pdfTokArrayStart}, true
+		case c == ']':
+			l.pos++
+			return pdfToken{kind: pdfTokArrayEnd}, true
+		case c == '/':
+			l.pos++
+			return pdfToken{kind: pdfTokName, value: l.regular()}, true
+		case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+			return pdfToken{kind: pdfTokNumber, value: l.regular()}, true
+		case isPDFDelimiter(c):
+			// Stray delimiters such as ')', '{' or '}'.
+			l.pos++
+		default:
+			return pdfToken{kind: pdfTokOperator, value: l.regular()}, true
+		}
+	}
+	return pdfToken{}, false
+}
+
+func (l *pdfLexer) peek(offset int) byte {
+	if l.pos+offset < len(l.data) {
+		return l.data[l.pos+offset]
+	}
+	return 0
+}
+
+// regular consumes a run of regular characters, which make up names, numbers and operators.
+func (l *pdfLexer) regular() []byte {
+	start := l.pos
+	for l.pos < len(l.data) && !isPDFWhitespace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	// Guard against making no progress on unexpected input.
+	if l.pos == start {
+		l.pos++
+	}
+	return l.data[start:l.pos]
+}
+
+// literalString consumes a parenthesized string, handling nested parentheses and escape sequences.
+func (l *pdfLexer) literalString() []byte {
+	var out []byte
+	depth := 0
+	for l.pos++; l.pos < len(l.data); l.pos++ {
+		c := l.data[l.pos]
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				l.pos++
+				return out
+			}
+			depth--
+		case '\\':
+			l.pos++
+			if l.pos >= len(l.data) {
+				return out
+			}
+			e := l.data[l.pos]
+			switch e {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r':
+				// A backslash at the end of a line continues the string on the next line.
+				if l.peek(1) == '\n' {
+					l.pos++
+				}
+				continue
+			case '\n':
+				continue
+			default:
+				if e >= '0' && e <= '7' {
+					v := e - '0'
+					for i := 0; i < 2 && l.peek(1) >= '0' && l.peek(1) <= '7'; i++ {
+						l.pos++
+						v = v<<3 | (l.data[l.pos] - '0')
+					}
+					c = v
+				} else {
+					c = e
+				}
+			}
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// skipInlineImage skips the binary data of an inline image, which runs from the ID operator to the EI
+// operator.
+func (l *pdfLexer) skipInlineImage() {
+	id := bytes.Index(l.data[l.pos:], []byte("ID"))
+	if id < 0 {
+		l.pos = len(l.data)
+		return
+	}
+	start := l.pos + id + len("ID")
+	for i := start; i+2 <= len(l.data); i++ {
+		if l.data[i] == 'E' && l.data[i+1] == 'I' && isPDFWhitespace(l.data[i-1]) &&
+			(i+2 == len(l.data) || isPDFWhitespace(l.data[i+2])) {
+			l.pos = i + 2
+			return
+		}
+	}
+	l.pos = len(l.data)
+}
+
+func isPDFWhitespace(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+func isPDFDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
+
+func pdfHexValue(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
@@ -108,7 +108,7 @@ func (h *rpmHandler) processRPMFiles(
 			}
 
 			fileSize := fileInfo.Size()
-			fileCtx := logContext.WithValues(ctx, "filename", fileInfo.Name, "size", fileSize)
+			fileCtx := logContext.WithValues(withArchiveMember(ctx, fileInfo.Name()), "filename", fileInfo.Name(), "size", fileSize)
 
 			rdr, err := newMimeTypeReader(reader)
 			if err != nil {
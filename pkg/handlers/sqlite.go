@@ -0,0 +1,666 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// sqliteHandler extracts the rows of SQLite database files. Scanning a database as raw pages splits row values
+// across page boundaries and separates them from their column names, so instead the handler walks the b-tree of
+// every table, including WITHOUT ROWID tables, and reassembles records that overflow onto other pages.
+//
+// Each value is written on its own line prefixed with its table, rowid and column, e.g.
+// "users[rowid=3].password: hunter2", so every chunk carries the location of the values it holds and detectors
+// that rely on nearby keywords see the column name. Each table is sent as separate content.
+//
+// The file format is parsed directly, so no cgo SQLite driver is required.
+type sqliteHandler struct{ *defaultHandler }
+
+// newSQLiteHandler creates a sqliteHandler.
+func newSQLiteHandler() *sqliteHandler {
+	return &sqliteHandler{defaultHandler: newDefaultHandler(sqliteHandlerType)}
+}
+
+// HandleFile processes SQLite database files and returns a channel of DataOrErr.
+//
+// Fatal errors that will terminate processing include:
+// - Context cancellation
+// - Context deadline exceeded
+// - Maximum archive depth exceeded
+// - Errors reading the file or its schema
+// - Panics during processing (recovered and returned as fatal errors)
+//
+// Non-fatal errors that will be logged but allow processing to continue include:
+// - Tables whose pages or records fail to parse
+func (h *sqliteHandler) HandleFile(ctx logContext.Context, input fileReader) chan DataOrErr {
+	dataOrErrChan := make(chan DataOrErr, defaultBufferSize)
+
+	go func() {
+		defer close(dataOrErrChan)
+
+		// Defer a panic recovery to handle any panics that occur while parsing corrupt databases.
+		defer func() {
+			if r := recover(); r != nil {
+				var panicErr error
+				if e, ok := r.(error); ok {
+					panicErr = e
+				} else {
+					panicErr = fmt.Errorf("panic occurred: %v", r)
+				}
+				dataOrErrChan <- DataOrErr{
+					Err: fmt.Errorf("%w: panic error: %v", ErrProcessingFatal, panicErr),
+				}
+			}
+		}()
+
+		start := time.Now()
+		err := h.processSQLite(ctx, 0, input, dataOrErrChan)
+		if err == nil {
+			h.metrics.incFilesProcessed()
+		}
+
+		// Update the metrics for the file processing and handle any errors.
+		h.measureLatencyAndHandleErrors(ctx, start, err, dataOrErrChan)
+	}()
+
+	return dataOrErrChan
+}
+
+// processSQLite extracts the rows of a single database and sends them to the provided channel.
+func (h *sqliteHandler) processSQLite(
+	ctx logContext.Context,
+	depth int,
+	reader io.Reader,
+	dataOrErrChan chan DataOrErr,
+) error {
+	if common.IsDone(ctx) {
+		return ctx.Err()
+	}
+
+	if depth >= maxDepth {
+		h.metrics.incMaxArchiveDepthCount()
+		return ErrMaxDepthReached
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, int64(maxSize)+1))
+	if err != nil {
+		return fmt.Errorf("error reading database: %w", err)
+	}
+	if len(data) > maxSize {
+		ctx.Logger().V(2).Info("skipping database: size exceeds max allowed", "limit", maxSize)
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+
+	db, err := parseSQLiteDB(data)
+	if err != nil {
+		return err
+	}
+	tables, err := db.tables()
+	if err != nil {
+		return fmt.Errorf("error reading database schema: %w", err)
+	}
+
+	for _, table := range tables {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		lCtx := logContext.WithValues(ctx, "table", table.name)
+
+		var text bytes.Buffer
+		if err := db.dumpTable(&text, table); err != nil {
+			h.metrics.incErrors()
+			warn := DataOrErr{Err: fmt.Errorf("%w: error reading table %s: %v", ErrProcessingWarning, table.name, err)}
+			if writeErr := common.CancellableWrite(lCtx, dataOrErrChan, warn); writeErr != nil {
+				return writeErr
+			}
+		}
+		if text.Len() == 0 {
+			continue
+		}
+
+		h.metrics.observeFileSize(int64(text.Len()))
+		rdr := mimeTypeReader{mimeExt: ".txt", mimeName: textMime, Reader: &text}
+		if err := h.handleNonArchiveContent(lCtx, rdr, dataOrErrChan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sqliteDB is a read-only view of an SQLite database file.
+type sqliteDB struct {
+	data     []byte
+	pageSize int
+	// usable is the page size minus the bytes reserved at the end of each page by extensions.
+	usable   int
+	encoding int
+	numPages int
+}
+
+// sqliteTable is a table listed in the sqlite_schema table.
+type sqliteTable struct {
+	name     string
+	rootPage int
+	columns  []string
+	// rowidColumn is the index of the INTEGER PRIMARY KEY column, which aliases the rowid and is stored as NULL,
+	// or -1 if the table has none.
+	rowidColumn  int
+	withoutRowid bool
+}
+
+const (
+	sqliteHeaderSize = 100
+
+	sqliteEncodingUTF8    = 1
+	sqliteEncodingUTF16LE = 2
+	sqliteEncodingUTF16BE = 3
+
+	sqlitePageInteriorIndex = 0x02
+	sqlitePageInteriorTable = 0x05
+	sqlitePageLeafIndex     = 0x0A
+	sqlitePageLeafTable     = 0x0D
+)
+
+var (
+	sqliteMagic = []byte("SQLite format 3\x00")
+
+	errSQLiteCorrupt = errors.New("corrupt database")
+)
+
+// parseSQLiteDB validates the database header.
+func parseSQLiteDB(data []byte) (*sqliteDB, error) {
+	if len(data) < sqliteHeaderSize || !bytes.HasPrefix(data, sqliteMagic) {
+		return nil, errors.New("not an SQLite database")
+	}
+
+	pageSize := int(binary.BigEndian.Uint16(data[16:]))
+	if pageSize == 1 {
+		pageSize = 65536
+	}
+	if pageSize < 512 || pageSize&(pageSize-1) != 0 {
+		return nil, fmt.Errorf("%w: invalid page size %d", errSQLiteCorrupt, pageSize)
+	}
+
+	db := &sqliteDB{
+		data:     data,
+		pageSize: pageSize,
+		usable:   pageSize - int(data[20]),
+		encoding: int(binary.BigEndian.Uint32(data[56:])),
+		numPages: len(data) / pageSize,
+	}
+	if db.usable < 480 {
+		return nil, fmt.Errorf("%w: invalid reserved space", errSQLiteCorrupt)
+	}
+	if db.encoding == 0 {
+		db.encoding = sqliteEncodingUTF8
+	}
+	return db, nil
+}
+
+// page returns page n, numbered from 1.
+func (db *sqliteDB) page(n int) ([]byte, error) {
+	if n < 1 || n > db.numPages {
+		return nil, fmt.Errorf("%w: page %d out of range", errSQLiteCorrupt, n)
+	}
+	return db.data[(n-1)*db.pageSize : n*db.pageSize], nil
+}
+
+// tables returns the tables with storage listed in the schema. Virtual tables have no storage of their own and
+// are skipped, while their shadow tables, such as the content tables of full-text indexes, are included.
+func (db *sqliteDB) tables() ([]sqliteTable, error) {
+	var tables []sqliteTable
+	err := db.walk(1, false, func(_ int64, payload []byte) error {
+		values, err := db.parseRecord(payload)
+		if err != nil || len(values) < 5 {
+			return nil
+		}
+		typ, name, sql := db.text(values[0]), db.text(values[1]), db.text(values[4])
+		root, ok := values[3].int()
+		if typ != "table" || !ok || root <= 0 {
+			return nil
+		}
+
+		columns, rowidColumn := parseSQLiteColumns(sql)
+		tables = append(tables, sqliteTable{
+			name:         name,
+			rootPage:     int(root),
+			columns:      columns,
+			rowidColumn:  rowidColumn,
+			withoutRowid: sqliteWithoutRowid(sql),
+		})
+		return nil
+	})
+
+	// The schema table itself is included, as it holds the SQL of views and triggers.
+	tables = append([]sqliteTable{{
+		name:        "sqlite_schema",
+		rootPage:    1,
+		columns:     []string{"type", "name", "tbl_name", "rootpage", "sql"},
+		rowidColumn: -1,
+	}}, tables...)
+	return tables, err
+}
+
+// dumpTable writes the values of every row of the table, one "table[rowid=N].column: value" line each.
+func (db *sqliteDB) dumpTable(out *bytes.Buffer, table sqliteTable) error {
+	return db.walk(table.rootPage, table.withoutRowid, func(rowid int64, payload []byte) error {
+		values, err := db.parseRecord(payload)
+		if err != nil {
+			return err
+		}
+
+		prefix := table.name
+		if !table.withoutRowid {
+			prefix += "[rowid=" + strconv.FormatInt(rowid, 10) + "]"
+		}
+		for i, v := range values {
+			s, ok := db.format(v)
+			if i == table.rowidColumn && v.kind == sqliteNull {
+				s, ok = strconv.FormatInt(rowid, 10), true
+			}
+			if !ok {
+				continue
+			}
+
+			column := "column" + strconv.Itoa(i+1)
+			if i < len(table.columns) {
+				column = table.columns[i]
+			}
+			out.WriteString(prefix)
+			out.WriteByte('.')
+			out.WriteString(column)
+			out.WriteString(": ")
+			out.WriteString(s)
+			out.WriteByte('\n')
+		}
+		if out.Len() > maxSize {
+			return errors.New("table exceeds max size")
+		}
+		return nil
+	})
+}
+
+// walk calls fn with the rowid and payload of every cell of the b-tree rooted at root, in key order. Index
+// b-trees, which store WITHOUT ROWID tables, have no rowids and fn receives 0.
+func (db *sqliteDB) walk(root int, index bool, fn func(rowid int64, payload []byte) error) error {
+	visited := make(map[int]struct{})
+	var visit func(n int) error
+	visit = func(n int) error {
+		if _, ok := visited[n]; ok {
+			return fmt.Errorf("%w: page %d is referenced more than once", errSQLiteCorrupt, n)
+		}
+		visited[n] = struct{}{}
+
+		page, err := db.page(n)
+		if err != nil {
+			return err
+		}
+		hdr := 0
+		if n == 1 {
+			hdr = sqliteHeaderSize
+		}
+		if hdr+8 > len(page) {
+			return fmt.Errorf("%w: truncated page %d", errSQLiteCorrupt, n)
+		}
+
+		kind := page[hdr]
+		interior := kind == sqlitePageInteriorTable || kind == sqlitePageInteriorIndex
+		switch {
+		case !index && (kind == sqlitePageInteriorTable || kind == sqlitePageLeafTable):
+		case index && (kind == sqlitePageInteriorIndex || kind == sqlitePageLeafIndex):
+		default:
+			return fmt.Errorf("%w: unexpected page type %#x on page %d", errSQLiteCorrupt, kind, n)
+		}
+
+		numCells := int(binary.BigEndian.Uint16(page[hdr+3:]))
+		cellPtrs := hdr + 8
+		if interior {
+			cellPtrs = hdr + 12
+		}
+		if cellPtrs+2*numCells > len(page) {
+			return fmt.Errorf("%w: truncated cell pointers on page %d", errSQLiteCorrupt, n)
+		}
+
+		for i := 0; i < numCells; i++ {
+			off := int(binary.BigEndian.Uint16(page[cellPtrs+2*i:]))
+			if off >= db.usable {
+				return fmt.Errorf("%w: cell out of range on page %d", errSQLiteCorrupt, n)
+			}
+			cell := page[off:db.usable]
+
+			if interior {
+				if len(cell) < 4 {
+					return fmt.Errorf("%w: truncated cell on page %d", errSQLiteCorrupt, n)
+				}
+				if err := visit(int(binary.BigEndian.Uint32(cell))); err != nil {
+					return err
+				}
+				if !index {
+					// Interior table cells only hold a key to guide searches.
+					continue
+				}
+				cell = cell[4:]
+			}
+
+			size, k := sqliteVarint(cell)
+			if k == 0 || size < 0 {
+				return fmt.Errorf("%w: invalid cell on page %d", errSQLiteCorrupt, n)
+			}
+			cell = cell[k:]
+			var rowid int64
+			if !index {
+				if rowid, k = sqliteVarint(cell); k == 0 {
+					return fmt.Errorf("%w: invalid cell on page %d", errSQLiteCorrupt, n)
+				}
+				cell = cell[k:]
+			}
+
+			payload, err := db.payload(cell, int(size), index)
+			if err != nil {
+				return err
+			}
+			if err := fn(rowid, payload); err != nil {
+				return err
+			}
+		}
+
+		if interior {
+			return visit(int(binary.BigEndian.Uint32(page[hdr+8:])))
+		}
+		return nil
+	}
+	return visit(root)
+}
+
+// payload returns the payload of a cell, following its overflow pages if it does not fit in the page.
+func (db *sqliteDB) payload(cell []byte, size int, index bool) ([]byte, error) {
+	if size > maxSize {
+		return nil, fmt.Errorf("%w: payload exceeds max size", errSQLiteCorrupt)
+	}
+
+	// The amount of payload stored on the page itself is defined by the file format.
+	maxLocal := db.usable - 35
+	if index {
+		maxLocal = (db.usable-12)*64/255 - 23
+	}
+	local := size
+	if size > maxLocal {
+		minLocal := (db.usable-12)*32/255 - 23
+		local = minLocal + (size-minLocal)%(db.usable-4)
+		if local > maxLocal {
+			local = minLocal
+		}
+	}
+	if local > len(cell) {
+		return nil, fmt.Errorf("%w: truncated payload", errSQLiteCorrupt)
+	}
+
+	out := make([]byte, 0, size)
+	out = append(out, cell[:local]...)
+	if local == size {
+		return out, nil
+	}
+	if local+4 > len(cell) {
+		return nil, fmt.Errorf("%w: truncated payload", errSQLiteCorrupt)
+	}
+
+	next := int(binary.BigEndian.Uint32(cell[local:]))
+	for pages := 0; len(out) < size; pages++ {
+		if next == 0 || pages > db.numPages {
+			return nil, fmt.Errorf("%w: broken overflow chain", errSQLiteCorrupt)
+		}
+		page, err := db.page(next)
+		if err != nil {
+			return nil, err
+		}
+		chunk := page[4:db.usable]
+		if remaining := size - len(out); len(chunk) > remaining {
+			chunk = chunk[:remaining]
+		}
+		out = append(out, chunk...)
+		next = int(binary.BigEndian.Uint32(page))
+	}
+	return out, nil
+}
+
+type sqliteValueKind int
+
+const (
+	sqliteNull sqliteValueKind = iota
+	sqliteInt
+	sqliteFloat
+	sqliteText
+	sqliteBlob
+)
+
+type sqliteValue struct {
+	kind sqliteValueKind
+	i    int64
+	f    float64
+	b    []byte
+}
+
+func (v sqliteValue) int() (int64, bool) { return v.i, v.kind == sqliteInt }
+
+// parseRecord decodes a record, which is a header of serial types, one per column, followed by the values.
+func (db *sqliteDB) parseRecord(payload []byte) ([]sqliteValue, error) {
+	hdrSize, k := sqliteVarint(payload)
+	if k == 0 || hdrSize < int64(k) || hdrSize > int64(len(payload)) {
+		return nil, fmt.Errorf("%w: invalid record header", errSQLiteCorrupt)
+	}
+
+	var values []sqliteValue
+	body := payload[hdrSize:]
+	for hdr := payload[k:hdrSize]; len(hdr) > 0; {
+		typ, n := sqliteVarint(hdr)
+		if n == 0 || typ < 0 {
+			return nil, fmt.Errorf("%w: invalid serial type", errSQLiteCorrupt)
+		}
+		hdr = hdr[n:]
+
+		var v sqliteValue
+		size := 0
+		switch {
+		case typ == 0:
+		case typ >= 1 && typ <= 6:
+			size = [...]int{0, 1, 2, 3, 4, 6, 8}[typ]
+			if size > len(body) {
+				return nil, fmt.Errorf("%w: truncated record", errSQLiteCorrupt)
+			}
+			var i int64
+			for _, c := range body[:size] {
+				i = i<<8 | int64(c)
+			}
+			// Sign extend.
+			shift := 64 - 8*uint(size)
+			v = sqliteValue{kind: sqliteInt, i: i << shift >> shift}
+		case typ == 7:
+			size = 8
+			if size > len(body) {
+				return nil, fmt.Errorf("%w: truncated record", errSQLiteCorrupt)
+			}
+			v = sqliteValue{kind: sqliteFloat, f: math.Float64frombits(binary.BigEndian.Uint64(body))}
+		case typ == 8 || typ == 9:
+			v = sqliteValue{kind: sqliteInt, i: typ - 8}
+		case typ >= 12:
+			size = int((typ - 12) / 2)
+			if size > len(body) {
+				return nil, fmt.Errorf("%w: truncated record", errSQLiteCorrupt)
+			}
+			kind := sqliteBlob
+			if typ%2 == 1 {
+				kind = sqliteText
+			}
+			v = sqliteValue{kind: kind, b: body[:size]}
+		default:
+			return nil, fmt.Errorf("%w: reserved serial type %d", errSQLiteCorrupt, typ)
+		}
+		body = body[size:]
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// text returns a text value in UTF-8.
+func (db *sqliteDB) text(v sqliteValue) string {
+	if v.kind != sqliteText {
+		return ""
+	}
+	switch db.encoding {
+	case sqliteEncodingUTF16LE, sqliteEncodingUTF16BE:
+		var order binary.ByteOrder = binary.LittleEndian
+		if db.encoding == sqliteEncodingUTF16BE {
+			order = binary.BigEndian
+		}
+		units := make([]uint16, 0, len(v.b)/2)
+		for i := 0; i+1 < len(v.b); i += 2 {
+			units = append(units, order.Uint16(v.b[i:]))
+		}
+		return string(utf16.Decode(units))
+	default:
+		return string(v.b)
+	}
+}
+
+// format returns the textual form of a value. NULLs and binary blobs have none, while blobs holding UTF-8 text,
+// such as serialized JSON, are returned as is.
+func (db *sqliteDB) format(v sqliteValue) (string, bool) {
+	switch v.kind {
+	case sqliteInt:
+		return strconv.FormatInt(v.i, 10), true
+	case sqliteFloat:
+		return strconv.FormatFloat(v.f, 'g', -1, 64), true
+	case sqliteText:
+		s := db.text(v)
+		return s, s != ""
+	case sqliteBlob:
+		if len(v.b) == 0 || !utf8.Valid(v.b) || bytes.IndexByte(v.b, 0) >= 0 {
+			return "", false
+		}
+		return string(v.b), true
+	default:
+		return "", false
+	}
+}
+
+// sqliteVarint decodes a big-endian variable length integer of up to 9 bytes, returning the value and the
+// number of bytes read, or 0 if b is truncated.
+func sqliteVarint(b []byte) (int64, int) {
+	var v uint64
+	for i := 0; i < 9 && i < len(b); i++ {
+		if i == 8 {
+			return int64(v<<8 | uint64(b[i])), 9
+		}
+		v = v<<7 | uint64(b[i]&0x7F)
+		if b[i]&0x80 == 0 {
+			return int64(v), i + 1
+		}
+	}
+	return 0, 0
+}
+
+// sqliteTableConstraints are the keywords that start a table constraint rather than a column definition.
+var sqliteTableConstraints = map[string]struct{}{
+	"CONSTRAINT": {}, "PRIMARY": {}, "UNIQUE": {}, "CHECK": {}, "FOREIGN": {},
+}
+
+// parseSQLiteColumns returns the column names of a CREATE TABLE statement, and the index of the column that
+// aliases the rowid, if any. Only the structure of the statement is parsed, which is enough to name values.
+func parseSQLiteColumns(sql string) ([]string, int) {
+	start := strings.IndexByte(sql, '(')
+	if start < 0 {
+		return nil, -1
+	}
+
+	// Split the definitions on top-level commas, ignoring commas in nested parentheses and quotes.
+	var (
+		defs  []string
+		depth int
+		quote byte
+		last  = start + 1
+	)
+scan:
+	for i := start + 1; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'' || c == '`':
+			quote = c
+		case c == '[':
+			quote = ']'
+		case c == '(':
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case c == ',' && depth == 0:
+			defs = append(defs, strings.TrimSpace(sql[last:i]))
+			last = i + 1
+		case c == ')':
+			defs = append(defs, strings.TrimSpace(sql[last:i]))
+			break scan
+		}
+	}
+
+	columns := make([]string, 0, len(defs))
+	rowidColumn := -1
+	for _, def := range defs {
+		fields := strings.Fields(def)
+		if len(fields) == 0 {
+			continue
+		}
+		if _, ok := sqliteTableConstraints[strings.ToUpper(fields[0])]; ok {
+			break
+		}
+
+		name, rest := sqliteIdentifier(def)
+		upper := strings.ToUpper(strings.Join(strings.Fields(rest), " "))
+		if strings.HasPrefix(upper, "INTEGER ") && strings.Contains(upper, "PRIMARY KEY") &&
+			!strings.Contains(upper, "PRIMARY KEY DESC") {
+			rowidColumn = len(columns)
+		}
+		columns = append(columns, name)
+	}
+	return columns, rowidColumn
+}
+
+// sqliteIdentifier splits a column definition into its unquoted name and the rest of the definition.
+func sqliteIdentifier(def string) (string, string) {
+	if def == "" {
+		return "", ""
+	}
+	closing := map[byte]byte{'"': '"', '`': '`', '[': ']', '\'': '\''}
+	if c, ok := closing[def[0]]; ok {
+		if end := strings.IndexByte(def[1:], c); end >= 0 {
+			return def[1 : end+1], def[end+2:] + " "
+		}
+	}
+	fields := strings.Fields(def)
+	return fields[0], strings.TrimPrefix(def, fields[0]) + " "
+}
+
+// sqliteWithoutRowid reports whether a CREATE TABLE statement declares a WITHOUT ROWID table, which is
+// stored as an index b-tree.
+func sqliteWithoutRowid(sql string) bool {
+	end := strings.LastIndexByte(sql, ')')
+	if end < 0 {
+		return false
+	}
+	tail := strings.ToUpper(strings.Join(strings.Fields(sql[end+1:]), " "))
+	return strings.Contains(tail, "WITHOUT ROWID")
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+func TestSQLiteHandler(t *testing.T) {
+	file, err := os.Open("testdata/test.sqlite")
+	require.NoError(t, err)
+	defer file.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	rdr, err := newFileReader(ctx, file)
+	require.NoError(t, err)
+	defer rdr.Close()
+	assert.Equal(t, sqliteMime, mimeType(rdr.mime.String()))
+	assert.False(t, rdr.isGenericArchive)
+
+	handler := newSQLiteHandler()
+	dataOrErrChan := handler.HandleFile(context.AddLogger(ctx), rdr)
+
+	var sb strings.Builder
+	for dataOrErr := range dataOrErrChan {
+		require.NoError(t, dataOrErr.Err)
+		sb.Write(dataOrErr.Data)
+	}
+	got := sb.String()
+
+	wants := []string{
+		// The INTEGER PRIMARY KEY column aliases the rowid.
+		"users[rowid=1].id: 1\n",
+		"users[rowid=1].name: alice\n",
+		"users[rowid=1].api key: AKIAEXAMPLEALICE0001\n",
+		"users[rowid=1].created: 1.5\n",
+		// Record spilling onto overflow pages.
+		"password=overflow-secret-value\n",
+		// WITHOUT ROWID table spanning several pages.
+		"settings.key: key000\nsettings.value: value-0\n",
+		"settings.key: key149\nsettings.value: value-149\n",
+		// View definitions from the schema.
+		"CREATE VIEW admins AS SELECT name FROM users WHERE id = 1",
+	}
+	for _, want := range wants {
+		assert.Contains(t, got, want)
+	}
+	assert.NotContains(t, got, "users[rowid=1].notes")
+}
+
+func TestHandleFileSQLite(t *testing.T) {
+	file, err := os.Open("testdata/test.sqlite")
+	require.NoError(t, err)
+	defer file.Close()
+
+	chunkCh := make(chan *sources.Chunk, 16)
+	reporter := sources.ChanReporter{Ch: chunkCh}
+	require.NoError(t, HandleFile(context.Background(), file, &sources.Chunk{}, reporter))
+	close(chunkCh)
+
+	var sb strings.Builder
+	for chunk := range chunkCh {
+		sb.Write(chunk.Data)
+	}
+	assert.Contains(t, sb.String(), "users[rowid=1].api key: AKIAEXAMPLEALICE0001\n")
+}
+
+func TestSQLiteCorruptDatabase(t *testing.T) {
+	data, err := os.ReadFile("testdata/test.sqlite")
+	require.NoError(t, err)
+
+	// Truncate the database, leaving the schema intact but the pages of the tables missing.
+	db, err := parseSQLiteDB(data[:2048])
+	require.NoError(t, err)
+	tables, err := db.tables()
+	require.NoError(t, err)
+
+	var sawErr bool
+	for _, table := range tables {
+		var buf bytes.Buffer
+		if err := db.dumpTable(&buf, table); err != nil {
+			assert.ErrorIs(t, err, errSQLiteCorrupt)
+			sawErr = true
+		}
+	}
+	assert.True(t, sawErr)
+}
+
+func TestParseSQLiteColumns(t *testing.T) {
+	tests := []struct {
+		sql         string
+		wantColumns []string
+		wantRowid   int
+	}{
+		{
+			sql:         `CREATE TABLE t (id INTEGER PRIMARY KEY, name TEXT)`,
+			wantColumns: []string{"id", "name"},
+			wantRowid:   0,
+		},
+		{
+			sql:         `CREATE TABLE "t" ("api key" TEXT, [x y] VARCHAR(10, 2) DEFAULT ',', z, PRIMARY KEY ("api key"))`,
+			wantColumns: []string{"api key", "x y", "z"},
+			wantRowid:   -1,
+		},
+		{
+			sql:         `CREATE TABLE t (a INT PRIMARY KEY, b)`,
+			wantColumns: []string{"a", "b"},
+			wantRowid:   -1,
+		},
+		{
+			sql:         `CREATE TABLE t (k TEXT, v BLOB, PRIMARY KEY (k)) WITHOUT ROWID`,
+			wantColumns: []string{"k", "v"},
+			wantRowid:   -1,
+		},
+	}
+
+	for _, tt := range tests {
+		columns, rowid := parseSQLiteColumns(tt.sql)
+		assert.Equal(t, tt.wantColumns, columns, tt.sql)
+		assert.Equal(t, tt.wantRowid, rowid, tt.sql)
+	}
+}
@@ -0,0 +1,373 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// terraformHandler scans Terraform state files and the JSON output of `terraform show -json` for plans and states
+// resource by resource. State files hold provider credentials and generated passwords in large JSON documents, so
+// scanned as text their findings point to a line of the document rather than to the resource holding the secret.
+//
+// Each attribute value is written on its own line prefixed with its resource address and attribute path, e.g.
+// "module.db.aws_db_instance.main[0].password: hunter2", with line breaks within values escaped. Each resource,
+// output and variable is sent as separate content carrying its address, and the line it starts on within that
+// content, so the engine can resolve the attribute path of each finding from its line.
+//
+// A plan holds the same values in its prior state, planned values and resource changes; each value is written once
+// per resource. Documents that are not valid JSON, or hold none of these sections, are scanned as plain text.
+type terraformHandler struct{ *defaultHandler }
+
+// newTerraformHandler creates a terraformHandler.
+func newTerraformHandler() *terraformHandler {
+	return &terraformHandler{defaultHandler: newDefaultHandler(terraformHandlerType)}
+}
+
+// HandleFile processes Terraform state and plan files and returns a channel of DataOrErr.
+//
+// Fatal errors that will terminate processing include:
+// - Context cancellation
+// - Context deadline exceeded
+// - Errors reading the file
+// - Errors writing to the data channel
+func (h *terraformHandler) HandleFile(ctx logContext.Context, input fileReader) chan DataOrErr {
+	dataOrErrChan := make(chan DataOrErr, defaultBufferSize)
+
+	go func() {
+		defer close(dataOrErrChan)
+
+		start := time.Now()
+		err := h.processTerraform(ctx, 0, input, dataOrErrChan)
+		if err == nil {
+			h.metrics.incFilesProcessed()
+		}
+
+		// Update the metrics for the file processing and handle any errors.
+		h.measureLatencyAndHandleErrors(ctx, start, err, dataOrErrChan)
+	}()
+
+	return dataOrErrChan
+}
+
+// processTerraform sends the resources of a single state or plan to the provided channel.
+func (h *terraformHandler) processTerraform(
+	ctx logContext.Context,
+	depth int,
+	reader io.Reader,
+	dataOrErrChan chan DataOrErr,
+) error {
+	if common.IsDone(ctx) {
+		return ctx.Err()
+	}
+
+	if depth >= maxDepth {
+		h.metrics.incMaxArchiveDepthCount()
+		return ErrMaxDepthReached
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, int64(maxSize)+1))
+	if err != nil {
+		return fmt.Errorf("error reading terraform file: %w", err)
+	}
+	if len(data) > maxSize {
+		ctx.Logger().V(2).Info("skipping terraform file: size exceeds max allowed", "limit", maxSize)
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+
+	var doc terraformDocument
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// Numbers are kept as written, so large IDs are not rewritten in exponent notation.
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		ctx.Logger().V(3).Info("terraform file is not valid JSON, scanning as text", "error", err)
+		rdr := mimeTypeReader{mimeExt: ".txt", mimeName: textMime, Reader: bytes.NewReader(data)}
+		return h.handleNonArchiveContent(ctx, rdr, dataOrErrChan)
+	}
+
+	resources := doc.resources()
+	if len(resources.order) == 0 {
+		ctx.Logger().V(3).Info("terraform file holds no resources, scanning as JSON")
+		rdr := mimeTypeReader{mimeExt: ".json", mimeName: jsonMime, Reader: bytes.NewReader(data)}
+		return h.handleNonArchiveContent(ctx, rdr, dataOrErrChan)
+	}
+
+	for _, address := range resources.order {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		text := resources.text[address].Bytes()
+		h.metrics.observeFileSize(int64(len(text)))
+		if err := h.handleResource(logContext.WithValues(ctx, "resource", address), address, text, dataOrErrChan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleResource sends the values of a resource in chunks, each carrying the resource address and the line of the
+// resource's values it starts on.
+func (h *terraformHandler) handleResource(
+	ctx logContext.Context,
+	address string,
+	text []byte,
+	dataOrErrChan chan DataOrErr,
+) error {
+	return h.handleLocatedText(ctx, text, dataOrErrChan, func(dataOrErr *DataOrErr, line int64) {
+		dataOrErr.Resource, dataOrErr.ResourceLine = address, line
+	})
+}
+
+// terraformDocument is the subset of a state file (format version 4, written by Terraform 0.12 and later) and of
+// the JSON output of `terraform show -json` holding values worth scanning.
+//
+// https://developer.hashicorp.com/terraform/internals/json-format
+type terraformDocument struct {
+	// Outputs and Resources are the contents of a state file.
+	Outputs   map[string]terraformOutput `json:"outputs"`
+	Resources []terraformStateResource   `json:"resources"`
+
+	// Values is the state shown by `terraform show -json` without a plan.
+	Values *terraformValues `json:"values"`
+
+	// The remaining fields are the contents of a plan shown by `terraform show -json`.
+	Variables     map[string]terraformOutput `json:"variables"`
+	PlannedValues *terraformValues           `json:"planned_values"`
+	PriorState    *struct {
+		Values *terraformValues `json:"values"`
+	} `json:"prior_state"`
+	ResourceChanges []struct {
+		Address string          `json:"address"`
+		Change  terraformChange `json:"change"`
+	} `json:"resource_changes"`
+	OutputChanges map[string]terraformChange `json:"output_changes"`
+}
+
+type terraformOutput struct {
+	Value any `json:"value"`
+}
+
+type terraformStateResource struct {
+	Module    string `json:"module"`
+	Mode      string `json:"mode"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Instances []struct {
+		IndexKey   any `json:"index_key"`
+		Attributes any `json:"attributes"`
+	} `json:"instances"`
+}
+
+// address returns the address of an instance of the resource, e.g. `module.db.aws_db_instance.main[0]`.
+func (r *terraformStateResource) address(indexKey any) string {
+	var b strings.Builder
+	if r.Module != "" {
+		b.WriteString(r.Module)
+		b.WriteByte('.')
+	}
+	if r.Mode == "data" {
+		b.WriteString("data.")
+	}
+	b.WriteString(r.Type)
+	b.WriteByte('.')
+	b.WriteString(r.Name)
+	switch key := indexKey.(type) {
+	case json.Number:
+		b.WriteString("[" + key.String() + "]")
+	case string:
+		b.WriteString("[" + strconv.Quote(key) + "]")
+	}
+	return b.String()
+}
+
+type terraformValues struct {
+	Outputs    map[string]terraformOutput `json:"outputs"`
+	RootModule terraformModule            `json:"root_module"`
+}
+
+type terraformModule struct {
+	Resources []struct {
+		Address string `json:"address"`
+		Values  any    `json:"values"`
+	} `json:"resources"`
+	ChildModules []terraformModule `json:"child_modules"`
+}
+
+type terraformChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// resources returns the values of the document grouped by resource address, outputs and variables included.
+func (d *terraformDocument) resources() *terraformResources {
+	resources := &terraformResources{text: make(map[string]*bytes.Buffer), seen: make(map[string]struct{})}
+
+	for _, r := range d.Resources {
+		for _, instance := range r.Instances {
+			resources.add(r.address(instance.IndexKey), "", instance.Attributes)
+		}
+	}
+	for _, values := range []*terraformValues{d.Values, d.PlannedValues, d.priorState()} {
+		if values == nil {
+			continue
+		}
+		resources.addModule(&values.RootModule)
+		resources.addOutputs(values.Outputs)
+	}
+	for _, rc := range d.ResourceChanges {
+		resources.add(rc.Address, "", rc.Change.Before)
+		resources.add(rc.Address, "", rc.Change.After)
+	}
+	for _, name := range slices.Sorted(maps.Keys(d.OutputChanges)) {
+		resources.add("output."+name, "", d.OutputChanges[name].Before)
+		resources.add("output."+name, "", d.OutputChanges[name].After)
+	}
+	resources.addOutputs(d.Outputs)
+	for _, name := range slices.Sorted(maps.Keys(d.Variables)) {
+		resources.add("var."+name, "", d.Variables[name].Value)
+	}
+	return resources
+}
+
+func (d *terraformDocument) priorState() *terraformValues {
+	if d.PriorState == nil {
+		return nil
+	}
+	return d.PriorState.Values
+}
+
+// terraformResources accumulates the "address.path: value" lines of each resource in the order resources are first
+// seen, writing each line once.
+type terraformResources struct {
+	order []string
+	text  map[string]*bytes.Buffer
+	seen  map[string]struct{}
+}
+
+func (r *terraformResources) addModule(module *terraformModule) {
+	for _, resource := range module.Resources {
+		r.add(resource.Address, "", resource.Values)
+	}
+	for i := range module.ChildModules {
+		r.addModule(&module.ChildModules[i])
+	}
+}
+
+func (r *terraformResources) addOutputs(outputs map[string]terraformOutput) {
+	for _, name := range slices.Sorted(maps.Keys(outputs)) {
+		r.add("output."+name, "", outputs[name].Value)
+	}
+}
+
+// terraformValueEscaper escapes line breaks so that each value occupies a single line.
+var terraformValueEscaper = strings.NewReplacer("\r", `\r`, "\n", `\n`)
+
+// add writes the string and number values of value, found at path within the resource, as lines of the resource.
+// Booleans and nulls hold nothing worth scanning and are skipped.
+func (r *terraformResources) add(address, path string, value any) {
+	if address == "" {
+		return
+	}
+	var s string
+	switch v := value.(type) {
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			r.add(address, path+terraformKey(key), v[key])
+		}
+		return
+	case []any:
+		for i, elem := range v {
+			r.add(address, path+"["+strconv.Itoa(i)+"]", elem)
+		}
+		return
+	case string:
+		s = terraformValueEscaper.Replace(v)
+	case json.Number:
+		s = v.String()
+	default:
+		return
+	}
+	if s == "" {
+		return
+	}
+
+	line := address + path + ": " + s + "\n"
+	if _, ok := r.seen[line]; ok {
+		return
+	}
+	r.seen[line] = struct{}{}
+
+	text, ok := r.text[address]
+	if !ok {
+		text = new(bytes.Buffer)
+		r.text[address] = text
+		r.order = append(r.order, address)
+	}
+	text.WriteString(line)
+}
+
+// terraformIdentifierPat matches the attribute names that are written in dotted form rather than as a quoted index.
+var terraformIdentifierPat = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// terraformKey returns the path element of a map key, e.g. `.password` or `["kubernetes.io/role"]`.
+func terraformKey(key string) string {
+	if terraformIdentifierPat.MatchString(key) {
+		return "." + key
+	}
+	return "[" + strconv.Quote(key) + "]"
+}
+
+var registerTerraformMIME sync.Once
+
+// terraformMIME returns the MIME type of Terraform state and plan files, registering it with the mimetype library as
+// a child of JSON on first use. The registered type never matches during detection; it is assigned based on the
+// file extension or content.
+func terraformMIME() *mimetype.MIME {
+	registerTerraformMIME.Do(func() {
+		mimetype.Lookup(string(jsonMime)).Extend(func([]byte, uint32) bool { return false }, string(tfstateMime), terraformStateExt)
+	})
+	return mimetype.Lookup(string(tfstateMime))
+}
+
+// terraformHeaderSize is the number of bytes read from the start of a JSON file to recognize a Terraform state or
+// plan, which both record the Terraform version within their first few fields.
+const terraformHeaderSize = 512
+
+// isTerraformFile reports whether a file is a Terraform state file or the JSON output of `terraform show -json`.
+// Files with the .tfstate extension are state files; other JSON files, such as state backups and saved plans, are
+// recognized by the "terraform_version" field at their start. The reader is reset to the beginning afterwards.
+func isTerraformFile(cfg readerConfig, fReader *fileReader) (bool, error) {
+	if !fReader.mime.Is(string(jsonMime)) && !strings.HasPrefix(fReader.mime.String(), "text/plain") {
+		return false, nil
+	}
+	if cfg.fileExtension == terraformStateExt {
+		return true, nil
+	}
+
+	header := make([]byte, terraformHeaderSize)
+	n, err := io.ReadFull(fReader, header)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return false, fmt.Errorf("error reading file header: %w", err)
+	}
+	if _, err := fReader.Seek(0, io.SeekStart); err != nil {
+		return false, fmt.Errorf("error resetting reader after reading file header: %w", err)
+	}
+	header = bytes.TrimSpace(header[:n])
+	return bytes.HasPrefix(header, []byte("{")) && bytes.Contains(header, []byte(`"terraform_version"`)), nil
+}
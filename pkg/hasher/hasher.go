@@ -4,6 +4,7 @@ package hasher
 import (
 	"fmt"
 	"hash"
+	"io"
 )
 
 // Hasher defines a generic interface for hashing data.
@@ -52,3 +53,22 @@ func (b *baseHasher) Hash(data []byte) ([]byte, error) {
 	_, _ = b.hash.Write(data)
 	return b.hash.Sum(nil), nil
 }
+
+// HashReader computes the hash of all data read from r until EOF.
+// Unlike Hash, the input is not limited in size, as it is streamed through the hash
+// rather than held in memory. Like Hash, it resets the underlying hash before hashing.
+func (b *baseHasher) HashReader(r io.Reader) ([]byte, error) {
+	b.hash.Reset()
+	if _, err := io.Copy(b.hash, r); err != nil {
+		return nil, err
+	}
+	return b.hash.Sum(nil), nil
+}
+
+// TeeReader returns a reader that hashes all data read from r, and a function returning the hash of the data read so
+// far. This hashes input as it's consumed by another reader, rather than reading it twice. Like Hash, it resets the
+// underlying hash first.
+func (b *baseHasher) TeeReader(r io.Reader) (io.Reader, func() []byte) {
+	b.hash.Reset()
+	return io.TeeReader(r, b.hash), func() []byte { return b.hash.Sum(nil) }
+}
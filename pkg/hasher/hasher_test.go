@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -94,6 +95,38 @@ func TestBlake2bHashIdempotency(t *testing.T) {
 	}
 }
 
+func TestBlake2bHashReader(t *testing.T) {
+	t.Parallel()
+
+	hasher := NewBlake2B()
+	input := bytes.Repeat([]byte("a"), maxInputSize)
+
+	want, err := hasher.Hash(input)
+	assert.NoError(t, err)
+	got, err := hasher.HashReader(bytes.NewReader(input))
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// Input larger than Hash accepts is streamed.
+	_, err = hasher.HashReader(bytes.NewReader(append(input, 'a')))
+	assert.NoError(t, err)
+}
+
+func TestBlake2bTeeReader(t *testing.T) {
+	t.Parallel()
+
+	hasher := NewBlake2B()
+	input := bytes.Repeat([]byte("a"), 2*maxInputSize)
+
+	want, err := hasher.HashReader(bytes.NewReader(input))
+	assert.NoError(t, err)
+	r, sum := hasher.TeeReader(bytes.NewReader(input))
+	read, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, input, read)
+	assert.Equal(t, want, sum())
+}
+
 var sampleData = []byte("The quick brown fox jumps over the lazy dog")
 
 // BenchmarkHasherPerGoroutine_Blake2b benchmarks hashing using separate Blake2b Hasher instances
@@ -78,6 +78,11 @@ func ConvertResult(r *detectors.ResultWithMetadata) ScanResult {
 		ExtraData:    r.ExtraData,
 		DecoderType:  r.DecoderType.String(),
 	}
+	if len(r.DecoderChain) > 1 {
+		for _, decoder := range r.DecoderChain {
+			result.DecoderChain = append(result.DecoderChain, decoder.String())
+		}
+	}
 
 	// Include raw only if it's different from redacted (i.e., not sensitive)
 	// For MCP, we typically want to include raw for verified secrets
@@ -114,6 +119,12 @@ func convertSourceMetadata(meta *source_metadatapb.MetaData) map[string]any {
 			if data.Filesystem.Line > 0 {
 				result["line"] = data.Filesystem.Line
 			}
+			if data.Filesystem.Cell > 0 {
+				result["cell"] = data.Filesystem.Cell
+				result["cell_line"] = data.Filesystem.CellLine
+			}
+			addArchiveMember(result, data.Filesystem.ArchiveMember, data.Filesystem.ArchiveMemberLine, data.Filesystem.ArchiveMemberOffset)
+			addTerraformResource(result, data.Filesystem.TerraformResource, data.Filesystem.TerraformAttribute, data.Filesystem.TerraformResourceLine)
 		}
 	case *source_metadatapb.MetaData_Git:
 		if data.Git != nil {
@@ -124,6 +135,18 @@ func convertSourceMetadata(meta *source_metadatapb.MetaData) map[string]any {
 			if data.Git.Line > 0 {
 				result["line"] = data.Git.Line
 			}
+			if data.Git.Cell > 0 {
+				result["cell"] = data.Git.Cell
+				result["cell_line"] = data.Git.CellLine
+			}
+			addArchiveMember(result, data.Git.ArchiveMember, data.Git.ArchiveMemberLine, data.Git.ArchiveMemberOffset)
+			addTerraformResource(result, data.Git.TerraformResource, data.Git.TerraformAttribute, data.Git.TerraformResourceLine)
+			if data.Git.Unreachable {
+				result["unreachable"] = true
+			}
+			if data.Git.Blob != "" {
+				result["blob"] = data.Git.Blob
+			}
 		}
 	case *source_metadatapb.MetaData_Github:
 		if data.Github != nil {
@@ -146,6 +169,180 @@ func convertSourceMetadata(meta *source_metadatapb.MetaData) map[string]any {
 				result["line"] = data.Gitlab.Line
 			}
 		}
+	case *source_metadatapb.MetaData_Bitbucket:
+		if data.Bitbucket != nil {
+			result["type"] = "bitbucket"
+			result["repository"] = data.Bitbucket.Repository
+			if data.Bitbucket.File != "" {
+				result["file"] = data.Bitbucket.File
+			}
+			if data.Bitbucket.Line > 0 {
+				result["line"] = data.Bitbucket.Line
+			}
+			if data.Bitbucket.Commit != "" {
+				result["commit"] = data.Bitbucket.Commit
+			}
+			if data.Bitbucket.PullRequestId != 0 {
+				result["pull_request"] = data.Bitbucket.PullRequestId
+			}
+			if data.Bitbucket.Link != "" {
+				result["link"] = data.Bitbucket.Link
+			}
+		}
+	case *source_metadatapb.MetaData_AzureRepos:
+		if data.AzureRepos != nil {
+			result["type"] = "azure_repos"
+			result["organization"] = data.AzureRepos.Organization
+			result["project"] = data.AzureRepos.Project
+			if data.AzureRepos.Repository != "" {
+				result["repository"] = data.AzureRepos.Repository
+				result["file"] = data.AzureRepos.File
+			}
+			if data.AzureRepos.Line > 0 {
+				result["line"] = data.AzureRepos.Line
+			}
+			if data.AzureRepos.Commit != "" {
+				result["commit"] = data.AzureRepos.Commit
+			}
+			if data.AzureRepos.Pipeline != "" {
+				result["pipeline"] = data.AzureRepos.Pipeline
+			}
+			if data.AzureRepos.VariableGroup != "" {
+				result["variable_group"] = data.AzureRepos.VariableGroup
+			}
+			if data.AzureRepos.Link != "" {
+				result["link"] = data.AzureRepos.Link
+			}
+		}
+	case *source_metadatapb.MetaData_Gerrit:
+		if data.Gerrit != nil {
+			result["type"] = "gerrit"
+			result["project"] = data.Gerrit.Project
+			if data.Gerrit.File != "" {
+				result["file"] = data.Gerrit.File
+			}
+			if data.Gerrit.Line > 0 {
+				result["line"] = data.Gerrit.Line
+			}
+			if data.Gerrit.Commit != "" {
+				result["commit"] = data.Gerrit.Commit
+			}
+			if data.Gerrit.ChangeNumber != 0 {
+				result["change"] = data.Gerrit.ChangeNumber
+				result["patch_set"] = data.Gerrit.PatchSet
+			}
+			if data.Gerrit.Link != "" {
+				result["link"] = data.Gerrit.Link
+			}
+		}
+	case *source_metadatapb.MetaData_Jira:
+		if data.Jira != nil {
+			result["type"] = "jira"
+			result["issue"] = data.Jira.Issue
+			if data.Jira.Location != "" {
+				result["location"] = data.Jira.Location
+			}
+			if data.Jira.Link != "" {
+				result["link"] = data.Jira.Link
+			}
+		}
+	case *source_metadatapb.MetaData_Confluence:
+		if data.Confluence != nil {
+			result["type"] = "confluence"
+			result["space"] = data.Confluence.Space
+			result["page"] = data.Confluence.Page
+			if data.Confluence.Version != "" {
+				result["version"] = data.Confluence.Version
+			}
+			if data.Confluence.Location != "" {
+				result["location"] = data.Confluence.Location
+			}
+			if data.Confluence.File != "" {
+				result["file"] = data.Confluence.File
+			}
+			if data.Confluence.Link != "" {
+				result["link"] = data.Confluence.Link
+			}
+		}
+	case *source_metadatapb.MetaData_Slack:
+		if data.Slack != nil {
+			result["type"] = "slack"
+			result["channel"] = data.Slack.ChannelName
+			result["channel_id"] = data.Slack.ChannelId
+			result["timestamp"] = data.Slack.Timestamp
+			result["location"] = data.Slack.Location
+			if data.Slack.File != "" {
+				result["file"] = data.Slack.File
+			}
+			if data.Slack.Link != "" {
+				result["link"] = data.Slack.Link
+			}
+		}
+	case *source_metadatapb.MetaData_Npm:
+		if data.Npm != nil {
+			result["type"] = "npm"
+			result["package"] = data.Npm.Package
+			result["version"] = data.Npm.Release
+			result["file"] = data.Npm.File
+			addArchiveMember(result, data.Npm.ArchiveMember, data.Npm.ArchiveMemberLine, data.Npm.ArchiveMemberOffset)
+		}
+	case *source_metadatapb.MetaData_Pypi:
+		if data.Pypi != nil {
+			result["type"] = "pypi"
+			result["package"] = data.Pypi.Package
+			result["version"] = data.Pypi.Release
+			result["file"] = data.Pypi.File
+			addArchiveMember(result, data.Pypi.ArchiveMember, data.Pypi.ArchiveMemberLine, data.Pypi.ArchiveMemberOffset)
+		}
+	case *source_metadatapb.MetaData_Artifactory:
+		if data.Artifactory != nil {
+			result["type"] = "artifactory"
+			result["repository"] = data.Artifactory.Repo
+			result["file"] = data.Artifactory.Path
+			if data.Artifactory.Link != "" {
+				result["link"] = data.Artifactory.Link
+			}
+		}
+	case *source_metadatapb.MetaData_Kubernetes:
+		if data.Kubernetes != nil {
+			result["type"] = "kubernetes"
+			result["cluster"] = data.Kubernetes.Cluster
+			result["namespace"] = data.Kubernetes.Namespace
+			result["kind"] = data.Kubernetes.Kind
+			result["name"] = data.Kubernetes.Name
+			result["field_path"] = data.Kubernetes.FieldPath
+		}
+	case *source_metadatapb.MetaData_Webhook:
+		if data.Webhook != nil {
+			result["type"] = "webhook"
+			result["request_id"] = data.Webhook.RequestId
+			result["path"] = data.Webhook.Path
+			result["timestamp"] = data.Webhook.Timestamp
+		}
+	case *source_metadatapb.MetaData_S3:
+		if data.S3 != nil {
+			result["type"] = "s3"
+			result["bucket"] = data.S3.Bucket
+			result["file"] = data.S3.File
+			addArchiveMember(result, data.S3.ArchiveMember, data.S3.ArchiveMemberLine, data.S3.ArchiveMemberOffset)
+			addTerraformResource(result, data.S3.TerraformResource, data.S3.TerraformAttribute, data.S3.TerraformResourceLine)
+		}
+	case *source_metadatapb.MetaData_Gcs:
+		if data.Gcs != nil {
+			result["type"] = "gcs"
+			result["bucket"] = data.Gcs.Bucket
+			result["file"] = data.Gcs.Filename
+			addArchiveMember(result, data.Gcs.ArchiveMember, data.Gcs.ArchiveMemberLine, data.Gcs.ArchiveMemberOffset)
+			addTerraformResource(result, data.Gcs.TerraformResource, data.Gcs.TerraformAttribute, data.Gcs.TerraformResourceLine)
+		}
+	case *source_metadatapb.MetaData_Docker:
+		if data.Docker != nil {
+			result["type"] = "docker"
+			result["image"] = data.Docker.Image
+			result["layer"] = data.Docker.Layer
+			result["file"] = data.Docker.File
+			addArchiveMember(result, data.Docker.ArchiveMember, data.Docker.ArchiveMemberLine, data.Docker.ArchiveMemberOffset)
+		}
 	case *source_metadatapb.MetaData_Stdin:
 		result["type"] = "stdin"
 	default:
@@ -154,3 +351,28 @@ func convertSourceMetadata(meta *source_metadatapb.MetaData) map[string]any {
 
 	return result
 }
+
+// addArchiveMember adds the archive member a result was found in, if any, to the converted source metadata.
+func addArchiveMember(result map[string]any, member string, line, offset int64) {
+	if member == "" {
+		return
+	}
+	result["archive_member"] = member
+	result["archive_member_line"] = line
+	result["archive_member_offset"] = offset
+}
+
+// addTerraformResource adds the Terraform resource, attribute and line of the resource's values a result was found
+// in, if any, to the converted source metadata.
+func addTerraformResource(result map[string]any, resource, attribute string, line int64) {
+	if resource == "" {
+		return
+	}
+	result["terraform_resource"] = resource
+	if attribute != "" {
+		result["terraform_attribute"] = attribute
+	}
+	if line > 0 {
+		result["terraform_resource_line"] = line
+	}
+}
@@ -197,6 +197,56 @@ func TestConvertResult(t *testing.T) {
 
 		assert.NotNil(t, scanResult.SourceMetadata)
 	})
+
+	t.Run("converts result with archive member", func(t *testing.T) {
+		result := createTestResult(detectorspb.DetectorType_AWS, true, "secret", "s***")
+		result.SourceMetadata = &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_S3{
+				S3: &source_metadatapb.S3{
+					Bucket:              "releases",
+					File:                "app.tar.gz",
+					ArchiveMember:       "app/config.yaml",
+					ArchiveMemberLine:   12,
+					ArchiveMemberOffset: 340,
+				},
+			},
+		}
+		scanResult := ConvertResult(result)
+
+		assert.Equal(t, map[string]any{
+			"type":                  "s3",
+			"bucket":                "releases",
+			"file":                  "app.tar.gz",
+			"archive_member":        "app/config.yaml",
+			"archive_member_line":   int64(12),
+			"archive_member_offset": int64(340),
+		}, scanResult.SourceMetadata)
+	})
+
+	t.Run("converts result from a dangling blob", func(t *testing.T) {
+		result := createTestResult(detectorspb.DetectorType_AWS, true, "secret", "s***")
+		result.SourceMetadata = &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Git{
+				Git: &source_metadatapb.Git{
+					Repository:  "https://github.com/org/repo",
+					Line:        3,
+					Unreachable: true,
+					Blob:        "4ae8ef021bf6fcfff43a13be5abfa52bb6fb5dbc",
+				},
+			},
+		}
+		scanResult := ConvertResult(result)
+
+		assert.Equal(t, map[string]any{
+			"type":        "git",
+			"repository":  "https://github.com/org/repo",
+			"commit":      "",
+			"file":        "",
+			"line":        int64(3),
+			"unreachable": true,
+			"blob":        "4ae8ef021bf6fcfff43a13be5abfa52bb6fb5dbc",
+		}, scanResult.SourceMetadata)
+	})
 }
 
 // Helper function to create test results
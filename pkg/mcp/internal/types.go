@@ -24,6 +24,9 @@ type ScanResult struct {
 	SourceMetadata map[string]any `json:"source_metadata,omitempty"`
 	// DecoderType is the decoder that was used to find this secret.
 	DecoderType string `json:"decoder_type"`
+	// DecoderChain lists every decoder applied, outermost first, when the
+	// secret was found in data with more than one layer of encoding.
+	DecoderChain []string `json:"decoder_chain,omitempty"`
 }
 
 // ScanSummary provides aggregate information about a scan.
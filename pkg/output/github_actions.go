@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
@@ -41,6 +42,16 @@ func (p *GitHubActionsPrinter) Print(_ context.Context, r *detectors.ResultWithM
 					out.Filename = filename
 				}
 			}
+			if k == "archive_member" {
+				if member, ok := v.(string); ok {
+					out.ArchiveMember = member
+				}
+			}
+			if k == "archive_member_line" {
+				if line, ok := v.(float64); ok {
+					out.ArchiveMemberLine = int64(line)
+				}
+			}
 		}
 	}
 
@@ -50,6 +61,9 @@ func (p *GitHubActionsPrinter) Print(_ context.Context, r *detectors.ResultWithM
 	}
 
 	key := fmt.Sprintf("%s:%s:%s:%s:%d", out.DecoderType, out.DetectorType, verifiedStatus, out.Filename, out.StartLine)
+	if out.ArchiveMember != "" {
+		key = fmt.Sprintf("%s:%s:%d", key, out.ArchiveMember, out.ArchiveMemberLine)
+	}
 	h := sha256.New()
 	h.Write([]byte(key))
 	key = hex.EncodeToString(h.Sum(nil))
@@ -69,6 +83,10 @@ func (p *GitHubActionsPrinter) Print(_ context.Context, r *detectors.ResultWithM
 	if r.DecoderType != detectorspb.DecoderType_PLAIN {
 		message = fmt.Sprintf("Found %s %s%s result with %s encoding 🐷🔑\n", verifiedStatus, out.DetectorType, name, out.DecoderType)
 	}
+	if out.ArchiveMember != "" {
+		// Annotations can only point at the archive itself, so the member is named in the message.
+		message = fmt.Sprintf("%s in archive member %s line %d\n", strings.TrimSuffix(message, "\n"), out.ArchiveMember, out.ArchiveMemberLine)
+	}
 
 	fmt.Printf("::warning file=%s,line=%d,endLine=%d::%s",
 		out.Filename, out.StartLine, out.StartLine, message)
@@ -83,4 +101,6 @@ type gitHubActionsOutputFormat struct {
 	Verified            bool
 	StartLine           int64
 	Filename            string
+	ArchiveMember       string
+	ArchiveMemberLine   int64
 }
@@ -54,6 +54,12 @@ func (p *JSONPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 		Redacted       string
 		ExtraData      map[string]string
 		StructuredData *detectorspb.StructuredData
+		// DecoderChain is the sequence of decoders, outermost first, that
+		// produced the data this result was found in.
+		DecoderChain []string `json:",omitempty"`
+		// Lifecycle is the history of the secret in the git repository it was
+		// found in, if lifecycle tracking was requested.
+		Lifecycle *detectors.SecretLifecycle `json:",omitempty"`
 	}{
 		SourceMetadata:        r.SourceMetadata,
 		SourceID:              r.SourceID,
@@ -71,6 +77,8 @@ func (p *JSONPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 		Redacted:              r.Redacted,
 		ExtraData:             r.ExtraData,
 		StructuredData:        r.StructuredData,
+		DecoderChain:          decoderChainNames(r.DecoderChain),
+		Lifecycle:             r.Lifecycle,
 	}
 	out, err := json.Marshal(v)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 )
 
@@ -32,6 +33,7 @@ func (p *PlainPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 	out := outputFormat{
 		DetectorType:        r.Result.DetectorType.String(),
 		DecoderType:         r.DecoderType.String(),
+		DecoderChain:        decoderChainNames(r.DecoderChain),
 		Verified:            r.Result.Verified,
 		VerificationError:   r.Result.VerificationError(),
 		MetaData:            r.SourceMetadata,
@@ -62,6 +64,9 @@ func (p *PlainPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 	}
 	printer.Printf("Detector Type: %s\n", out.DetectorType)
 	printer.Printf("Decoder Type: %s\n", out.DecoderType)
+	if len(out.DecoderChain) > 1 {
+		printer.Printf("Decoder Chain: %s\n", strings.Join(out.DecoderChain, " -> "))
+	}
 	printer.Printf("Raw result: %s\n", whitePrinter.Sprint(out.Raw))
 
 	for k, v := range r.Result.ExtraData {
@@ -101,6 +106,15 @@ func (p *PlainPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 		printer.Printf("%s: %v\n", cases.Title(language.AmericanEnglish).String(k), aggregateData[k])
 	}
 
+	if l := r.Lifecycle; l != nil {
+		printer.Printf("First Seen Commit: %s\n", l.FirstSeenCommit)
+		printer.Printf("First Seen Author: %s\n", l.FirstSeenAuthor)
+		if l.RemovedCommit != "" {
+			printer.Printf("Removed Commit: %s\n", l.RemovedCommit)
+		}
+		printer.Printf("Present At Head: %t\n", l.PresentAtHead)
+	}
+
 	// if analysis info is not nil, means the detector added key for analyzer and result is verified
 	if r.Result.AnalysisInfo != nil && r.Result.Verified {
 		printer.Printf("Analyze: Run `trufflehog analyze` to analyze this key's permissions\n")
@@ -129,9 +143,22 @@ func structToMap(obj any) (m map[string]map[string]any, err error) {
 	return
 }
 
+// decoderChainNames returns the string names of the decoders in chain.
+func decoderChainNames(chain []detectorspb.DecoderType) []string {
+	if len(chain) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(chain))
+	for _, decoder := range chain {
+		names = append(names, decoder.String())
+	}
+	return names
+}
+
 type outputFormat struct {
 	DetectorType,
 	DecoderType string
+	DecoderChain      []string
 	Verified          bool
 	VerificationError error
 	Raw               string
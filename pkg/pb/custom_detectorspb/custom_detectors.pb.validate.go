@@ -579,3 +579,140 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ValidationConfigValidationError{}
+
+// Validate checks the field values on DetectorPlugin with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DetectorPlugin) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DetectorPlugin with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DetectorPluginMultiError,
+// or nil if none found.
+func (m *DetectorPlugin) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DetectorPlugin) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Command
+
+	// no validation rules for Env
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DetectorPluginValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DetectorPluginValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DetectorPluginValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxConcurrency
+
+	if len(errors) > 0 {
+		return DetectorPluginMultiError(errors)
+	}
+
+	return nil
+}
+
+// DetectorPluginMultiError is an error wrapping multiple validation errors
+// returned by DetectorPlugin.ValidateAll() if the designated constraints
+// aren't met.
+type DetectorPluginMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DetectorPluginMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DetectorPluginMultiError) AllErrors() []error { return m }
+
+// DetectorPluginValidationError is the validation error returned by
+// DetectorPlugin.Validate if the designated constraints aren't met.
+type DetectorPluginValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DetectorPluginValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DetectorPluginValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DetectorPluginValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DetectorPluginValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DetectorPluginValidationError) ErrorName() string { return "DetectorPluginValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DetectorPluginValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDetectorPlugin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DetectorPluginValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DetectorPluginValidationError{}
@@ -185,6 +185,12 @@ func (m *Bitbucket) validate(all bool) error {
 
 	// no validation rules for Line
 
+	// no validation rules for Project
+
+	// no validation rules for PullRequestId
+
+	// no validation rules for Author
+
 	if len(errors) > 0 {
 		return BitbucketMultiError(errors)
 	}
@@ -745,6 +751,12 @@ func (m *Docker) validate(all bool) error {
 
 	// no validation rules for Tag
 
+	// no validation rules for ArchiveMember
+
+	// no validation rules for ArchiveMemberLine
+
+	// no validation rules for ArchiveMemberOffset
+
 	if len(errors) > 0 {
 		return DockerMultiError(errors)
 	}
@@ -964,6 +976,22 @@ func (m *Filesystem) validate(all bool) error {
 
 	// no validation rules for Line
 
+	// no validation rules for Cell
+
+	// no validation rules for ArchiveMember
+
+	// no validation rules for ArchiveMemberLine
+
+	// no validation rules for ArchiveMemberOffset
+
+	// no validation rules for TerraformResource
+
+	// no validation rules for TerraformAttribute
+
+	// no validation rules for CellLine
+
+	// no validation rules for TerraformResourceLine
+
 	if len(errors) > 0 {
 		return FilesystemMultiError(errors)
 	}
@@ -1076,6 +1104,26 @@ func (m *Git) validate(all bool) error {
 
 	// no validation rules for RepositoryLocalPath
 
+	// no validation rules for Cell
+
+	// no validation rules for ArchiveMember
+
+	// no validation rules for ArchiveMemberLine
+
+	// no validation rules for ArchiveMemberOffset
+
+	// no validation rules for Unreachable
+
+	// no validation rules for Blob
+
+	// no validation rules for TerraformResource
+
+	// no validation rules for TerraformAttribute
+
+	// no validation rules for CellLine
+
+	// no validation rules for TerraformResourceLine
+
 	if len(errors) > 0 {
 		return GitMultiError(errors)
 	}
@@ -1426,6 +1474,18 @@ func (m *GCS) validate(all bool) error {
 
 	// no validation rules for ContentType
 
+	// no validation rules for ArchiveMember
+
+	// no validation rules for ArchiveMemberLine
+
+	// no validation rules for ArchiveMemberOffset
+
+	// no validation rules for TerraformResource
+
+	// no validation rules for TerraformAttribute
+
+	// no validation rules for TerraformResourceLine
+
 	if len(errors) > 0 {
 		return GCSMultiError(errors)
 	}
@@ -1763,6 +1823,12 @@ func (m *NPM) validate(all bool) error {
 
 	// no validation rules for Email
 
+	// no validation rules for ArchiveMember
+
+	// no validation rules for ArchiveMemberLine
+
+	// no validation rules for ArchiveMemberOffset
+
 	if len(errors) > 0 {
 		return NPMMultiError(errors)
 	}
@@ -1871,6 +1937,12 @@ func (m *PyPi) validate(all bool) error {
 
 	// no validation rules for Email
 
+	// no validation rules for ArchiveMember
+
+	// no validation rules for ArchiveMemberLine
+
+	// no validation rules for ArchiveMemberOffset
+
 	if len(errors) > 0 {
 		return PyPiMultiError(errors)
 	}
@@ -1979,6 +2051,18 @@ func (m *S3) validate(all bool) error {
 
 	// no validation rules for Timestamp
 
+	// no validation rules for ArchiveMember
+
+	// no validation rules for ArchiveMemberLine
+
+	// no validation rules for ArchiveMemberOffset
+
+	// no validation rules for TerraformResource
+
+	// no validation rules for TerraformAttribute
+
+	// no validation rules for TerraformResourceLine
+
 	if len(errors) > 0 {
 		return S3MultiError(errors)
 	}
@@ -2205,6 +2289,12 @@ func (m *Gerrit) validate(all bool) error {
 
 	// no validation rules for Line
 
+	// no validation rules for ChangeNumber
+
+	// no validation rules for PatchSet
+
+	// no validation rules for Link
+
 	if len(errors) > 0 {
 		return GerritMultiError(errors)
 	}
@@ -3323,6 +3413,10 @@ func (m *AzureRepos) validate(all bool) error {
 
 	// no validation rules for Organization
 
+	// no validation rules for Pipeline
+
+	// no validation rules for VariableGroup
+
 	if len(errors) > 0 {
 		return AzureReposMultiError(errors)
 	}
@@ -3684,6 +3778,16 @@ func (m *Webhook) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for RequestId
+
+	// no validation rules for RemoteAddress
+
+	// no validation rules for Path
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Headers
+
 	switch v := m.Data.(type) {
 	case *Webhook_Vector:
 		if v == nil {
@@ -4245,6 +4349,115 @@ var _ interface {
 	ErrorName() string
 } = SlackContinuousValidationError{}
 
+// Validate checks the field values on Kubernetes with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Kubernetes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Kubernetes with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KubernetesMultiError, or
+// nil if none found.
+func (m *Kubernetes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Kubernetes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cluster
+
+	// no validation rules for Namespace
+
+	// no validation rules for Kind
+
+	// no validation rules for Name
+
+	// no validation rules for FieldPath
+
+	if len(errors) > 0 {
+		return KubernetesMultiError(errors)
+	}
+
+	return nil
+}
+
+// KubernetesMultiError is an error wrapping multiple validation errors
+// returned by Kubernetes.ValidateAll() if the designated constraints aren't met.
+type KubernetesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KubernetesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KubernetesMultiError) AllErrors() []error { return m }
+
+// KubernetesValidationError is the validation error returned by
+// Kubernetes.Validate if the designated constraints aren't met.
+type KubernetesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KubernetesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KubernetesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KubernetesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KubernetesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KubernetesValidationError) ErrorName() string { return "KubernetesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KubernetesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKubernetes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KubernetesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KubernetesValidationError{}
+
 // Validate checks the field values on MetaData with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -5703,6 +5916,47 @@ func (m *MetaData) validate(all bool) error {
 			}
 		}
 
+	case *MetaData_Kubernetes:
+		if v == nil {
+			err := MetaDataValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetKubernetes()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Kubernetes",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetaDataValidationError{
+						field:  "Kubernetes",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetKubernetes()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetaDataValidationError{
+					field:  "Kubernetes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
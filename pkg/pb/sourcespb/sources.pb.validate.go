@@ -234,6 +234,8 @@ func (m *Artifactory) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for MaxArtifactSize
+
 	switch v := m.Credential.(type) {
 	case *Artifactory_BasicAuth:
 		if v == nil {
@@ -765,6 +767,8 @@ func (m *Bitbucket) validate(all bool) error {
 		_ = v // ensures v is used
 	}
 
+	// no validation rules for IncludePullRequestComments
+
 	if len(errors) > 0 {
 		return BitbucketMultiError(errors)
 	}
@@ -1142,6 +1146,37 @@ func (m *Confluence) validate(all bool) error {
 
 	// no validation rules for IncludeComments
 
+	// no validation rules for Cql
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfluenceValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfluenceValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfluenceValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	switch v := m.Credential.(type) {
 	case *Confluence_Unauthenticated:
 		if v == nil {
@@ -1703,6 +1738,78 @@ func (m *Filesystem) validate(all bool) error {
 
 	// no validation rules for SkipBinaries
 
+	// no validation rules for RespectGitignore
+
+	// no validation rules for StateFile
+
+	// no validation rules for RescanFilesWithFindings
+
+	// no validation rules for FollowSymlinks
+
+	// no validation rules for SymlinksWithinRoot
+
+	// no validation rules for MaxFileSize
+
+	if all {
+		switch v := interface{}(m.GetModifiedSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FilesystemValidationError{
+					field:  "ModifiedSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FilesystemValidationError{
+					field:  "ModifiedSince",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetModifiedSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FilesystemValidationError{
+				field:  "ModifiedSince",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetModifiedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FilesystemValidationError{
+					field:  "ModifiedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FilesystemValidationError{
+					field:  "ModifiedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetModifiedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FilesystemValidationError{
+				field:  "ModifiedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OneFileSystem
+
 	if len(errors) > 0 {
 		return FilesystemMultiError(errors)
 	}
@@ -2223,6 +2330,10 @@ func (m *Git) validate(all bool) error {
 		_ = v // ensures v is used
 	}
 
+	// no validation rules for ScanUnreachable
+
+	// no validation rules for TrackLifecycle
+
 	if len(errors) > 0 {
 		return GitMultiError(errors)
 	}
@@ -3614,6 +3725,39 @@ func (m *JIRA) validate(all bool) error {
 
 	// no validation rules for InstallationType
 
+	// no validation rules for Jql
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JIRAValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JIRAValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JIRAValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IncludeAttachments
+
 	switch v := m.Credential.(type) {
 	case *JIRA_BasicAuth:
 		if v == nil {
@@ -3853,6 +3997,20 @@ func (m *NPMUnauthenticatedPackage) validate(all bool) error {
 
 	var errors []error
 
+	if _, err := url.Parse(m.GetRegistryUrl()); err != nil {
+		err = NPMUnauthenticatedPackageValidationError{
+			field:  "RegistryUrl",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Scope
+
 	switch v := m.Credential.(type) {
 	case *NPMUnauthenticatedPackage_Unauthenticated:
 		if v == nil {
@@ -4001,6 +4159,20 @@ func (m *PyPIUnauthenticatedPackage) validate(all bool) error {
 
 	var errors []error
 
+	if _, err := url.Parse(m.GetIndexUrl()); err != nil {
+		err = PyPIUnauthenticatedPackageValidationError{
+			field:  "IndexUrl",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AllPackages
+
 	switch v := m.Credential.(type) {
 	case *PyPIUnauthenticatedPackage_Unauthenticated:
 		if v == nil {
@@ -4904,6 +5076,10 @@ func (m *Gerrit) validate(all bool) error {
 		_ = v // ensures v is used
 	}
 
+	// no validation rules for RecentChangeDays
+
+	// no validation rules for SkipComments
+
 	if len(errors) > 0 {
 		return GerritMultiError(errors)
 	}
@@ -6106,6 +6282,10 @@ func (m *AzureRepos) validate(all bool) error {
 		_ = v // ensures v is used
 	}
 
+	// no validation rules for IncludePipelineVariables
+
+	// no validation rules for IncludeWikis
+
 	if len(errors) > 0 {
 		return AzureReposMultiError(errors)
 	}
@@ -6372,6 +6552,43 @@ func (m *Webhook) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for HmacSecret
+
+	// no validation rules for SignatureHeader
+
+	// no validation rules for MaxBodySize
+
+	// no validation rules for RespondWithFindings
+
+	if all {
+		switch v := interface{}(m.GetResponseTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "ResponseTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "ResponseTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponseTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookValidationError{
+				field:  "ResponseTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	switch v := m.Credential.(type) {
 	case *Webhook_Header:
 		if v == nil {
@@ -7136,3 +7353,110 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SlackContinuousValidationError{}
+
+// Validate checks the field values on Kubernetes with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Kubernetes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Kubernetes with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KubernetesMultiError, or
+// nil if none found.
+func (m *Kubernetes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Kubernetes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kubeconfig
+
+	// no validation rules for Context
+
+	// no validation rules for InCluster
+
+	// no validation rules for IncludeSecrets
+
+	if len(errors) > 0 {
+		return KubernetesMultiError(errors)
+	}
+
+	return nil
+}
+
+// KubernetesMultiError is an error wrapping multiple validation errors
+// returned by Kubernetes.ValidateAll() if the designated constraints aren't met.
+type KubernetesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KubernetesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KubernetesMultiError) AllErrors() []error { return m }
+
+// KubernetesValidationError is the validation error returned by
+// Kubernetes.Validate if the designated constraints aren't met.
+type KubernetesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KubernetesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KubernetesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KubernetesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KubernetesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KubernetesValidationError) ErrorName() string { return "KubernetesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KubernetesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKubernetes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KubernetesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KubernetesValidationError{}
@@ -0,0 +1,306 @@
+package artifactory
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/roundtripper"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_JFROG_ARTIFACTORY
+
+const (
+	defaultMaxArtifactSize = 250 * 1024 * 1024  // 250 MiB
+	maxArtifactSizeLimit   = 1024 * 1024 * 1024 // 1 GiB
+)
+
+// UnitRepository is the kind of the units of the source. Their IDs are repository keys.
+const UnitRepository sources.SourceUnitKind = "repository"
+
+// Source scans the artifacts of the local repositories of a JFrog Artifactory instance, such as the configuration
+// bundles and packages that build pipelines deploy there.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	client *client
+
+	repositories    []string
+	includePaths    []glob.Glob
+	ignorePaths     []glob.Glob
+	maxArtifactSize int64
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Artifactory source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Artifactory
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	endpoint := strings.TrimSuffix(conn.GetEndpoint(), "/")
+	if u, err := url.Parse(endpoint); err != nil || u.Host == "" {
+		return fmt.Errorf("invalid endpoint %q", conn.GetEndpoint())
+	}
+
+	var authenticate func(*http.Request)
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Artifactory_BasicAuth:
+		log.RedactGlobally(cred.BasicAuth.GetPassword())
+		authenticate = func(req *http.Request) {
+			req.SetBasicAuth(cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword())
+		}
+	case *sourcespb.Artifactory_AccessToken:
+		log.RedactGlobally(cred.AccessToken)
+		authenticate = func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer "+cred.AccessToken)
+		}
+	case *sourcespb.Artifactory_Unauthenticated:
+		authenticate = func(*http.Request) {}
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+	s.client = &client{
+		baseURL: endpoint,
+		httpClient: &http.Client{Transport: roundtripper.NewRoundTripper(nil,
+			roundtripper.WithLogger(ctx.Logger()),
+			roundtripper.WithRetryable(),
+		)},
+		authenticate: authenticate,
+	}
+
+	s.repositories = conn.GetRepositories()
+	var err error
+	if s.includePaths, err = compileGlobs(conn.GetIncludePaths()); err != nil {
+		return fmt.Errorf("invalid include path: %w", err)
+	}
+	if s.ignorePaths, err = compileGlobs(conn.GetIgnorePaths()); err != nil {
+		return fmt.Errorf("invalid ignore path: %w", err)
+	}
+	s.setMaxArtifactSize(conn.GetMaxArtifactSize())
+
+	return nil
+}
+
+// compileGlobs compiles path globs, where * doesn't match slashes and ** does.
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	globs := make([]glob.Glob, 0, len(patterns))
+	for _, pattern := range patterns {
+		g, err := glob.Compile(strings.TrimPrefix(pattern, "/"), '/')
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+	return globs, nil
+}
+
+// setMaxArtifactSize sets the maximum size of artifacts that will be scanned. If not set, set to a negative number,
+// or set larger than maxArtifactSizeLimit, the defaultMaxArtifactSize will be used.
+func (s *Source) setMaxArtifactSize(maxArtifactSize int64) {
+	if maxArtifactSize <= 0 || maxArtifactSize > maxArtifactSizeLimit {
+		s.maxArtifactSize = defaultMaxArtifactSize
+	} else {
+		s.maxArtifactSize = maxArtifactSize
+	}
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var repos []string
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			repos = append(repos, id)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating repositories")
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+	// We must sort the repositories so we can resume later if necessary.
+	slices.Sort(repos)
+
+	// If there is resume information available, limit this scan to only the repositories that still need scanning.
+	reposToScan, progressIndexOffset := sources.FilterReposToResume(repos, s.GetProgress().EncodedResumeInfo)
+	scanErrs := sources.NewScanErrors()
+	for i, repo := range reposToScan {
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.setProgressCompleteWithRepo(i, progressIndexOffset, len(reposToScan), repo)
+			// Ensure the repository is removed from the resume info after being scanned.
+			defer func() {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, repo)
+			}()
+
+			unit := sources.CommonSourceUnit{Kind: UnitRepository, ID: repo}
+			if err := s.ChunkUnit(ctx, unit, sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(reposToScan), len(reposToScan), "Completed Artifactory scan", "")
+	return nil
+}
+
+// setProgressCompleteWithRepo calls the s.SetProgressComplete after safely setting up the encoded resume info string.
+func (s *Source) setProgressCompleteWithRepo(index, offset, total int, repo string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	s.resumeInfoSlice = append(s.resumeInfoSlice, repo)
+	slices.Sort(s.resumeInfoSlice)
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	s.SetProgressComplete(index+offset, total+offset, fmt.Sprintf("Repository: %s", repo), encodedResumeInfo)
+}
+
+// Enumerate reports the configured repositories, or else every local repository, to the reporter. Units are
+// identified by repository key.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	repos := s.repositories
+	if len(repos) == 0 {
+		var err error
+		if repos, err = s.client.listLocalRepositories(ctx); err != nil {
+			return fmt.Errorf("error listing repositories: %w", err)
+		}
+		ctx.Logger().Info("enumerated Artifactory repositories", "count", len(repos))
+	}
+	for _, repo := range repos {
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitRepository, ID: repo}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit chunks the artifacts of a repository which pass the configured path filters and aren't too large.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	repo, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "repository", repo)
+
+	artifacts, err := s.client.listArtifacts(ctx, repo)
+	if err != nil {
+		return fmt.Errorf("error listing the artifacts of %s: %w", repo, err)
+	}
+	var count int
+	for _, a := range artifacts {
+		path := strings.TrimPrefix(a.URI, "/")
+		if a.Folder || !s.includePath(path) {
+			continue
+		}
+		if a.Size > s.maxArtifactSize {
+			ctx.Logger().V(3).Info("skipping artifact", "path", path, "size", a.Size, "reason", "too large")
+			continue
+		}
+		count++
+		if err := s.scanArtifact(ctx, repo, a, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("error scanning artifact %s/%s: %w", repo, path, err)); err != nil {
+				return err
+			}
+		}
+	}
+	ctx.Logger().V(2).Info("scanned artifacts", "count", count)
+	return nil
+}
+
+// includePath returns whether the path of an artifact, relative to its repository, matches an include path, if
+// there are any, and no ignore path.
+func (s *Source) includePath(path string) bool {
+	matches := func(g glob.Glob) bool { return g.Match(path) }
+	if slices.ContainsFunc(s.ignorePaths, matches) {
+		return false
+	}
+	return len(s.includePaths) == 0 || slices.ContainsFunc(s.includePaths, matches)
+}
+
+// scanArtifact downloads an artifact and chunks it with the file handlers, so archives and documents are extracted.
+func (s *Source) scanArtifact(ctx context.Context, repo string, a artifact, reporter sources.ChunkReporter) error {
+	body, err := s.client.download(ctx, repo, a)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		JobID:      s.JobID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Artifactory{
+			Artifactory: &source_metadatapb.Artifactory{
+				Repo:      sanitizer.UTF8(repo),
+				Path:      sanitizer.UTF8(strings.TrimPrefix(a.URI, "/")),
+				Link:      sanitizer.UTF8(s.client.baseURL + artifactPath(repo, a)),
+				Timestamp: sanitizer.UTF8(a.LastModified),
+			},
+		}},
+		Verify: s.verify,
+	}
+	return handlers.HandleFile(ctx, body, chunkSkel, reporter)
+}
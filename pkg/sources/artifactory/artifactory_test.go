@@ -0,0 +1,94 @@
+package artifactory
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+func TestSource_Chunks(t *testing.T) {
+	var bundle bytes.Buffer
+	zw := zip.NewWriter(&bundle)
+	w, err := zw.Create("config/app.env")
+	require.NoError(t, err)
+	_, _ = w.Write([]byte("DB_PASSWORD=hunter2\n"))
+	require.NoError(t, zw.Close())
+
+	downloads := map[string][]byte{
+		"/artifactory/config-local/bundles/app 1.0.zip":   bundle.Bytes(),
+		"/artifactory/config-local/bundles/notes.txt":     []byte("token: abc\n"),
+		"/artifactory/config-local/bundles/tmp/debug.log": []byte("ignored\n"),
+		"/artifactory/config-local/images/large.tar":      []byte("too large\n"),
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/artifactory/api/repositories":
+			assert.Equal(t, "local", r.URL.Query().Get("type"))
+			_, _ = w.Write([]byte(`[{"key": "config-local", "type": "LOCAL"}]`))
+		case "/artifactory/api/storage/config-local":
+			assert.Equal(t, "1", r.URL.Query().Get("deep"))
+			_ = json.NewEncoder(w).Encode(map[string]any{"files": []any{
+				map[string]any{"uri": "/bundles/app 1.0.zip", "size": bundle.Len(), "lastModified": "2024-01-02T03:04:05.000Z"},
+				map[string]any{"uri": "/bundles/notes.txt", "size": 11},
+				map[string]any{"uri": "/bundles/tmp/debug.log", "size": 8},
+				map[string]any{"uri": "/images/large.tar", "size": 1 << 20},
+			}})
+		default:
+			content, ok := downloads[r.URL.Path]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			_, _ = w.Write(content)
+		}
+	}))
+	defer server.Close()
+
+	conn, err := anypb.New(&sourcespb.Artifactory{
+		Endpoint:        server.URL + "/artifactory/",
+		Credential:      &sourcespb.Artifactory_AccessToken{AccessToken: "access-token"},
+		IncludePaths:    []string{"bundles/**", "images/*"},
+		IgnorePaths:     []string{"**/tmp/**"},
+		MaxArtifactSize: 1024,
+	})
+	require.NoError(t, err)
+	s := &Source{}
+	ctx := context.Background()
+	require.NoError(t, s.Init(ctx, "test artifactory", 0, 0, false, conn, 1))
+
+	units := &sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(ctx, units))
+	require.Equal(t, []sources.SourceUnit{sources.CommonSourceUnit{Kind: UnitRepository, ID: "config-local"}}, units.Units)
+
+	chunks := &sourcestest.TestReporter{}
+	require.NoError(t, s.ChunkUnit(ctx, units.Units[0], chunks))
+	require.Empty(t, chunks.ChunkErrs)
+	require.Len(t, chunks.Chunks, 2)
+
+	assert.Equal(t, "DB_PASSWORD=hunter2\n", string(chunks.Chunks[0].Data))
+	assert.Equal(t, &source_metadatapb.Artifactory{
+		Repo:      "config-local",
+		Path:      "bundles/app 1.0.zip",
+		Link:      server.URL + "/artifactory/config-local/bundles/app%201.0.zip",
+		Timestamp: "2024-01-02T03:04:05.000Z",
+	}, chunks.Chunks[0].SourceMetadata.GetArtifactory())
+	assert.Equal(t, "token: abc\n", string(chunks.Chunks[1].Data))
+	assert.Equal(t, "bundles/notes.txt", chunks.Chunks[1].SourceMetadata.GetArtifactory().GetPath())
+}
@@ -0,0 +1,123 @@
+package artifactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// artifact is a file of a repository, as listed by the file list API.
+type artifact struct {
+	// URI is the path of the artifact in its repository, starting with a slash.
+	URI          string `json:"uri"`
+	Size         int64  `json:"size"`
+	LastModified string `json:"lastModified"`
+	Folder       bool   `json:"folder"`
+}
+
+// client sends requests to the REST API of an Artifactory instance.
+//
+// https://jfrog.com/help/r/jfrog-rest-apis/artifactory-rest-apis
+type client struct {
+	// baseURL is the URL of Artifactory, which usually ends with /artifactory.
+	baseURL    string
+	httpClient *http.Client
+	// authenticate adds the credentials to a request.
+	authenticate func(*http.Request)
+}
+
+// do sends a GET request to a path and returns the response if its status is OK.
+func (c *client) do(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.authenticate(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, req.URL.Redacted())
+	}
+	return resp, nil
+}
+
+// get decodes the JSON response to a GET request of an API path into v.
+func (c *client) get(ctx context.Context, path string, v any) error {
+	resp, err := c.do(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding response for %s: %w", resp.Request.URL.Redacted(), err)
+	}
+	return nil
+}
+
+// listLocalRepositories lists the keys of the local repositories, which hold the artifacts deployed to Artifactory
+// rather than cached from remote repositories.
+func (c *client) listLocalRepositories(ctx context.Context) ([]string, error) {
+	var repos []struct {
+		Key string `json:"key"`
+	}
+	if err := c.get(ctx, "/api/repositories?type=local", &repos); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		keys = append(keys, repo.Key)
+	}
+	return keys, nil
+}
+
+// listArtifacts lists the files of a repository, in every folder.
+func (c *client) listArtifacts(ctx context.Context, repo string) ([]artifact, error) {
+	query := url.Values{
+		"list":        {""},
+		"deep":        {"1"},
+		"listFolders": {"0"},
+	}
+	var list struct {
+		Files []artifact `json:"files"`
+	}
+	if err := c.get(ctx, "/api/storage/"+url.PathEscape(repo)+"?"+query.Encode(), &list); err != nil {
+		return nil, err
+	}
+	return list.Files, nil
+}
+
+// download returns the content of an artifact.
+func (c *client) download(ctx context.Context, repo string, a artifact) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, artifactPath(repo, a))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// artifactPath returns the path to download an artifact from, relative to the URL of Artifactory.
+func artifactPath(repo string, a artifact) string {
+	return "/" + url.PathEscape(repo) + escapePath(a.URI)
+}
+
+// escapePath escapes each segment of a path.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,607 @@
+package azurerepos
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/giturl"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_AZURE_REPOS
+
+// DefaultEndpoint is the endpoint of Azure DevOps Services.
+const DefaultEndpoint = "https://dev.azure.com"
+
+// UnitVariables is the kind of the units holding the pipeline and variable group variables of a project. Their IDs
+// are "<organization>/<project>".
+const UnitVariables sources.SourceUnitKind = "variables"
+
+// Source scans the git repositories of Azure DevOps Services organizations or Azure DevOps Server collections, and
+// optionally their projects' wikis and the variables of their pipelines.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	endpoint string
+	token    string
+	client   *client
+
+	repos         []string
+	organizations []string
+	projects      []string
+	includeForks  bool
+	// These filters are checked both during enumeration and when ChunkUnit is called, like the GitLab source's.
+	ignoreRepos     []string
+	includeRepos    []string
+	ignoreProjects  []string
+	includeProjects []string
+
+	includeVariables bool
+	includeWikis     bool
+
+	useCustomContentWriter bool
+	git                    *git.Git
+	scanOptions            *git.ScanOptions
+
+	// repoCache maps the clone URLs of enumerated repositories to the repositories.
+	repoCache   map[string]repository
+	repoCacheMu sync.RWMutex
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// WithCustomContentWriter sets the useCustomContentWriter flag on the source.
+func (s *Source) WithCustomContentWriter() { s.useCustomContentWriter = true }
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Azure Repos source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	if err := git.CmdCheck(); err != nil {
+		return err
+	}
+
+	var conn sourcespb.AzureRepos
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.endpoint = strings.TrimSuffix(conn.GetEndpoint(), "/")
+	if s.endpoint == "" {
+		s.endpoint = DefaultEndpoint
+	}
+	if _, err := url.Parse(s.endpoint); err != nil {
+		return fmt.Errorf("invalid endpoint %q: %w", s.endpoint, err)
+	}
+
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.AzureRepos_Token:
+		log.RedactGlobally(cred.Token)
+		s.token = cred.Token
+	case *sourcespb.AzureRepos_Oauth:
+		return fmt.Errorf("OAuth credentials are not supported for source %q (%s)", name, s.Type().String())
+	case nil:
+		// Public projects can be scanned without credentials.
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+	s.client = newClient(s.endpoint, s.token)
+
+	s.repos = conn.GetRepositories()
+	s.organizations = conn.GetOrganizations()
+	s.projects = conn.GetProjects()
+	s.includeForks = conn.GetIncludeForks()
+	s.ignoreRepos = conn.GetIgnoreRepos()
+	s.includeRepos = conn.GetIncludeRepos()
+	s.ignoreProjects = conn.GetIgnoreProjects()
+	s.includeProjects = conn.GetIncludeProjects()
+	s.includeVariables = conn.GetIncludePipelineVariables()
+	s.includeWikis = conn.GetIncludeWikis()
+	for _, repo := range s.repos {
+		if _, _, err := s.parseRepoURL(repo); err != nil {
+			return err
+		}
+	}
+	for _, p := range s.projects {
+		if org, name, ok := strings.Cut(p, "/"); !ok || org == "" || name == "" {
+			return fmt.Errorf("invalid project %q: expected <organization>/<project>", p)
+		}
+	}
+	if len(s.repos) == 0 && len(s.organizations) == 0 && len(s.projects) == 0 && s.token == "" {
+		return fmt.Errorf("organizations, projects or repositories must be given to scan without a token")
+	}
+
+	cfg := &git.Config{
+		SourceName:   s.name,
+		JobID:        s.jobID,
+		SourceID:     s.sourceID,
+		SourceType:   s.Type(),
+		Verify:       s.verify,
+		SkipBinaries: conn.GetSkipBinaries(),
+		SkipArchives: conn.GetSkipArchives(),
+		Concurrency:  concurrency,
+		SourceMetadataFunc: func(file, email, commit, timestamp, repository, repositoryLocalPath string, line int64) *source_metadatapb.MetaData {
+			metadata := &source_metadatapb.AzureRepos{
+				File:       sanitizer.UTF8(file),
+				Repository: sanitizer.UTF8(repository),
+				Commit:     sanitizer.UTF8(commit),
+				Email:      sanitizer.UTF8(email),
+				Timestamp:  sanitizer.UTF8(timestamp),
+				Line:       line,
+				Visibility: source_metadatapb.Visibility_unknown,
+			}
+			if repo, ok := s.cachedRepo(repository); ok {
+				metadata.Organization = repo.Project.Organization
+				metadata.Project = repo.Project.Name
+				metadata.Visibility = visibility(repo.Project.Visibility)
+				if repo.WebURL != "" && commit != "" {
+					metadata.Link = giturl.GenerateLink(repo.WebURL, commit, file, line)
+				}
+			}
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_AzureRepos{AzureRepos: metadata},
+			}
+		},
+		UseCustomContentWriter: s.useCustomContentWriter,
+	}
+	s.git = git.NewGit(cfg)
+	s.repoCache = make(map[string]repository)
+
+	return nil
+}
+
+func (s *Source) WithScanOptions(scanOptions *git.ScanOptions) {
+	s.scanOptions = scanOptions
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	units := make(map[string]sources.SourceUnit)
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			units[id] = unit
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating Azure Repos")
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+	// We must sort the units so we can resume later if necessary.
+	ids := make([]string, 0, len(units))
+	for id := range units {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	// If there is resume information available, limit this scan to only the units that still need scanning.
+	idsToScan, progressIndexOffset := sources.FilterReposToResume(ids, s.GetProgress().EncodedResumeInfo)
+	scanErrs := sources.NewScanErrors()
+	for i, id := range idsToScan {
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.setProgressCompleteWithRepo(i, progressIndexOffset, len(idsToScan), id)
+			// Ensure the unit is removed from the resume info after being scanned.
+			defer func() {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, id)
+			}()
+
+			if err := s.ChunkUnit(ctx, units[id], sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(idsToScan), len(idsToScan), "Completed Azure Repos scan", "")
+	return nil
+}
+
+// setProgressCompleteWithRepo calls the s.SetProgressComplete after safely setting up the encoded resume info string.
+func (s *Source) setProgressCompleteWithRepo(index, offset, total int, unitID string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	s.resumeInfoSlice = append(s.resumeInfoSlice, unitID)
+	slices.Sort(s.resumeInfoSlice)
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	s.SetProgressComplete(index+offset, total+offset, fmt.Sprintf("Unit: %s", unitID), encodedResumeInfo)
+}
+
+// Enumerate reports the units to scan to the reporter: the configured repositories, or else the repositories of the
+// configured projects, or else those of every project of the configured organizations, or else of every organization
+// the token's user belongs to. Repositories are filtered by the configured include and ignore globs, which match
+// "<organization>/<project>/<repository>", and projects by theirs, which match "<organization>/<project>". The
+// repositories of project wikis and a unit for the variables of each project are reported too if enabled.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	reportedProjects := make(map[string]bool)
+	reportProject := func(p project) error {
+		if !s.includeVariables || reportedProjects[p.fullName()] {
+			return nil
+		}
+		reportedProjects[p.fullName()] = true
+		return reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitVariables, ID: p.fullName()})
+	}
+	reportRepo := func(repo repository) error {
+		s.cacheRepo(repo)
+		return reporter.UnitOk(ctx, git.SourceUnit{Kind: git.UnitRepo, ID: repo.CloneURL})
+	}
+
+	if len(s.repos) > 0 {
+		for _, repoURL := range s.repos {
+			repo, err := s.ensureRepoInCache(ctx, repoURL)
+			if err != nil {
+				if err := reporter.UnitErr(ctx, err); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := reportRepo(repo); err != nil {
+				return err
+			}
+			if err := reportProject(repo.Project); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	projects, err := s.projectsToScan(ctx, reporter)
+	if err != nil {
+		return err
+	}
+	ignoreProject, ignoreRepo := s.ignorers(ctx)
+	repoCount := 0
+	for _, p := range projects {
+		if ignoreProject(p.fullName()) {
+			ctx.Logger().V(3).Info("skipping project", "project", p.fullName(), "reason", "ignored in config")
+			continue
+		}
+		repos, err := s.client.listRepos(ctx, p.Organization, p.Name)
+		if err != nil {
+			if err := reporter.UnitErr(ctx, fmt.Errorf("error listing repositories of %s: %w", p.fullName(), err)); err != nil {
+				return err
+			}
+			continue
+		}
+		if s.includeWikis {
+			wikis, err := s.client.listWikiRepos(ctx, p.Organization, p.Name)
+			if err != nil {
+				if err := reporter.UnitErr(ctx, fmt.Errorf("error listing wikis of %s: %w", p.fullName(), err)); err != nil {
+					return err
+				}
+			}
+			repos = append(repos, wikis...)
+		}
+		for _, repo := range repos {
+			if reason := s.skipReason(repo, ignoreRepo); reason != "" {
+				ctx.Logger().V(3).Info("skipping repository", "repo", repo.fullName(), "reason", reason)
+				continue
+			}
+			if err := reportRepo(repo); err != nil {
+				return err
+			}
+			repoCount++
+		}
+		if err := reportProject(p); err != nil {
+			return err
+		}
+	}
+	ctx.Logger().Info("enumerated Azure Repos repositories", "count", repoCount)
+	return nil
+}
+
+// projectsToScan returns the configured projects, or else those of the configured organizations, or else those of
+// every organization the token's user belongs to.
+func (s *Source) projectsToScan(ctx context.Context, reporter sources.UnitReporter) ([]project, error) {
+	if len(s.projects) > 0 {
+		projects := make([]project, 0, len(s.projects))
+		for _, p := range s.projects {
+			org, name, _ := strings.Cut(p, "/")
+			projects = append(projects, project{Organization: org, Name: name})
+		}
+		return projects, nil
+	}
+
+	organizations := s.organizations
+	if len(organizations) == 0 {
+		var err error
+		if organizations, err = s.client.listOrganizations(ctx); err != nil {
+			return nil, fmt.Errorf("error listing organizations: %w", err)
+		}
+	}
+	var projects []project
+	for _, org := range organizations {
+		orgProjects, err := s.client.listProjects(ctx, org)
+		if err != nil {
+			if err := reporter.UnitErr(ctx, fmt.Errorf("error listing projects of %s: %w", org, err)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		projects = append(projects, orgProjects...)
+	}
+	return projects, nil
+}
+
+// ChunkUnit scans a unit: it clones and scans a repository, or chunks the variables of a project's pipelines and
+// variable groups.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	id, kind := unit.SourceUnitID()
+	if kind == UnitVariables {
+		org, name, ok := strings.Cut(id, "/")
+		if !ok {
+			return fmt.Errorf("invalid variables unit %q", id)
+		}
+		return s.scanVariables(ctx, project{Organization: org, Name: name}, reporter)
+	}
+
+	ctx = context.WithValue(ctx, "repo", id)
+	repo, err := s.ensureRepoInCache(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(s.repos) == 0 {
+		ignoreProject, ignoreRepo := s.ignorers(ctx)
+		if ignoreProject(repo.Project.fullName()) || s.skipReason(repo, ignoreRepo) != "" {
+			ctx.Logger().V(3).Info("skipping repository", "reason", "ignored in config")
+			return nil
+		}
+	}
+
+	var path string
+	var gitRepo *gogit.Repository
+	if s.token != "" {
+		path, gitRepo, err = git.CloneRepoUsingToken(ctx, s.token, repo.CloneURL, "", "", false)
+	} else {
+		path, gitRepo, err = git.CloneRepoUsingUnauthenticated(ctx, repo.CloneURL, "")
+	}
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(path)
+
+	return s.git.ScanRepo(ctx, gitRepo, path, s.scanOptions, reporter)
+}
+
+// scanVariables chunks the plaintext variables of a project's build pipelines and variable groups, one chunk of
+// "<name>: <value>" lines per pipeline or group.
+func (s *Source) scanVariables(ctx context.Context, p project, reporter sources.ChunkReporter) error {
+	pipelines, err := s.client.listPipelineVariables(ctx, p.Organization, p.Name)
+	if err != nil {
+		return fmt.Errorf("error listing pipelines of %s: %w", p.fullName(), err)
+	}
+	groups, err := s.client.listVariableGroups(ctx, p.Organization, p.Name)
+	if err != nil {
+		if err := reporter.ChunkErr(ctx, fmt.Errorf("error listing variable groups of %s: %w", p.fullName(), err)); err != nil {
+			return err
+		}
+	}
+
+	report := func(vars variables, metadata *source_metadatapb.AzureRepos) error {
+		if len(vars.Values) == 0 {
+			return nil
+		}
+		names := make([]string, 0, len(vars.Values))
+		for name := range vars.Values {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		var data strings.Builder
+		for _, name := range names {
+			fmt.Fprintf(&data, "%s: %s\n", name, vars.Values[name])
+		}
+
+		metadata.Organization = p.Organization
+		metadata.Project = p.Name
+		metadata.Link = sanitizer.UTF8(vars.Link)
+		metadata.Visibility = source_metadatapb.Visibility_unknown
+		chunk := sources.Chunk{
+			SourceName:     s.name,
+			SourceID:       s.SourceID(),
+			JobID:          s.JobID(),
+			SourceType:     s.Type(),
+			SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_AzureRepos{AzureRepos: metadata}},
+			Data:           []byte(data.String()),
+			Verify:         s.verify,
+		}
+		return reporter.ChunkOk(ctx, chunk)
+	}
+	for _, pipeline := range pipelines {
+		if err := report(pipeline, &source_metadatapb.AzureRepos{Pipeline: sanitizer.UTF8(pipeline.Name)}); err != nil {
+			return err
+		}
+	}
+	for _, group := range groups {
+		if err := report(group, &source_metadatapb.AzureRepos{VariableGroup: sanitizer.UTF8(group.Name)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skipReason returns why a repository isn't scanned, or an empty string if it is.
+func (s *Source) skipReason(repo repository, ignoreRepo func(string) bool) string {
+	switch {
+	case repo.Disabled:
+		return "disabled"
+	case repo.Fork && !s.includeForks:
+		return "fork"
+	case ignoreRepo(repo.fullName()):
+		return "ignored in config"
+	default:
+		return ""
+	}
+}
+
+// ignorers returns functions reporting whether a project or a repository is excluded by the include and ignore globs.
+func (s *Source) ignorers(ctx context.Context) (ignoreProject, ignoreRepo func(string) bool) {
+	return globIgnorer(ctx, s.includeProjects, s.ignoreProjects), globIgnorer(ctx, s.includeRepos, s.ignoreRepos)
+}
+
+func globIgnorer(ctx context.Context, includePatterns, ignorePatterns []string) func(string) bool {
+	compile := func(patterns []string) []glob.Glob {
+		globs := make([]glob.Glob, 0, len(patterns))
+		for _, pattern := range patterns {
+			g, err := glob.Compile(pattern)
+			if err != nil {
+				ctx.Logger().Error(err, "could not compile include/exclude glob", "glob", pattern)
+				continue
+			}
+			globs = append(globs, g)
+		}
+		return globs
+	}
+	include, exclude := compile(includePatterns), compile(ignorePatterns)
+	matches := func(globs []glob.Glob, name string) bool {
+		return slices.ContainsFunc(globs, func(g glob.Glob) bool { return g.Match(name) })
+	}
+	return func(name string) bool {
+		if len(include) > 0 && !matches(include, name) {
+			return true
+		}
+		return matches(exclude, name)
+	}
+}
+
+func (s *Source) cacheRepo(repo repository) {
+	s.repoCacheMu.Lock()
+	defer s.repoCacheMu.Unlock()
+	s.repoCache[repo.CloneURL] = repo
+}
+
+func (s *Source) cachedRepo(repoURL string) (repository, bool) {
+	s.repoCacheMu.RLock()
+	defer s.repoCacheMu.RUnlock()
+	repo, ok := s.repoCache[withoutUserinfo(repoURL)]
+	return repo, ok
+}
+
+// ensureRepoInCache returns the repository with a clone URL, which ChunkUnit isn't guaranteed to have enumerated,
+// looking it up through the API if needed.
+func (s *Source) ensureRepoInCache(ctx context.Context, repoURL string) (repository, error) {
+	if repo, ok := s.cachedRepo(repoURL); ok {
+		return repo, nil
+	}
+	org, projectName, err := s.parseRepoURL(repoURL)
+	if err != nil {
+		return repository{}, err
+	}
+	name := repoURL[strings.LastIndex(repoURL, "/")+1:]
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		name = unescaped
+	}
+	repo, err := s.client.getRepo(ctx, org, projectName, name)
+	if err != nil {
+		return repository{}, fmt.Errorf("error getting repository %s: %w", repoURL, err)
+	}
+	s.cacheRepo(repo)
+	return repo, nil
+}
+
+// parseRepoURL returns the organization and project of a repository from its URL, which has the form
+// <endpoint>/<organization>/<project>/_git/<repository>.
+func (s *Source) parseRepoURL(repoURL string) (organization, projectName string, err error) {
+	u, err := url.Parse(withoutUserinfo(repoURL))
+	if err != nil {
+		return "", "", fmt.Errorf("invalid repository URL %q: %w", repoURL, err)
+	}
+	endpoint, err := url.Parse(s.endpoint)
+	if err != nil {
+		return "", "", err
+	}
+	if u.Host != endpoint.Host || !strings.HasPrefix(u.Path, endpoint.Path+"/") {
+		return "", "", fmt.Errorf("invalid repository URL %q: expected a repository of %s", repoURL, s.endpoint)
+	}
+	parts := strings.Split(strings.TrimPrefix(u.Path, endpoint.Path+"/"), "/")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] != "_git" || parts[3] == "" {
+		return "", "", fmt.Errorf("invalid repository URL %q: expected %s/<organization>/<project>/_git/<repository>", repoURL, s.endpoint)
+	}
+	return parts[0], parts[1], nil
+}
+
+// visibility converts the visibility of a project.
+func visibility(projectVisibility string) source_metadatapb.Visibility {
+	switch projectVisibility {
+	case "public":
+		return source_metadatapb.Visibility_public
+	case "private":
+		return source_metadatapb.Visibility_private
+	case "organization":
+		return source_metadatapb.Visibility_shared
+	default:
+		return source_metadatapb.Visibility_unknown
+	}
+}
+
+// withoutUserinfo removes the username and password from a URL. Azure DevOps includes the organization as the
+// username of the remote URLs it returns.
+func withoutUserinfo(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+	u.User = nil
+	return u.String()
+}
@@ -0,0 +1,313 @@
+package azurerepos
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+// bareRepo creates a bare repository holding one commit of a file, and returns its file:// URL.
+func bareRepo(t *testing.T, file, content string) string {
+	t.Helper()
+	dir := t.TempDir()
+	work, bare := filepath.Join(dir, "work"), filepath.Join(dir, "repo.git")
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	run("init", "-q", work)
+	require.NoError(t, os.WriteFile(filepath.Join(work, file), []byte(content), 0644))
+	run("-C", work, "add", file)
+	run("-C", work, "commit", "-q", "-m", "add "+file)
+	run("clone", "-q", "--bare", work, bare)
+	return "file://" + bare
+}
+
+// apiStandIn serves an organization "org" with projects "Proj" and "Other", and fails the test if a request isn't
+// authenticated with the token "pat".
+func apiStandIn(t *testing.T, app, wiki string) *httptest.Server {
+	t.Helper()
+	var server *httptest.Server
+	repo := func(id, name string, remoteURL string, extra map[string]any) map[string]any {
+		r := map[string]any{
+			"id":        id,
+			"name":      name,
+			"project":   map[string]any{"name": "Proj", "visibility": "private"},
+			"remoteUrl": remoteURL,
+			"webUrl":    server.URL + "/org/Proj/_git/" + name,
+		}
+		for k, v := range extra {
+			r[k] = v
+		}
+		return r
+	}
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok && user == "" && pass == "pat", r.URL.String())
+		assert.NotEmpty(t, r.URL.Query().Get("api-version"), r.URL.String())
+
+		var resp any
+		switch r.URL.Path {
+		case "/_apis/profile/profiles/me":
+			resp = map[string]any{"id": "me"}
+		case "/_apis/accounts":
+			assert.Equal(t, "me", r.URL.Query().Get("memberId"))
+			resp = map[string]any{"value": []any{map[string]any{"accountName": "org"}}}
+		case "/org/_apis/projects":
+			// Projects are listed over two pages.
+			if r.URL.Query().Get("continuationToken") == "" {
+				w.Header().Set("X-Ms-Continuationtoken", "next")
+				resp = map[string]any{"value": []any{map[string]any{"name": "Proj", "visibility": "private"}}}
+			} else {
+				resp = map[string]any{"value": []any{map[string]any{"name": "Other"}}}
+			}
+		case "/org/Proj/_apis/git/repositories":
+			resp = map[string]any{"value": []any{
+				repo("1", "app", app, nil),
+				repo("2", "fork", "https://org@example.com/fork", map[string]any{"isFork": true}),
+				repo("3", "disabled", "https://org@example.com/disabled", map[string]any{"isDisabled": true}),
+			}}
+		case "/org/Other/_apis/git/repositories":
+			resp = map[string]any{"value": []any{}}
+		case "/org/Proj/_apis/git/repositories/app":
+			resp = repo("1", "app", app, nil)
+		case "/org/Proj/_apis/git/repositories/4":
+			resp = repo("4", "Proj.wiki", wiki, nil)
+		case "/org/Proj/_apis/wiki/wikis":
+			resp = map[string]any{"value": []any{
+				map[string]any{"type": "projectWiki", "repositoryId": "4"},
+				map[string]any{"type": "codeWiki", "repositoryId": "1"},
+			}}
+		case "/org/Proj/_apis/build/definitions":
+			resp = map[string]any{"value": []any{map[string]any{
+				"name": "build",
+				"variables": map[string]any{
+					"AWS_SECRET": map[string]any{"value": "plaintext-secret"},
+					"PASSWORD":   map[string]any{"isSecret": true},
+				},
+				"_links": map[string]any{"web": map[string]any{"href": server.URL + "/org/Proj/_build/definition?definitionId=1"}},
+			}}}
+		case "/org/Proj/_apis/distributedtask/variablegroups":
+			resp = map[string]any{"value": []any{
+				map[string]any{"id": 7, "name": "shared", "variables": map[string]any{"DB_URL": map[string]any{"value": "postgres://u:p@db"}}},
+				map[string]any{"id": 8, "name": "empty", "variables": map[string]any{}},
+			}}
+		case "/org/Other/_apis/wiki/wikis", "/org/Other/_apis/build/definitions", "/org/Other/_apis/distributedtask/variablegroups":
+			resp = map[string]any{"value": []any{}}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func initSource(t *testing.T, server *httptest.Server, conn *sourcespb.AzureRepos) *Source {
+	t.Helper()
+	conn.Endpoint = server.URL
+	conn.Credential = &sourcespb.AzureRepos_Token{Token: "pat"}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	s := &Source{}
+	require.NoError(t, s.Init(context.Background(), "test azure repos", 0, 0, false, anyConn, 1))
+	s.client.profileURL = server.URL
+	return s
+}
+
+func chunkUnits(t *testing.T, s *Source) (*sourcestest.TestReporter, *sourcestest.TestReporter) {
+	t.Helper()
+	ctx := context.Background()
+	units := &sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(ctx, units))
+	require.Empty(t, units.UnitErrs)
+
+	chunks := &sourcestest.TestReporter{}
+	for _, unit := range units.Units {
+		require.NoError(t, s.ChunkUnit(ctx, unit, chunks))
+	}
+	require.Empty(t, chunks.ChunkErrs)
+	return units, chunks
+}
+
+func TestSource_Enumerate(t *testing.T) {
+	app := bareRepo(t, "config.yaml", "password: app-secret\n")
+	wiki := bareRepo(t, "Home.md", "wiki\n")
+	server := apiStandIn(t, app, wiki)
+	// Azure DevOps includes the organization as the username of repository URLs.
+	appURL := "http://org@" + server.Listener.Addr().String() + "/org/Proj/_git/app"
+
+	tests := []struct {
+		name string
+		conn *sourcespb.AzureRepos
+		want []sources.SourceUnit
+	}{
+		{
+			name: "organizations of the user",
+			conn: &sourcespb.AzureRepos{},
+			want: []sources.SourceUnit{git.SourceUnit{Kind: git.UnitRepo, ID: app}},
+		},
+		{
+			name: "forks, wikis and variables",
+			conn: &sourcespb.AzureRepos{
+				Organizations:            []string{"org"},
+				IncludeForks:             true,
+				IncludeWikis:             true,
+				IncludePipelineVariables: true,
+			},
+			want: []sources.SourceUnit{
+				git.SourceUnit{Kind: git.UnitRepo, ID: app},
+				git.SourceUnit{Kind: git.UnitRepo, ID: "https://example.com/fork"},
+				git.SourceUnit{Kind: git.UnitRepo, ID: wiki},
+				sources.CommonSourceUnit{Kind: UnitVariables, ID: "org/Proj"},
+				sources.CommonSourceUnit{Kind: UnitVariables, ID: "org/Other"},
+			},
+		},
+		{
+			name: "filtered projects and repositories",
+			conn: &sourcespb.AzureRepos{
+				Organizations:            []string{"org"},
+				IncludeProjects:          []string{"org/*"},
+				IgnoreProjects:           []string{"org/Other"},
+				IgnoreRepos:              []string{"*/app"},
+				IncludeWikis:             true,
+				IncludePipelineVariables: true,
+			},
+			want: []sources.SourceUnit{
+				git.SourceUnit{Kind: git.UnitRepo, ID: wiki},
+				sources.CommonSourceUnit{Kind: UnitVariables, ID: "org/Proj"},
+			},
+		},
+		{
+			name: "repositories",
+			conn: &sourcespb.AzureRepos{
+				Repositories:             []string{appURL},
+				IncludePipelineVariables: true,
+			},
+			want: []sources.SourceUnit{
+				git.SourceUnit{Kind: git.UnitRepo, ID: app},
+				sources.CommonSourceUnit{Kind: UnitVariables, ID: "org/Proj"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := initSource(t, server, tt.conn)
+			units := &sourcestest.TestReporter{}
+			require.NoError(t, s.Enumerate(context.Background(), units))
+			assert.Empty(t, units.UnitErrs)
+			assert.Equal(t, tt.want, units.Units)
+		})
+	}
+}
+
+func TestSource_ChunkUnit(t *testing.T) {
+	app := bareRepo(t, "config.yaml", "password: app-secret\n")
+	wiki := bareRepo(t, "Home.md", "wiki\n")
+	server := apiStandIn(t, app, wiki)
+	s := initSource(t, server, &sourcespb.AzureRepos{
+		Projects:                 []string{"org/Proj"},
+		IncludePipelineVariables: true,
+	})
+
+	_, chunks := chunkUnits(t, s)
+	var files []*source_metadatapb.AzureRepos
+	variables := make(map[string]string)
+	for _, chunk := range chunks.Chunks {
+		md := chunk.SourceMetadata.GetAzureRepos()
+		require.NotNil(t, md)
+		assert.Equal(t, "org", md.GetOrganization())
+		assert.Equal(t, "Proj", md.GetProject())
+		switch {
+		case md.GetPipeline() != "":
+			variables[md.GetPipeline()] = string(chunk.Data)
+			assert.Equal(t, server.URL+"/org/Proj/_build/definition?definitionId=1", md.GetLink())
+		case md.GetVariableGroup() != "":
+			variables[md.GetVariableGroup()] = string(chunk.Data)
+			assert.Equal(t, server.URL+"/org/Proj/_library?itemType=VariableGroups&view=VariableGroupView&variableGroupId=7", md.GetLink())
+		case md.GetFile() != "":
+			files = append(files, md)
+		}
+	}
+
+	require.Len(t, files, 1)
+	assert.Equal(t, "config.yaml", files[0].GetFile())
+	assert.Equal(t, source_metadatapb.Visibility_private, files[0].GetVisibility())
+	assert.Contains(t, files[0].GetLink(), server.URL+"/org/Proj/_git/app?path=/config.yaml&version=GC"+files[0].GetCommit())
+	assert.Equal(t, map[string]string{
+		"build":  "AWS_SECRET: plaintext-secret\n",
+		"shared": "DB_URL: postgres://u:p@db\n",
+	}, variables)
+}
+
+func TestSource_parseRepoURL(t *testing.T) {
+	tests := []struct {
+		endpoint    string
+		repoURL     string
+		wantOrg     string
+		wantProject string
+		wantErr     bool
+	}{
+		{endpoint: DefaultEndpoint, repoURL: "https://dev.azure.com/org/Proj/_git/app", wantOrg: "org", wantProject: "Proj"},
+		{endpoint: DefaultEndpoint, repoURL: "https://org@dev.azure.com/org/My%20Project/_git/app", wantOrg: "org", wantProject: "My Project"},
+		{endpoint: "https://tfs.example.com/tfs", repoURL: "https://tfs.example.com/tfs/collection/Proj/_git/app", wantOrg: "collection", wantProject: "Proj"},
+		{endpoint: DefaultEndpoint, repoURL: "https://dev.azure.com/org/Proj/app", wantErr: true},
+		{endpoint: DefaultEndpoint, repoURL: "https://github.com/org/Proj/_git/app", wantErr: true},
+	}
+	for _, tt := range tests {
+		s := &Source{endpoint: tt.endpoint}
+		org, project, err := s.parseRepoURL(tt.repoURL)
+		if tt.wantErr {
+			assert.Error(t, err, tt.repoURL)
+			continue
+		}
+		require.NoError(t, err, tt.repoURL)
+		assert.Equal(t, tt.wantOrg, org, tt.repoURL)
+		assert.Equal(t, tt.wantProject, project, tt.repoURL)
+	}
+}
+
+func TestSource_Init(t *testing.T) {
+	tests := []struct {
+		name    string
+		conn    *sourcespb.AzureRepos
+		wantErr string
+	}{
+		{name: "invalid project", conn: &sourcespb.AzureRepos{Projects: []string{"Proj"}}, wantErr: "invalid project"},
+		{name: "invalid repository", conn: &sourcespb.AzureRepos{Repositories: []string{"https://dev.azure.com/org"}}, wantErr: "invalid repository URL"},
+		{name: "nothing to scan without a token", conn: &sourcespb.AzureRepos{}, wantErr: "must be given"},
+		{name: "public project", conn: &sourcespb.AzureRepos{Projects: []string{"org/Proj"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, err := anypb.New(tt.conn)
+			require.NoError(t, err)
+			err = (&Source{}).Init(context.Background(), "test azure repos", 0, 0, false, conn, 1)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
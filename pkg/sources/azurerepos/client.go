@@ -0,0 +1,319 @@
+package azurerepos
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// profileAPIURL is the endpoint of the Azure DevOps API that lists the organizations of a user.
+const profileAPIURL = "https://app.vssps.visualstudio.com"
+
+// API versions supported by both Azure DevOps Services and Azure DevOps Server 2020.
+const (
+	apiVersion              = "6.0"
+	variableGroupAPIVersion = "6.0-preview.2"
+)
+
+// project is an Azure DevOps project.
+type project struct {
+	Organization string
+	Name         string
+	// Visibility is "private", "public" or "organization".
+	Visibility string
+}
+
+// fullName returns "<organization>/<project>".
+func (p project) fullName() string {
+	return p.Organization + "/" + p.Name
+}
+
+// repository is an Azure Repos git repository.
+type repository struct {
+	ID      string
+	Name    string
+	Project project
+	// CloneURL is the repository's HTTPS remote URL, without credentials.
+	CloneURL string
+	// WebURL is the repository's web page, which links to files are built from.
+	WebURL   string
+	Fork     bool
+	Disabled bool
+}
+
+// fullName returns "<organization>/<project>/<repository>".
+func (r repository) fullName() string {
+	return r.Project.fullName() + "/" + r.Name
+}
+
+// variables are the variables of a build pipeline or variable group.
+type variables struct {
+	Name string
+	Link string
+	// Values maps the names of the plaintext variables to their values. Secret variables' values aren't returned by
+	// the API.
+	Values map[string]string
+}
+
+// client sends authenticated requests to the Azure DevOps REST API.
+//
+// https://learn.microsoft.com/en-us/rest/api/azure/devops/
+type client struct {
+	baseURL    string
+	profileURL string
+	httpClient *http.Client
+	token      string
+}
+
+func newClient(baseURL, token string) *client {
+	return &client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		profileURL: profileAPIURL,
+		httpClient: common.RetryableHTTPClientTimeout(30),
+		token:      token,
+	}
+}
+
+// get decodes the JSON response to a GET request into v, and returns the continuation token of the next page, if any.
+// The URL is either absolute or relative to the base URL.
+func (c *client) get(ctx context.Context, reqURL string, query url.Values, v any) (string, error) {
+	if !strings.HasPrefix(reqURL, "http://") && !strings.HasPrefix(reqURL, "https://") {
+		reqURL = c.baseURL + reqURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		// Personal access tokens are sent as the password of basic authentication, with an empty username.
+		req.SetBasicAuth("", c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	// Requests that aren't authorized may get a sign-in page with status 203 rather than be rejected.
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, req.URL.Redacted())
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return "", fmt.Errorf("error decoding response for %s: %w", req.URL.Redacted(), err)
+	}
+	return resp.Header.Get("X-Ms-Continuationtoken"), nil
+}
+
+// list collects the values of every page of a list endpoint.
+func list[T any](ctx context.Context, c *client, reqURL string, query url.Values) ([]T, error) {
+	var values []T
+	for {
+		var page struct {
+			Value []T `json:"value"`
+		}
+		next, err := c.get(ctx, reqURL, query, &page)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, page.Value...)
+		if next == "" {
+			return values, nil
+		}
+		query.Set("continuationToken", next)
+	}
+}
+
+func apiQuery(version string) url.Values {
+	return url.Values{"api-version": {version}}
+}
+
+// projectPath returns the API path of a project's resources.
+func projectPath(organization, project string) string {
+	return "/" + url.PathEscape(organization) + "/" + url.PathEscape(project) + "/_apis"
+}
+
+// listOrganizations lists the organizations of the user the token belongs to. It's only supported by Azure DevOps
+// Services.
+func (c *client) listOrganizations(ctx context.Context) ([]string, error) {
+	var profile struct {
+		ID string `json:"id"`
+	}
+	if _, err := c.get(ctx, c.profileURL+"/_apis/profile/profiles/me", apiQuery(apiVersion), &profile); err != nil {
+		return nil, err
+	}
+	query := apiQuery(apiVersion)
+	query.Set("memberId", profile.ID)
+	accounts, err := list[struct {
+		AccountName string `json:"accountName"`
+	}](ctx, c, c.profileURL+"/_apis/accounts", query)
+	if err != nil {
+		return nil, err
+	}
+	organizations := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		organizations = append(organizations, account.AccountName)
+	}
+	return organizations, nil
+}
+
+type apiProject struct {
+	Name       string `json:"name"`
+	Visibility string `json:"visibility"`
+}
+
+func (p apiProject) project(organization string) project {
+	return project{Organization: organization, Name: p.Name, Visibility: p.Visibility}
+}
+
+// listProjects lists the projects of an organization.
+func (c *client) listProjects(ctx context.Context, organization string) ([]project, error) {
+	query := apiQuery(apiVersion)
+	query.Set("$top", "100")
+	apiProjects, err := list[apiProject](ctx, c, "/"+url.PathEscape(organization)+"/_apis/projects", query)
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]project, 0, len(apiProjects))
+	for _, p := range apiProjects {
+		projects = append(projects, p.project(organization))
+	}
+	return projects, nil
+}
+
+type apiRepository struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Project    apiProject `json:"project"`
+	RemoteURL  string     `json:"remoteUrl"`
+	WebURL     string     `json:"webUrl"`
+	IsFork     bool       `json:"isFork"`
+	IsDisabled bool       `json:"isDisabled"`
+}
+
+func (r apiRepository) repository(organization string) repository {
+	return repository{
+		ID:       r.ID,
+		Name:     r.Name,
+		Project:  r.Project.project(organization),
+		CloneURL: withoutUserinfo(r.RemoteURL),
+		WebURL:   r.WebURL,
+		Fork:     r.IsFork,
+		Disabled: r.IsDisabled,
+	}
+}
+
+// listRepos lists the git repositories of a project, excluding the hidden repositories of project wikis.
+func (c *client) listRepos(ctx context.Context, organization, projectName string) ([]repository, error) {
+	apiRepos, err := list[apiRepository](ctx, c, projectPath(organization, projectName)+"/git/repositories", apiQuery(apiVersion))
+	if err != nil {
+		return nil, err
+	}
+	repos := make([]repository, 0, len(apiRepos))
+	for _, r := range apiRepos {
+		repos = append(repos, r.repository(organization))
+	}
+	return repos, nil
+}
+
+// getRepo returns a repository of a project by name or ID.
+func (c *client) getRepo(ctx context.Context, organization, projectName, nameOrID string) (repository, error) {
+	var r apiRepository
+	reqURL := projectPath(organization, projectName) + "/git/repositories/" + url.PathEscape(nameOrID)
+	if _, err := c.get(ctx, reqURL, apiQuery(apiVersion), &r); err != nil {
+		return repository{}, err
+	}
+	return r.repository(organization), nil
+}
+
+// listWikiRepos lists the repositories of a project's wikis. Code wikis are published from ordinary repositories, so
+// only the repositories of project wikis are listed.
+func (c *client) listWikiRepos(ctx context.Context, organization, projectName string) ([]repository, error) {
+	wikis, err := list[struct {
+		Type         string `json:"type"`
+		RepositoryID string `json:"repositoryId"`
+	}](ctx, c, projectPath(organization, projectName)+"/wiki/wikis", apiQuery(apiVersion))
+	if err != nil {
+		return nil, err
+	}
+	var repos []repository
+	for _, wiki := range wikis {
+		if wiki.Type != "projectWiki" {
+			continue
+		}
+		repo, err := c.getRepo(ctx, organization, projectName, wiki.RepositoryID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting wiki repository %s: %w", wiki.RepositoryID, err)
+		}
+		repos = append(repos, repo)
+	}
+	return repos, nil
+}
+
+type apiVariables map[string]struct {
+	Value    *string `json:"value"`
+	IsSecret bool    `json:"isSecret"`
+}
+
+// plaintext returns the values of the variables that aren't secret.
+func (v apiVariables) plaintext() map[string]string {
+	values := make(map[string]string, len(v))
+	for name, variable := range v {
+		if variable.IsSecret || variable.Value == nil || *variable.Value == "" {
+			continue
+		}
+		values[name] = *variable.Value
+	}
+	return values
+}
+
+// listPipelineVariables lists the variables of a project's build pipelines.
+func (c *client) listPipelineVariables(ctx context.Context, organization, projectName string) ([]variables, error) {
+	query := apiQuery(apiVersion)
+	query.Set("includeAllProperties", "true")
+	definitions, err := list[struct {
+		Name      string       `json:"name"`
+		Variables apiVariables `json:"variables"`
+		Links     struct {
+			Web struct {
+				Href string `json:"href"`
+			} `json:"web"`
+		} `json:"_links"`
+	}](ctx, c, projectPath(organization, projectName)+"/build/definitions", query)
+	if err != nil {
+		return nil, err
+	}
+	pipelines := make([]variables, 0, len(definitions))
+	for _, d := range definitions {
+		pipelines = append(pipelines, variables{Name: d.Name, Link: d.Links.Web.Href, Values: d.Variables.plaintext()})
+	}
+	return pipelines, nil
+}
+
+// listVariableGroups lists the variables of a project's variable groups.
+func (c *client) listVariableGroups(ctx context.Context, organization, projectName string) ([]variables, error) {
+	groups, err := list[struct {
+		ID        int64        `json:"id"`
+		Name      string       `json:"name"`
+		Variables apiVariables `json:"variables"`
+	}](ctx, c, projectPath(organization, projectName)+"/distributedtask/variablegroups", apiQuery(variableGroupAPIVersion))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]variables, 0, len(groups))
+	for _, g := range groups {
+		link := fmt.Sprintf("%s/%s/%s/_library?itemType=VariableGroups&view=VariableGroupView&variableGroupId=%d",
+			c.baseURL, url.PathEscape(organization), url.PathEscape(projectName), g.ID)
+		result = append(result, variables{Name: g.Name, Link: link, Values: g.Variables.plaintext()})
+	}
+	return result, nil
+}
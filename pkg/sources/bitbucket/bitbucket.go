@@ -0,0 +1,522 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_BITBUCKET
+
+// cloudAPIURL is the endpoint of the Bitbucket Cloud API.
+const cloudAPIURL = "https://api.bitbucket.org"
+
+// tokenCloneUser is the username Bitbucket expects when cloning over HTTPS with an access token.
+const tokenCloneUser = "x-token-auth"
+
+// Source scans the repositories of a Bitbucket Data Center (or Server) instance or of Bitbucket Cloud, and
+// optionally the comments of their pull requests.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	cloud  bool
+	client client
+	// cloneUser and clonePassword authenticate clones, if set.
+	cloneUser     string
+	clonePassword string
+
+	repos    []string
+	projects []string
+	// These filters are checked both during enumeration and when ChunkUnit is called, like the GitLab source's.
+	ignoreRepos  []string
+	includeRepos []string
+
+	includePRComments bool
+
+	useCustomContentWriter bool
+	git                    *git.Git
+	scanOptions            *git.ScanOptions
+
+	// repoCache maps the clone URLs of enumerated repositories, without credentials, to the repositories.
+	repoCache   map[string]repository
+	repoCacheMu sync.RWMutex
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// WithCustomContentWriter sets the useCustomContentWriter flag on the source.
+func (s *Source) WithCustomContentWriter() { s.useCustomContentWriter = true }
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Bitbucket source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	if err := git.CmdCheck(); err != nil {
+		return err
+	}
+
+	var conn sourcespb.Bitbucket
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.repos = conn.GetRepositories()
+	s.projects = conn.GetProjects()
+	s.ignoreRepos = conn.GetIgnoreRepos()
+	s.includeRepos = conn.GetIncludeRepos()
+	s.includePRComments = conn.GetIncludePullRequestComments()
+	for _, repo := range s.repos {
+		if !strings.Contains(repo, "/") {
+			return fmt.Errorf("invalid repository %q: expected <project key or workspace>/<slug>", repo)
+		}
+	}
+
+	endpoint, cloud, err := resolveEndpoint(conn.GetEndpoint(), conn.GetInstallationType())
+	if err != nil {
+		return err
+	}
+	s.cloud = cloud
+
+	authenticate := func(*http.Request) {}
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Bitbucket_Token:
+		log.RedactGlobally(cred.Token)
+		s.cloneUser, s.clonePassword = tokenCloneUser, cred.Token
+		authenticate = func(req *http.Request) { req.Header.Set("Authorization", "Bearer "+cred.Token) }
+	case *sourcespb.Bitbucket_BasicAuth:
+		log.RedactGlobally(cred.BasicAuth.Password)
+		s.cloneUser, s.clonePassword = cred.BasicAuth.Username, cred.BasicAuth.Password
+		authenticate = func(req *http.Request) { req.SetBasicAuth(cred.BasicAuth.Username, cred.BasicAuth.Password) }
+	case *sourcespb.Bitbucket_Oauth:
+		return fmt.Errorf("OAuth credentials are not supported for source %q (%s)", name, s.Type().String())
+	case nil:
+		// Public repositories can be scanned without credentials.
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+	if cloud {
+		s.client = cloudClient{newAPIClient(endpoint, authenticate)}
+	} else {
+		s.client = dataCenterClient{newAPIClient(endpoint, authenticate)}
+	}
+
+	cfg := &git.Config{
+		SourceName:   s.name,
+		JobID:        s.jobID,
+		SourceID:     s.sourceID,
+		SourceType:   s.Type(),
+		Verify:       s.verify,
+		SkipBinaries: conn.GetSkipBinaries(),
+		SkipArchives: conn.GetSkipArchives(),
+		Concurrency:  concurrency,
+		SourceMetadataFunc: func(file, email, commit, timestamp, repository, repositoryLocalPath string, line int64) *source_metadatapb.MetaData {
+			metadata := &source_metadatapb.Bitbucket{
+				File:       sanitizer.UTF8(file),
+				Repository: sanitizer.UTF8(repository),
+				Commit:     sanitizer.UTF8(commit),
+				Email:      sanitizer.UTF8(email),
+				Timestamp:  sanitizer.UTF8(timestamp),
+				Line:       line,
+			}
+			if repo, ok := s.cachedRepo(repository); ok {
+				metadata.Project = repo.Project
+				metadata.Workspace = repo.Workspace
+				if repo.Link != "" && commit != "" {
+					metadata.Link = repo.Link + "/commits/" + commit
+				}
+			}
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Bitbucket{Bitbucket: metadata},
+			}
+		},
+		UseCustomContentWriter: s.useCustomContentWriter,
+	}
+	s.git = git.NewGit(cfg)
+	s.repoCache = make(map[string]repository)
+
+	return nil
+}
+
+// resolveEndpoint returns the API endpoint of a Bitbucket installation and whether it's Bitbucket Cloud. Installations
+// whose type isn't configured are taken to be Bitbucket Cloud if the endpoint is empty or on bitbucket.org.
+func resolveEndpoint(endpoint string, installationType sourcespb.BitbucketInstallationType) (string, bool, error) {
+	var host string
+	if endpoint != "" {
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "https://" + endpoint
+		}
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return "", false, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+		}
+		host = u.Host
+	}
+	isCloudHost := host == "" || host == "bitbucket.org" || host == "api.bitbucket.org"
+
+	switch {
+	case installationType == sourcespb.BitbucketInstallationType_DATA_CENTER,
+		installationType == sourcespb.BitbucketInstallationType_AUTODETECT && !isCloudHost:
+		if endpoint == "" {
+			return "", false, fmt.Errorf("an endpoint is required for Bitbucket Data Center")
+		}
+		return endpoint, false, nil
+	case isCloudHost:
+		// Bitbucket Cloud's web and git host isn't its API's.
+		return cloudAPIURL, true, nil
+	default:
+		return endpoint, true, nil
+	}
+}
+
+func (s *Source) WithScanOptions(scanOptions *git.ScanOptions) {
+	s.scanOptions = scanOptions
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var repos []string
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			repos = append(repos, id)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating repositories")
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+	// We must sort the repos so we can resume later if necessary.
+	slices.Sort(repos)
+
+	// If there is resume information available, limit this scan to only the repos that still need scanning.
+	reposToScan, progressIndexOffset := sources.FilterReposToResume(repos, s.GetProgress().EncodedResumeInfo)
+	scanErrs := sources.NewScanErrors()
+	for i, repoURL := range reposToScan {
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.setProgressCompleteWithRepo(i, progressIndexOffset, len(reposToScan), repoURL)
+			// Ensure the repo is removed from the resume info after being scanned.
+			defer func() {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, repoURL)
+			}()
+
+			unit := git.SourceUnit{Kind: git.UnitRepo, ID: repoURL}
+			if err := s.ChunkUnit(ctx, unit, sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(reposToScan), len(reposToScan), "Completed Bitbucket scan", "")
+	return nil
+}
+
+// setProgressCompleteWithRepo calls the s.SetProgressComplete after safely setting up the encoded resume info string.
+func (s *Source) setProgressCompleteWithRepo(index, offset, total int, repoURL string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	s.resumeInfoSlice = append(s.resumeInfoSlice, repoURL)
+	slices.Sort(s.resumeInfoSlice)
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	s.SetProgressComplete(index+offset, total+offset, fmt.Sprintf("Repo: %s", repoURL), encodedResumeInfo)
+}
+
+// Enumerate reports the repositories to scan to the reporter: the configured repositories, or else those of the
+// configured projects, or else all repositories the credentials can read. Enumerated repositories are filtered by the
+// configured include and ignore globs, which match their full names.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	report := func(repo repository) error {
+		if repo.CloneURL == "" {
+			return reporter.UnitErr(ctx, fmt.Errorf("repository %s has no HTTP clone URL", repo.FullName))
+		}
+		s.cacheRepo(repo)
+		return reporter.UnitOk(ctx, git.SourceUnit{Kind: git.UnitRepo, ID: repo.CloneURL})
+	}
+
+	if len(s.repos) > 0 {
+		for _, name := range s.repos {
+			repo, err := s.client.getRepo(ctx, name)
+			if err != nil {
+				if err := reporter.UnitErr(ctx, fmt.Errorf("error getting repository %s: %w", name, err)); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := report(repo); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var repos []repository
+	if len(s.projects) > 0 {
+		for _, project := range s.projects {
+			projectRepos, err := s.client.listProjectRepos(ctx, project)
+			if err != nil {
+				if err := reporter.UnitErr(ctx, fmt.Errorf("error listing repositories of %s: %w", project, err)); err != nil {
+					return err
+				}
+				continue
+			}
+			repos = append(repos, projectRepos...)
+		}
+	} else {
+		var err error
+		if repos, err = s.client.listRepos(ctx); err != nil {
+			return fmt.Errorf("error listing repositories: %w", err)
+		}
+	}
+
+	ignoreRepo := s.repoIgnorer(ctx)
+	for _, repo := range repos {
+		if ignoreRepo(repo.FullName) {
+			ctx.Logger().V(3).Info("skipping repository", "repo", repo.FullName, "reason", "ignored in config")
+			continue
+		}
+		if err := report(repo); err != nil {
+			return err
+		}
+	}
+	ctx.Logger().Info("enumerated Bitbucket repositories", "count", len(repos))
+	return nil
+}
+
+// ChunkUnit clones and scans the repository of a unit, then the comments of its pull requests if enabled.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	repoURL, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "repo", repoURL)
+
+	repo, err := s.ensureRepoInCache(ctx, repoURL)
+	if err != nil {
+		return err
+	}
+	if len(s.repos) == 0 && s.repoIgnorer(ctx)(repo.FullName) {
+		ctx.Logger().V(3).Info("skipping repository", "reason", "ignored in config")
+		return nil
+	}
+
+	var path string
+	var gitRepo *gogit.Repository
+	if s.clonePassword != "" {
+		path, gitRepo, err = git.CloneRepoUsingToken(ctx, s.clonePassword, repoURL, "", s.cloneUser, false)
+	} else {
+		path, gitRepo, err = git.CloneRepoUsingUnauthenticated(ctx, repoURL, "")
+	}
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(path)
+
+	if err := s.git.ScanRepo(ctx, gitRepo, path, s.scanOptions, reporter); err != nil {
+		return err
+	}
+
+	if s.includePRComments {
+		if err := s.scanPullRequests(ctx, repo, reporter); err != nil {
+			return fmt.Errorf("error scanning pull requests of %s: %w", repo.FullName, err)
+		}
+	}
+	return nil
+}
+
+// scanPullRequests chunks the comments of a repository's pull requests.
+func (s *Source) scanPullRequests(ctx context.Context, repo repository, reporter sources.ChunkReporter) error {
+	prs, err := s.client.listPullRequests(ctx, repo)
+	if err != nil {
+		return err
+	}
+	for _, pr := range prs {
+		comments, err := s.client.listComments(ctx, repo, pr)
+		if err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("error listing comments of pull request %d: %w", pr.ID, err)); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, comment := range comments {
+			chunk := sources.Chunk{
+				SourceName: s.name,
+				SourceID:   s.SourceID(),
+				JobID:      s.JobID(),
+				SourceType: s.Type(),
+				SourceMetadata: &source_metadatapb.MetaData{
+					Data: &source_metadatapb.MetaData_Bitbucket{
+						Bitbucket: &source_metadatapb.Bitbucket{
+							Repository:    sanitizer.UTF8(repo.CloneURL),
+							Project:       repo.Project,
+							Workspace:     repo.Workspace,
+							Title:         sanitizer.UTF8(pr.Title),
+							PullRequestId: pr.ID,
+							Author:        sanitizer.UTF8(comment.Author),
+							Timestamp:     sanitizer.UTF8(comment.Timestamp),
+							Link:          sanitizer.UTF8(comment.Link),
+						},
+					},
+				},
+				Data:   []byte(comment.Text),
+				Verify: s.verify,
+			}
+			if err := reporter.ChunkOk(ctx, chunk); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// repoIgnorer returns a function reporting whether a repository is excluded by the include and ignore globs.
+func (s *Source) repoIgnorer(ctx context.Context) func(fullName string) bool {
+	compile := func(patterns []string) []glob.Glob {
+		globs := make([]glob.Glob, 0, len(patterns))
+		for _, pattern := range patterns {
+			g, err := glob.Compile(pattern)
+			if err != nil {
+				ctx.Logger().Error(err, "could not compile include/exclude repo glob", "glob", pattern)
+				continue
+			}
+			globs = append(globs, g)
+		}
+		return globs
+	}
+	include, exclude := compile(s.includeRepos), compile(s.ignoreRepos)
+	matches := func(globs []glob.Glob, name string) bool {
+		return slices.ContainsFunc(globs, func(g glob.Glob) bool { return g.Match(name) })
+	}
+	return func(fullName string) bool {
+		if len(include) > 0 && !matches(include, fullName) {
+			return true
+		}
+		return matches(exclude, fullName)
+	}
+}
+
+func (s *Source) cacheRepo(repo repository) {
+	s.repoCacheMu.Lock()
+	defer s.repoCacheMu.Unlock()
+	s.repoCache[withoutUserinfo(repo.CloneURL)] = repo
+}
+
+func (s *Source) cachedRepo(repoURL string) (repository, bool) {
+	s.repoCacheMu.RLock()
+	defer s.repoCacheMu.RUnlock()
+	repo, ok := s.repoCache[withoutUserinfo(repoURL)]
+	return repo, ok
+}
+
+// ensureRepoInCache returns the repository with a clone URL, which ChunkUnit isn't guaranteed to have enumerated,
+// looking it up through the API if needed.
+func (s *Source) ensureRepoInCache(ctx context.Context, repoURL string) (repository, error) {
+	if repo, ok := s.cachedRepo(repoURL); ok {
+		return repo, nil
+	}
+	fullName, err := repoFullName(repoURL, s.cloud)
+	if err != nil {
+		return repository{}, err
+	}
+	repo, err := s.client.getRepo(ctx, fullName)
+	if err != nil {
+		return repository{}, fmt.Errorf("error getting repository %s: %w", fullName, err)
+	}
+	s.cacheRepo(repo)
+	return repo, nil
+}
+
+// repoFullName returns the full name of a repository from its clone URL, which has the form
+// https://<host>/scm/<project key>/<slug>.git on Data Center and https://bitbucket.org/<workspace>/<slug>.git on
+// Cloud. Data Center servers may be hosted under a context path.
+func repoFullName(cloneURL string, cloud bool) (string, error) {
+	u, err := url.Parse(cloneURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid repository URL %q: %w", cloneURL, err)
+	}
+	parts := strings.Split(strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git"), "/")
+	if !cloud {
+		i := slices.Index(parts, "scm")
+		if i < 0 {
+			return "", fmt.Errorf("invalid repository URL %q: expected a /scm/ path", cloneURL)
+		}
+		parts = parts[i+1:]
+	}
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("invalid repository URL %q", cloneURL)
+	}
+	// Data Center clone URLs hold lowercase project keys, which the API accepts.
+	return parts[0] + "/" + parts[1], nil
+}
+
+// withoutUserinfo removes the username and password from a URL, as the git source does from the remote URLs it
+// reports in chunk metadata.
+func withoutUserinfo(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+	u.User = nil
+	return u.String()
+}
@@ -0,0 +1,331 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+// bareRepo creates a bare repository holding one commit of a file, and returns its file:// URL.
+func bareRepo(t *testing.T, file, content string) string {
+	t.Helper()
+	dir := t.TempDir()
+	work, bare := filepath.Join(dir, "work"), filepath.Join(dir, "repo.git")
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	run("init", "-q", work)
+	require.NoError(t, os.WriteFile(filepath.Join(work, file), []byte(content), 0644))
+	run("-C", work, "add", file)
+	run("-C", work, "commit", "-q", "-m", "add "+file)
+	run("clone", "-q", "--bare", work, bare)
+	return "file://" + bare
+}
+
+// apiStandIn serves JSON responses by request path, and fails the test if a request isn't authenticated.
+func apiStandIn(t *testing.T, responses func(baseURL string) map[string]any) *httptest.Server {
+	t.Helper()
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "app-password" {
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"), r.URL.String())
+		}
+		key := r.URL.Path
+		if start := r.URL.Query().Get("start"); start != "" && start != "0" {
+			key += "?start=" + start
+		}
+		if page := r.URL.Query().Get("page"); page != "" {
+			key += "?page=" + page
+		}
+		resp, ok := responses(server.URL)[key]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func dataCenterRepoJSON(baseURL, project, slug, cloneURL string) map[string]any {
+	return map[string]any{
+		"slug":    slug,
+		"project": map[string]any{"key": project},
+		"links": map[string]any{
+			"clone": []map[string]any{{"href": cloneURL, "name": "http"}, {"href": "ssh://git@example.com/x.git", "name": "ssh"}},
+			"self":  []map[string]any{{"href": baseURL + "/projects/" + project + "/repos/" + slug + "/browse"}},
+		},
+	}
+}
+
+func chunkUnits(t *testing.T, s *Source) (*sourcestest.TestReporter, *sourcestest.TestReporter) {
+	t.Helper()
+	ctx := context.Background()
+	units := &sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(ctx, units))
+	require.Empty(t, units.UnitErrs)
+
+	chunks := &sourcestest.TestReporter{}
+	for _, unit := range units.Units {
+		require.NoError(t, s.ChunkUnit(ctx, unit, chunks))
+	}
+	require.Empty(t, chunks.ChunkErrs)
+	return units, chunks
+}
+
+func TestSource_DataCenter(t *testing.T) {
+	app := bareRepo(t, "config.yaml", "password: app-secret\n")
+	docs := bareRepo(t, "README.md", "docs\n")
+	server := apiStandIn(t, func(baseURL string) map[string]any {
+		prLink := baseURL + "/projects/PROJ/repos/app/pull-requests/1"
+		return map[string]any{
+			// Repositories are listed over two pages.
+			"/rest/api/1.0/repos": map[string]any{
+				"values":        []any{dataCenterRepoJSON(baseURL, "PROJ", "app", app)},
+				"isLastPage":    false,
+				"nextPageStart": 1,
+			},
+			"/rest/api/1.0/repos?start=1": map[string]any{
+				"values":     []any{dataCenterRepoJSON(baseURL, "PROJ", "docs", docs)},
+				"isLastPage": true,
+			},
+			"/rest/api/1.0/projects/PROJ/repos/app/pull-requests": map[string]any{
+				"values": []any{map[string]any{
+					"id": 1, "title": "Add config",
+					"links": map[string]any{"self": []map[string]any{{"href": prLink}}},
+				}},
+				"isLastPage": true,
+			},
+			"/rest/api/1.0/projects/PROJ/repos/app/pull-requests/1/activities": map[string]any{
+				"values": []any{
+					map[string]any{"action": "APPROVED"},
+					map[string]any{"action": "COMMENTED", "comment": map[string]any{
+						"id": 10, "text": "use token abc", "createdDate": 1700000000000,
+						"author": map[string]any{"name": "jdoe", "emailAddress": "jdoe@example.com"},
+						"comments": []any{map[string]any{
+							"id": 11, "text": "reply", "createdDate": 1700000000000,
+							"author": map[string]any{"name": "asmith"},
+						}},
+					}},
+				},
+				"isLastPage": true,
+			},
+		}
+	})
+
+	tests := []struct {
+		name      string
+		conn      *sourcespb.Bitbucket
+		wantFiles []string
+		wantPRs   []string
+	}{
+		{
+			name: "all repositories",
+			conn: &sourcespb.Bitbucket{
+				Endpoint:   server.URL,
+				Credential: &sourcespb.Bitbucket_Token{Token: "token"},
+			},
+			wantFiles: []string{"README.md", "config.yaml"},
+		},
+		{
+			name: "included repositories with pull request comments",
+			conn: &sourcespb.Bitbucket{
+				Endpoint: server.URL,
+				Credential: &sourcespb.Bitbucket_BasicAuth{
+					BasicAuth: &credentialspb.BasicAuth{Username: "user", Password: "app-password"},
+				},
+				IncludeRepos:               []string{"PROJ/*"},
+				IgnoreRepos:                []string{"*/docs"},
+				IncludePullRequestComments: true,
+			},
+			wantFiles: []string{"config.yaml"},
+			wantPRs:   []string{"use token abc", "reply"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, err := anypb.New(tt.conn)
+			require.NoError(t, err)
+			s := &Source{}
+			require.NoError(t, s.Init(context.Background(), "test bitbucket", 0, 0, false, conn, 1))
+			assert.False(t, s.cloud)
+
+			_, chunks := chunkUnits(t, s)
+			var files, prs []string
+			for _, chunk := range chunks.Chunks {
+				md := chunk.SourceMetadata.GetBitbucket()
+				require.NotNil(t, md)
+				assert.Equal(t, "PROJ", md.GetProject())
+				switch {
+				case md.GetPullRequestId() != 0:
+					prs = append(prs, string(chunk.Data))
+					assert.Equal(t, "Add config", md.GetTitle())
+					assert.Contains(t, md.GetLink(), "/pull-requests/1/overview?commentId=")
+				case md.GetFile() != "":
+					files = append(files, md.GetFile())
+					assert.Contains(t, md.GetLink(), server.URL+"/projects/PROJ/repos/")
+					assert.Contains(t, md.GetLink(), "/commits/"+md.GetCommit())
+				}
+			}
+			slices.Sort(files)
+			assert.Equal(t, tt.wantFiles, files)
+			assert.Equal(t, tt.wantPRs, prs)
+			if tt.wantPRs != nil {
+				authors := []string{}
+				for _, chunk := range chunks.Chunks {
+					if md := chunk.SourceMetadata.GetBitbucket(); md.GetPullRequestId() != 0 {
+						authors = append(authors, md.GetAuthor())
+					}
+				}
+				assert.Equal(t, []string{"jdoe@example.com", "asmith"}, authors)
+			}
+		})
+	}
+}
+
+func TestSource_Cloud(t *testing.T) {
+	app := bareRepo(t, "config.yaml", "password: app-secret\n")
+	server := apiStandIn(t, func(baseURL string) map[string]any {
+		return map[string]any{
+			"/2.0/repositories/ws": map[string]any{
+				"values": []any{},
+				"next":   baseURL + "/2.0/repositories/ws?page=2",
+			},
+			"/2.0/repositories/ws?page=2": map[string]any{
+				"values": []any{map[string]any{
+					"slug": "app", "full_name": "ws/app",
+					"workspace": map[string]any{"slug": "ws"},
+					"project":   map[string]any{"key": "PROJ"},
+					"links": map[string]any{
+						"clone": []map[string]any{{"href": app, "name": "https"}},
+						"html":  map[string]any{"href": "https://bitbucket.org/ws/app"},
+					},
+				}},
+			},
+			"/2.0/repositories/ws/app/pullrequests": map[string]any{
+				"values": []any{map[string]any{"id": 7, "title": "Fix"}},
+			},
+			"/2.0/repositories/ws/app/pullrequests/7/comments": map[string]any{
+				"values": []any{
+					map[string]any{
+						"id": 1, "content": map[string]any{"raw": "here is the key"},
+						"user":  map[string]any{"nickname": "jdoe"},
+						"links": map[string]any{"html": map[string]any{"href": "https://bitbucket.org/ws/app/pull-requests/7#comment-1"}},
+					},
+					map[string]any{"id": 2, "deleted": true, "content": map[string]any{"raw": ""}},
+				},
+			},
+		}
+	})
+
+	conn, err := anypb.New(&sourcespb.Bitbucket{
+		Endpoint:                   server.URL,
+		InstallationType:           sourcespb.BitbucketInstallationType_CLOUD,
+		Credential:                 &sourcespb.Bitbucket_Token{Token: "token"},
+		Projects:                   []string{"ws"},
+		IncludePullRequestComments: true,
+	})
+	require.NoError(t, err)
+	s := &Source{}
+	require.NoError(t, s.Init(context.Background(), "test bitbucket", 0, 0, false, conn, 1))
+	assert.True(t, s.cloud)
+
+	units, chunks := chunkUnits(t, s)
+	assert.Equal(t, []sources.SourceUnit{git.SourceUnit{Kind: git.UnitRepo, ID: app}}, units.Units)
+
+	var comments []*source_metadatapb.Bitbucket
+	for _, chunk := range chunks.Chunks {
+		md := chunk.SourceMetadata.GetBitbucket()
+		assert.Equal(t, "ws", md.GetWorkspace())
+		if md.GetPullRequestId() != 0 {
+			assert.Equal(t, "here is the key", string(chunk.Data))
+			comments = append(comments, md)
+		} else if md.GetFile() != "" {
+			assert.Equal(t, "https://bitbucket.org/ws/app/commits/"+md.GetCommit(), md.GetLink())
+		}
+	}
+	require.Len(t, comments, 1)
+	assert.Equal(t, int64(7), comments[0].GetPullRequestId())
+	assert.Equal(t, "jdoe", comments[0].GetAuthor())
+	assert.Equal(t, "https://bitbucket.org/ws/app/pull-requests/7#comment-1", comments[0].GetLink())
+}
+
+func TestResolveEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint         string
+		installationType sourcespb.BitbucketInstallationType
+		want             string
+		wantCloud        bool
+		wantErr          bool
+	}{
+		{endpoint: "", want: cloudAPIURL, wantCloud: true},
+		{endpoint: "https://bitbucket.org", want: cloudAPIURL, wantCloud: true},
+		{endpoint: "https://bitbucket.example.com", want: "https://bitbucket.example.com"},
+		{endpoint: "bitbucket.example.com", want: "https://bitbucket.example.com"},
+		{endpoint: "https://proxy.example.com", installationType: sourcespb.BitbucketInstallationType_CLOUD, want: "https://proxy.example.com", wantCloud: true},
+		{endpoint: "", installationType: sourcespb.BitbucketInstallationType_DATA_CENTER, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, cloud, err := resolveEndpoint(tt.endpoint, tt.installationType)
+		if tt.wantErr {
+			assert.Error(t, err, tt.endpoint)
+			continue
+		}
+		require.NoError(t, err, tt.endpoint)
+		assert.Equal(t, tt.want, got, tt.endpoint)
+		assert.Equal(t, tt.wantCloud, cloud, tt.endpoint)
+	}
+}
+
+func TestRepoFullName(t *testing.T) {
+	tests := []struct {
+		cloneURL string
+		cloud    bool
+		want     string
+		wantErr  bool
+	}{
+		{cloneURL: "https://bitbucket.example.com/scm/proj/app.git", want: "proj/app"},
+		{cloneURL: "https://jdoe@bitbucket.example.com/bitbucket/scm/proj/app.git", want: "proj/app"},
+		{cloneURL: "https://bitbucket.example.com/proj/app.git", wantErr: true},
+		{cloneURL: "https://jdoe@bitbucket.org/ws/app.git", cloud: true, want: "ws/app"},
+		{cloneURL: "https://bitbucket.org/ws.git", cloud: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := repoFullName(tt.cloneURL, tt.cloud)
+		if tt.wantErr {
+			assert.Error(t, err, tt.cloneURL)
+			continue
+		}
+		require.NoError(t, err, tt.cloneURL)
+		assert.Equal(t, tt.want, got, tt.cloneURL)
+	}
+}
+
+func TestWithoutUserinfo(t *testing.T) {
+	assert.Equal(t, "https://bitbucket.org/ws/app.git", withoutUserinfo("https://jdoe@bitbucket.org/ws/app.git"))
+	assert.True(t, strings.HasPrefix(withoutUserinfo("file:///tmp/repo.git"), "file:///tmp/repo.git"))
+}
@@ -0,0 +1,445 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// repository is a Bitbucket repository, as returned by either API.
+type repository struct {
+	// FullName is "<project key>/<slug>" on Data Center and "<workspace>/<slug>" on Cloud.
+	FullName string
+	Slug     string
+	// Project is the key of the repository's project, if any.
+	Project string
+	// Workspace is the repository's workspace. It's only set on Cloud.
+	Workspace string
+	CloneURL  string
+	// Link is the repository's web page.
+	Link string
+}
+
+// pullRequest is a Bitbucket pull request.
+type pullRequest struct {
+	ID    int64
+	Title string
+	Link  string
+}
+
+// comment is a comment on a pull request.
+type comment struct {
+	ID        int64
+	Text      string
+	Author    string
+	Timestamp string
+	Link      string
+}
+
+// client lists repositories, pull requests and comments through the REST API of a Bitbucket Data Center server or
+// of Bitbucket Cloud.
+type client interface {
+	// listRepos lists all repositories the credentials can read.
+	listRepos(ctx context.Context) ([]repository, error)
+	// listProjectRepos lists the repositories of a Data Center project or Cloud workspace.
+	listProjectRepos(ctx context.Context, project string) ([]repository, error)
+	// getRepo returns a repository by full name.
+	getRepo(ctx context.Context, fullName string) (repository, error)
+	listPullRequests(ctx context.Context, repo repository) ([]pullRequest, error)
+	listComments(ctx context.Context, repo repository, pr pullRequest) ([]comment, error)
+}
+
+// apiClient sends authenticated requests to a Bitbucket REST API.
+type apiClient struct {
+	baseURL    string
+	httpClient *http.Client
+	// authenticate adds the credentials to a request.
+	authenticate func(*http.Request)
+}
+
+func newAPIClient(baseURL string, authenticate func(*http.Request)) apiClient {
+	return apiClient{
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+		httpClient:   common.RetryableHTTPClientTimeout(30),
+		authenticate: authenticate,
+	}
+}
+
+// get decodes the JSON response to a GET request into v. The URL is either absolute or relative to the base URL.
+func (c apiClient) get(ctx context.Context, reqURL string, v any) error {
+	if !strings.HasPrefix(reqURL, "http://") && !strings.HasPrefix(reqURL, "https://") {
+		reqURL = c.baseURL + reqURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	c.authenticate(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, req.URL.Redacted())
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding response for %s: %w", req.URL.Redacted(), err)
+	}
+	return nil
+}
+
+// dataCenterClient is a client of the REST API of Bitbucket Data Center and Server.
+//
+// https://developer.atlassian.com/server/bitbucket/rest/
+type dataCenterClient struct {
+	apiClient
+}
+
+type dataCenterPage[T any] struct {
+	Values        []T  `json:"values"`
+	IsLastPage    bool `json:"isLastPage"`
+	NextPageStart int  `json:"nextPageStart"`
+}
+
+type dataCenterLinks struct {
+	Clone []struct {
+		Href string `json:"href"`
+		Name string `json:"name"`
+	} `json:"clone"`
+	Self []struct {
+		Href string `json:"href"`
+	} `json:"self"`
+}
+
+func (l dataCenterLinks) self() string {
+	if len(l.Self) == 0 {
+		return ""
+	}
+	return l.Self[0].Href
+}
+
+type dataCenterRepo struct {
+	Slug    string `json:"slug"`
+	Project struct {
+		Key string `json:"key"`
+	} `json:"project"`
+	Links dataCenterLinks `json:"links"`
+}
+
+func (r dataCenterRepo) repository() repository {
+	repo := repository{
+		FullName: r.Project.Key + "/" + r.Slug,
+		Slug:     r.Slug,
+		Project:  r.Project.Key,
+		Link:     strings.TrimSuffix(r.Links.self(), "/browse"),
+	}
+	for _, link := range r.Links.Clone {
+		if link.Name == "http" {
+			repo.CloneURL = link.Href
+		}
+	}
+	return repo
+}
+
+type dataCenterComment struct {
+	ID     int64  `json:"id"`
+	Text   string `json:"text"`
+	Author struct {
+		Name         string `json:"name"`
+		EmailAddress string `json:"emailAddress"`
+	} `json:"author"`
+	CreatedDate int64               `json:"createdDate"`
+	Comments    []dataCenterComment `json:"comments"`
+}
+
+// dataCenterPages collects the values of all pages of a paginated resource.
+func dataCenterPages[T any](ctx context.Context, c dataCenterClient, path string, query url.Values) ([]T, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("limit", "100")
+	var values []T
+	for start := 0; ; {
+		query.Set("start", strconv.Itoa(start))
+		var page dataCenterPage[T]
+		if err := c.get(ctx, path+"?"+query.Encode(), &page); err != nil {
+			return nil, err
+		}
+		values = append(values, page.Values...)
+		if page.IsLastPage || page.NextPageStart <= start {
+			return values, nil
+		}
+		start = page.NextPageStart
+	}
+}
+
+func (c dataCenterClient) repos(ctx context.Context, path string) ([]repository, error) {
+	repos, err := dataCenterPages[dataCenterRepo](ctx, c, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]repository, 0, len(repos))
+	for _, repo := range repos {
+		result = append(result, repo.repository())
+	}
+	return result, nil
+}
+
+func (c dataCenterClient) listRepos(ctx context.Context) ([]repository, error) {
+	return c.repos(ctx, "/rest/api/1.0/repos")
+}
+
+func (c dataCenterClient) listProjectRepos(ctx context.Context, project string) ([]repository, error) {
+	return c.repos(ctx, "/rest/api/1.0/projects/"+url.PathEscape(project)+"/repos")
+}
+
+func (c dataCenterClient) getRepo(ctx context.Context, fullName string) (repository, error) {
+	project, slug, ok := strings.Cut(fullName, "/")
+	if !ok {
+		return repository{}, fmt.Errorf("invalid repository %q: expected <project key>/<slug>", fullName)
+	}
+	var repo dataCenterRepo
+	if err := c.get(ctx, "/rest/api/1.0/projects/"+url.PathEscape(project)+"/repos/"+url.PathEscape(slug), &repo); err != nil {
+		return repository{}, err
+	}
+	return repo.repository(), nil
+}
+
+func (c dataCenterClient) repoPath(repo repository) string {
+	return "/rest/api/1.0/projects/" + url.PathEscape(repo.Project) + "/repos/" + url.PathEscape(repo.Slug)
+}
+
+func (c dataCenterClient) listPullRequests(ctx context.Context, repo repository) ([]pullRequest, error) {
+	type dataCenterPullRequest struct {
+		ID    int64           `json:"id"`
+		Title string          `json:"title"`
+		Links dataCenterLinks `json:"links"`
+	}
+	prs, err := dataCenterPages[dataCenterPullRequest](ctx, c, c.repoPath(repo)+"/pull-requests", url.Values{"state": {"ALL"}})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]pullRequest, 0, len(prs))
+	for _, pr := range prs {
+		result = append(result, pullRequest{ID: pr.ID, Title: pr.Title, Link: pr.Links.self()})
+	}
+	return result, nil
+}
+
+func (c dataCenterClient) listComments(ctx context.Context, repo repository, pr pullRequest) ([]comment, error) {
+	type dataCenterActivity struct {
+		Action  string             `json:"action"`
+		Comment *dataCenterComment `json:"comment"`
+	}
+	path := fmt.Sprintf("%s/pull-requests/%d/activities", c.repoPath(repo), pr.ID)
+	activities, err := dataCenterPages[dataCenterActivity](ctx, c, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []comment
+	// Replies are nested in the comments they reply to.
+	var add func(dataCenterComment)
+	add = func(c dataCenterComment) {
+		author := c.Author.Name
+		if c.Author.EmailAddress != "" {
+			author = c.Author.EmailAddress
+		}
+		comments = append(comments, comment{
+			ID:        c.ID,
+			Text:      c.Text,
+			Author:    author,
+			Timestamp: time.UnixMilli(c.CreatedDate).UTC().Format(time.RFC3339),
+			Link:      fmt.Sprintf("%s/overview?commentId=%d", pr.Link, c.ID),
+		})
+		for _, reply := range c.Comments {
+			add(reply)
+		}
+	}
+	for _, activity := range activities {
+		if activity.Action == "COMMENTED" && activity.Comment != nil {
+			add(*activity.Comment)
+		}
+	}
+	return comments, nil
+}
+
+// cloudClient is a client of the REST API of Bitbucket Cloud.
+//
+// https://developer.atlassian.com/cloud/bitbucket/rest/
+type cloudClient struct {
+	apiClient
+}
+
+type cloudPage[T any] struct {
+	Values []T    `json:"values"`
+	Next   string `json:"next"`
+}
+
+type cloudLink struct {
+	Href string `json:"href"`
+}
+
+type cloudRepo struct {
+	Slug      string `json:"slug"`
+	FullName  string `json:"full_name"`
+	Workspace struct {
+		Slug string `json:"slug"`
+	} `json:"workspace"`
+	Project struct {
+		Key string `json:"key"`
+	} `json:"project"`
+	Links struct {
+		Clone []struct {
+			Href string `json:"href"`
+			Name string `json:"name"`
+		} `json:"clone"`
+		HTML cloudLink `json:"html"`
+	} `json:"links"`
+}
+
+func (r cloudRepo) repository() repository {
+	repo := repository{
+		FullName:  r.FullName,
+		Slug:      r.Slug,
+		Project:   r.Project.Key,
+		Workspace: r.Workspace.Slug,
+		Link:      r.Links.HTML.Href,
+	}
+	for _, link := range r.Links.Clone {
+		if link.Name == "https" {
+			repo.CloneURL = link.Href
+		}
+	}
+	return repo
+}
+
+// cloudPages collects the values of all pages of a paginated resource.
+func cloudPages[T any](ctx context.Context, c cloudClient, path string, query url.Values) ([]T, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("pagelen", "100")
+	var values []T
+	for next := path + "?" + query.Encode(); next != ""; {
+		var page cloudPage[T]
+		if err := c.get(ctx, next, &page); err != nil {
+			return nil, err
+		}
+		values = append(values, page.Values...)
+		next = page.Next
+	}
+	return values, nil
+}
+
+func (c cloudClient) repos(ctx context.Context, path string, query url.Values) ([]repository, error) {
+	repos, err := cloudPages[cloudRepo](ctx, c, path, query)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]repository, 0, len(repos))
+	for _, repo := range repos {
+		result = append(result, repo.repository())
+	}
+	return result, nil
+}
+
+func (c cloudClient) listRepos(ctx context.Context) ([]repository, error) {
+	return c.repos(ctx, "/2.0/repositories", url.Values{"role": {"member"}})
+}
+
+func (c cloudClient) listProjectRepos(ctx context.Context, workspace string) ([]repository, error) {
+	return c.repos(ctx, "/2.0/repositories/"+url.PathEscape(workspace), nil)
+}
+
+func (c cloudClient) getRepo(ctx context.Context, fullName string) (repository, error) {
+	workspace, slug, ok := strings.Cut(fullName, "/")
+	if !ok {
+		return repository{}, fmt.Errorf("invalid repository %q: expected <workspace>/<slug>", fullName)
+	}
+	var repo cloudRepo
+	if err := c.get(ctx, "/2.0/repositories/"+url.PathEscape(workspace)+"/"+url.PathEscape(slug), &repo); err != nil {
+		return repository{}, err
+	}
+	return repo.repository(), nil
+}
+
+func (c cloudClient) repoPath(repo repository) string {
+	return "/2.0/repositories/" + url.PathEscape(repo.Workspace) + "/" + url.PathEscape(repo.Slug)
+}
+
+func (c cloudClient) listPullRequests(ctx context.Context, repo repository) ([]pullRequest, error) {
+	type cloudPullRequest struct {
+		ID    int64  `json:"id"`
+		Title string `json:"title"`
+		Links struct {
+			HTML cloudLink `json:"html"`
+		} `json:"links"`
+	}
+	query := url.Values{"state": {"OPEN", "MERGED", "DECLINED", "SUPERSEDED"}}
+	prs, err := cloudPages[cloudPullRequest](ctx, c, c.repoPath(repo)+"/pullrequests", query)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]pullRequest, 0, len(prs))
+	for _, pr := range prs {
+		result = append(result, pullRequest{ID: pr.ID, Title: pr.Title, Link: pr.Links.HTML.Href})
+	}
+	return result, nil
+}
+
+func (c cloudClient) listComments(ctx context.Context, repo repository, pr pullRequest) ([]comment, error) {
+	type cloudComment struct {
+		ID      int64 `json:"id"`
+		Deleted bool  `json:"deleted"`
+		Content struct {
+			Raw string `json:"raw"`
+		} `json:"content"`
+		User struct {
+			DisplayName string `json:"display_name"`
+			Nickname    string `json:"nickname"`
+		} `json:"user"`
+		CreatedOn string `json:"created_on"`
+		Links     struct {
+			HTML cloudLink `json:"html"`
+		} `json:"links"`
+	}
+	path := fmt.Sprintf("%s/pullrequests/%d/comments", c.repoPath(repo), pr.ID)
+	cloudComments, err := cloudPages[cloudComment](ctx, c, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]comment, 0, len(cloudComments))
+	for _, c := range cloudComments {
+		if c.Deleted {
+			continue
+		}
+		author := c.User.Nickname
+		if author == "" {
+			author = c.User.DisplayName
+		}
+		comments = append(comments, comment{
+			ID:        c.ID,
+			Text:      c.Content.Raw,
+			Author:    author,
+			Timestamp: c.CreatedOn,
+			Link:      c.Links.HTML.Href,
+		})
+	}
+	return comments, nil
+}
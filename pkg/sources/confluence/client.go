@@ -0,0 +1,227 @@
+package confluence
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// resultsPerPage is the number of results requested per page of a list or search.
+const resultsPerPage = 50
+
+type user struct {
+	DisplayName string `json:"displayName"`
+	// PublicName is the name of Cloud accounts hiding their display name.
+	PublicName string `json:"publicName"`
+	Email      string `json:"email"`
+	// Username is the name of Data Center accounts.
+	Username string `json:"username"`
+}
+
+// String returns the user's display name, or else its public name or username.
+func (u user) String() string {
+	switch {
+	case u.DisplayName != "":
+		return u.DisplayName
+	case u.PublicName != "":
+		return u.PublicName
+	default:
+		return u.Username
+	}
+}
+
+type version struct {
+	Number int64  `json:"number"`
+	When   string `json:"when"`
+	By     user   `json:"by"`
+}
+
+// content is a page, blog post, comment or attachment.
+type content struct {
+	ID    string `json:"id"`
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	Space struct {
+		Key string `json:"key"`
+	} `json:"space"`
+	Version version `json:"version"`
+	Body    struct {
+		Storage struct {
+			Value string `json:"value"`
+		} `json:"storage"`
+	} `json:"body"`
+	Links struct {
+		WebUI string `json:"webui"`
+		// Download is the path to download an attachment from.
+		Download string `json:"download"`
+	} `json:"_links"`
+}
+
+type page[T any] struct {
+	Results []T `json:"results"`
+	Links   struct {
+		// Next is the path of the next page, if any.
+		Next string `json:"next"`
+	} `json:"_links"`
+}
+
+// client sends requests to the REST API of a Confluence Cloud site or Confluence Data Center server.
+//
+// https://developer.atlassian.com/cloud/confluence/rest/v1/
+// https://developer.atlassian.com/server/confluence/rest/
+type client struct {
+	// baseURL is the URL of the site, which the paths of links are relative to. It ends with /wiki on Cloud.
+	baseURL    string
+	httpClient *http.Client
+	// authenticate adds the credentials to a request.
+	authenticate func(*http.Request)
+}
+
+// do sends a GET request to a path and returns the response if its status is OK. The Accept header is set to accept
+// if it isn't empty.
+func (c *client) do(ctx context.Context, path, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	c.authenticate(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, req.URL.Redacted())
+	}
+	return resp, nil
+}
+
+// get decodes the JSON response to a GET request of an API path into v.
+func (c *client) get(ctx context.Context, path string, v any) error {
+	resp, err := c.do(ctx, path, "application/json")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding response for %s: %w", resp.Request.URL.Redacted(), err)
+	}
+	return nil
+}
+
+// list calls visit with each result of a paginated API path, following the links to the next pages.
+func list[T any](ctx context.Context, c *client, path string, query url.Values, visit func(T) error) error {
+	query.Set("limit", strconv.Itoa(resultsPerPage))
+	next := path + "?" + query.Encode()
+	for next != "" {
+		var p page[T]
+		if err := c.get(ctx, next, &p); err != nil {
+			return err
+		}
+		for _, result := range p.Results {
+			if err := visit(result); err != nil {
+				return err
+			}
+		}
+		next = p.Links.Next
+	}
+	return nil
+}
+
+// listSpaces lists the keys of the spaces of a type, "global" or "personal", or of every type if it's empty.
+func (c *client) listSpaces(ctx context.Context, spaceType string) ([]string, error) {
+	query := url.Values{}
+	if spaceType != "" {
+		query.Set("type", spaceType)
+	}
+	var keys []string
+	err := list(ctx, c, "/rest/api/space", query, func(space struct {
+		Key string `json:"key"`
+	}) error {
+		keys = append(keys, space.Key)
+		return nil
+	})
+	return keys, err
+}
+
+// searchContent calls visit with each page and blog post matching a CQL query, with its body.
+func (c *client) searchContent(ctx context.Context, cql string, visit func(content) error) error {
+	query := url.Values{
+		"cql":    {cql},
+		"expand": {"body.storage,version,space"},
+	}
+	return list(ctx, c, "/rest/api/content/search", query, visit)
+}
+
+// listVersions lists the versions of a page or blog post.
+func (c *client) listVersions(ctx context.Context, id string) ([]version, error) {
+	var versions []version
+	err := list(ctx, c, "/rest/api/content/"+url.PathEscape(id)+"/version", url.Values{}, func(v version) error {
+		versions = append(versions, v)
+		return nil
+	})
+	return versions, err
+}
+
+// getVersion returns a previous version of a page or blog post, with its body.
+func (c *client) getVersion(ctx context.Context, id string, number int64) (content, error) {
+	query := url.Values{
+		"status":  {"historical"},
+		"version": {strconv.FormatInt(number, 10)},
+		"expand":  {"body.storage,version,space"},
+	}
+	var version content
+	err := c.get(ctx, "/rest/api/content/"+url.PathEscape(id)+"?"+query.Encode(), &version)
+	return version, err
+}
+
+// listComments lists the comments of a page or blog post, including replies, with their bodies.
+func (c *client) listComments(ctx context.Context, id string) ([]content, error) {
+	query := url.Values{
+		"expand": {"body.storage,version"},
+		"depth":  {"all"},
+	}
+	var comments []content
+	err := list(ctx, c, "/rest/api/content/"+url.PathEscape(id)+"/child/comment", query, func(comment content) error {
+		comments = append(comments, comment)
+		return nil
+	})
+	return comments, err
+}
+
+// listAttachments lists the attachments of a page or blog post.
+func (c *client) listAttachments(ctx context.Context, id string) ([]content, error) {
+	query := url.Values{"expand": {"version"}}
+	var attachments []content
+	err := list(ctx, c, "/rest/api/content/"+url.PathEscape(id)+"/child/attachment", query, func(attachment content) error {
+		attachments = append(attachments, attachment)
+		return nil
+	})
+	return attachments, err
+}
+
+// download returns the content of an attachment.
+func (c *client) download(ctx context.Context, attachment content) (io.ReadCloser, error) {
+	if !strings.HasPrefix(attachment.Links.Download, "/") {
+		return nil, fmt.Errorf("attachment %s has no download link", attachment.ID)
+	}
+	resp, err := c.do(ctx, attachment.Links.Download, "")
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,390 @@
+package confluence
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/roundtripper"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_CONFLUENCE
+
+// UnitSpace is the kind of the units of the source. Their IDs are space keys.
+const UnitSpace sources.SourceUnitKind = "space"
+
+// Locations of the chunks of a page or blog post that aren't its body, recorded in their metadata. The body's
+// location is the content's type, "page" or "blogpost".
+const (
+	locationComment    = "comment"
+	locationAttachment = "attachment"
+)
+
+// Source scans the pages and blog posts of the spaces of a Confluence Cloud site or Confluence Data Center server,
+// and their previous versions, and optionally their comments and attachments.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	endpoint string
+	client   *client
+
+	spaces             []string
+	ignoreSpaces       []string
+	spaceType          string
+	cql                string
+	since              time.Time
+	skipHistory        bool
+	includeComments    bool
+	includeAttachments bool
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Confluence source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Confluence
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.endpoint = strings.TrimSuffix(conn.GetEndpoint(), "/")
+	if u, err := url.Parse(s.endpoint); err != nil || u.Host == "" {
+		return fmt.Errorf("invalid endpoint %q", conn.GetEndpoint())
+	}
+
+	var authenticate func(*http.Request)
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Confluence_BasicAuth:
+		log.RedactGlobally(cred.BasicAuth.GetPassword())
+		authenticate = func(req *http.Request) {
+			req.SetBasicAuth(cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword())
+		}
+	case *sourcespb.Confluence_Token:
+		log.RedactGlobally(cred.Token)
+		authenticate = func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer "+cred.Token)
+		}
+	case *sourcespb.Confluence_Unauthenticated:
+		authenticate = func(*http.Request) {}
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+
+	opts := []func(*roundtripper.RoundTripper){
+		roundtripper.WithLogger(ctx.Logger()),
+		roundtripper.WithRetryable(),
+	}
+	if conn.GetInsecureSkipVerifyTls() {
+		opts = append(opts, roundtripper.WithInsecureTLS())
+	}
+	s.client = &client{
+		baseURL:      s.endpoint,
+		httpClient:   &http.Client{Transport: roundtripper.NewRoundTripper(nil, opts...)},
+		authenticate: authenticate,
+	}
+
+	s.spaces = conn.GetSpaces()
+	s.ignoreSpaces = conn.GetIgnoreSpaces()
+	switch conn.GetSpacesScope() {
+	case sourcespb.Confluence_GLOBAL:
+		s.spaceType = "global"
+	case sourcespb.Confluence_PERSONAL:
+		s.spaceType = "personal"
+	}
+	s.cql = conn.GetCql()
+	if conn.GetSince() != nil {
+		s.since = conn.GetSince().AsTime()
+	}
+	s.skipHistory = conn.GetSkipHistory()
+	s.includeComments = conn.GetIncludeComments()
+	s.includeAttachments = conn.GetIncludeAttachments()
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var spaces []string
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			spaces = append(spaces, id)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating spaces")
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+	// We must sort the spaces so we can resume later if necessary.
+	slices.Sort(spaces)
+
+	// If there is resume information available, limit this scan to only the spaces that still need scanning.
+	spacesToScan, progressIndexOffset := sources.FilterReposToResume(spaces, s.GetProgress().EncodedResumeInfo)
+	scanErrs := sources.NewScanErrors()
+	for i, space := range spacesToScan {
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.setProgressCompleteWithSpace(i, progressIndexOffset, len(spacesToScan), space)
+			// Ensure the space is removed from the resume info after being scanned.
+			defer func() {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, space)
+			}()
+
+			unit := sources.CommonSourceUnit{Kind: UnitSpace, ID: space}
+			if err := s.ChunkUnit(ctx, unit, sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(spacesToScan), len(spacesToScan), "Completed Confluence scan", "")
+	return nil
+}
+
+// setProgressCompleteWithSpace calls the s.SetProgressComplete after safely setting up the encoded resume info
+// string.
+func (s *Source) setProgressCompleteWithSpace(index, offset, total int, space string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	s.resumeInfoSlice = append(s.resumeInfoSlice, space)
+	slices.Sort(s.resumeInfoSlice)
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	s.SetProgressComplete(index+offset, total+offset, fmt.Sprintf("Space: %s", space), encodedResumeInfo)
+}
+
+// Enumerate reports the configured spaces, or else every space of the configured scope the credentials can view,
+// except the ignored ones, to the reporter. Units are identified by space key.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	spaces := s.spaces
+	if len(spaces) == 0 {
+		var err error
+		if spaces, err = s.client.listSpaces(ctx, s.spaceType); err != nil {
+			return fmt.Errorf("error listing spaces: %w", err)
+		}
+		ctx.Logger().Info("enumerated Confluence spaces", "count", len(spaces))
+	}
+	for _, space := range spaces {
+		if slices.Contains(s.ignoreSpaces, space) {
+			ctx.Logger().V(3).Info("skipping space", "space", space, "reason", "ignored in config")
+			continue
+		}
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitSpace, ID: space}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit chunks the pages and blog posts of a space matching the configured CQL query and modified since the
+// configured time, along with their previous versions, comments and attachments.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	space, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "space", space)
+
+	var count int
+	err := s.client.searchContent(ctx, contentQuery(space, s.cql, s.since), func(c content) error {
+		count++
+		if err := s.scanContent(ctx, c, reporter); err != nil {
+			return reporter.ChunkErr(ctx, fmt.Errorf("error scanning %s %q: %w", c.Type, c.Title, err))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error searching the content of %s: %w", space, err)
+	}
+	ctx.Logger().V(2).Info("scanned pages and blog posts", "count", count)
+	return nil
+}
+
+// contentQuery returns the CQL query of the pages and blog posts of a space to scan. The configured query mustn't
+// have an ORDER BY clause since it's combined with the others.
+func contentQuery(space, cql string, since time.Time) string {
+	clauses := []string{fmt.Sprintf("space = %q", space), "type in (page, blogpost)"}
+	if cql != "" {
+		clauses = append(clauses, "("+cql+")")
+	}
+	if !since.IsZero() {
+		// CQL dates have a precision of minutes, so round down to not miss any content.
+		clauses = append(clauses, fmt.Sprintf("lastmodified >= %q", since.UTC().Format("2006/01/02 15:04")))
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+// scanContent chunks the body of a page or blog post, then its previous versions unless history is skipped, and its
+// comments and attachments if they're included.
+func (s *Source) scanContent(ctx context.Context, c content, reporter sources.ChunkReporter) error {
+	link := s.endpoint + c.Links.WebUI
+	if err := s.reportBody(ctx, c, c, link, reporter); err != nil {
+		return err
+	}
+
+	if !s.skipHistory {
+		versions, err := s.client.listVersions(ctx, c.ID)
+		if err != nil {
+			return fmt.Errorf("error listing versions: %w", err)
+		}
+		for _, v := range versions {
+			if v.Number >= c.Version.Number {
+				continue
+			}
+			previous, err := s.client.getVersion(ctx, c.ID, v.Number)
+			if err != nil {
+				return fmt.Errorf("error getting version %d: %w", v.Number, err)
+			}
+			versionLink := s.endpoint + "/pages/viewpage.action?" + url.Values{
+				"pageId":      {c.ID},
+				"pageVersion": {strconv.FormatInt(v.Number, 10)},
+			}.Encode()
+			if err := s.reportBody(ctx, c, previous, versionLink, reporter); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.includeComments {
+		comments, err := s.client.listComments(ctx, c.ID)
+		if err != nil {
+			return fmt.Errorf("error listing comments: %w", err)
+		}
+		for _, comment := range comments {
+			chunk := s.chunk(c, comment.Version, &source_metadatapb.Confluence{
+				Location:  locationComment,
+				CommentId: sanitizer.UTF8(comment.ID),
+				Link:      link + "?focusedCommentId=" + url.QueryEscape(comment.ID),
+			})
+			chunk.Data = []byte(comment.Body.Storage.Value)
+			if err := reporter.ChunkOk(ctx, *chunk); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.includeAttachments {
+		attachments, err := s.client.listAttachments(ctx, c.ID)
+		if err != nil {
+			return fmt.Errorf("error listing attachments: %w", err)
+		}
+		for _, attachment := range attachments {
+			if err := s.scanAttachment(ctx, c, attachment, reporter); err != nil {
+				if err := reporter.ChunkErr(ctx, fmt.Errorf("error scanning attachment %s: %w", attachment.Title, err)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// reportBody chunks the body of a version of a page or blog post, unless it's empty.
+func (s *Source) reportBody(ctx context.Context, c, version content, link string, reporter sources.ChunkReporter) error {
+	if version.Body.Storage.Value == "" {
+		return nil
+	}
+	chunk := s.chunk(c, version.Version, &source_metadatapb.Confluence{
+		Location: sanitizer.UTF8(c.Type),
+		Link:     link,
+	})
+	chunk.Data = []byte(version.Body.Storage.Value)
+	return reporter.ChunkOk(ctx, *chunk)
+}
+
+// scanAttachment downloads an attachment and chunks it with the file handlers, so archives and documents are
+// extracted.
+func (s *Source) scanAttachment(ctx context.Context, c, attachment content, reporter sources.ChunkReporter) error {
+	body, err := s.client.download(ctx, attachment)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	chunkSkel := s.chunk(c, attachment.Version, &source_metadatapb.Confluence{
+		Location: locationAttachment,
+		File:     sanitizer.UTF8(attachment.Title),
+		Link:     s.endpoint + attachment.Links.Download,
+	})
+	return handlers.HandleFile(ctx, body, chunkSkel, reporter)
+}
+
+// chunk returns a chunk of the source with metadata, filled in with the page or blog post it belongs to and the
+// version of the chunked content.
+func (s *Source) chunk(c content, v version, metadata *source_metadatapb.Confluence) *sources.Chunk {
+	metadata.Page = sanitizer.UTF8(c.Title)
+	metadata.Space = sanitizer.UTF8(c.Space.Key)
+	metadata.Version = strconv.FormatInt(v.Number, 10)
+	metadata.User = sanitizer.UTF8(v.By.String())
+	metadata.Email = sanitizer.UTF8(v.By.Email)
+	metadata.Timestamp = sanitizer.UTF8(v.When)
+	return &sources.Chunk{
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		JobID:          s.JobID(),
+		SourceType:     s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Confluence{Confluence: metadata}},
+		Verify:         s.verify,
+	}
+}
@@ -0,0 +1,137 @@
+package confluence
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+// confluenceStandIn serves a stand-in of the REST API of a Confluence server under /wiki. Responses are keyed by
+// path, and by the CQL query, cursor or version of the request if it has one.
+func confluenceStandIn(t *testing.T, api map[string]any) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer pat" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		key := r.URL.Path
+		for _, param := range []string{"cql", "cursor", "version"} {
+			if value := r.URL.Query().Get(param); value != "" {
+				key += "?" + param + "=" + value
+			}
+		}
+		resp, ok := api[key]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if content, ok := resp.(string); ok {
+			_, _ = w.Write([]byte(content))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSource_Chunks(t *testing.T) {
+	author := map[string]any{"displayName": "Jane Doe", "email": "jane@example.com"}
+	page := func(id, title string, number int, body string) map[string]any {
+		return map[string]any{
+			"id": id, "type": "page", "title": title,
+			"space":   map[string]any{"key": "OPS"},
+			"version": map[string]any{"number": number, "when": "2024-01-02T03:04:05.000Z", "by": author},
+			"body":    map[string]any{"storage": map[string]any{"value": body}},
+			"_links":  map[string]any{"webui": "/spaces/OPS/pages/" + id},
+		}
+	}
+	results := func(next string, results ...any) map[string]any {
+		return map[string]any{"results": results, "_links": map[string]any{"next": next}}
+	}
+	server := confluenceStandIn(t, map[string]any{
+		"/wiki/rest/api/space": results("", map[string]any{"key": "OPS"}, map[string]any{"key": "~jane"}),
+		`/wiki/rest/api/content/search?cql=space = "OPS" AND type in (page, blogpost) AND (label = runbook) AND lastmodified >= "2024/01/01 00:00"`: results(
+			"/rest/api/content/search?cursor=2",
+			page("1", "Runbook", 2, "<p>password: current</p>"),
+		),
+		"/wiki/rest/api/content/search?cursor=2": results("", page("2", "Empty", 1, "")),
+		"/wiki/rest/api/content/1/version":       results("", map[string]any{"number": 2}, map[string]any{"number": 1}),
+		"/wiki/rest/api/content/1?version=1":     page("1", "Runbook", 1, "<p>password: previous</p>"),
+		"/wiki/rest/api/content/2/version":       results("", map[string]any{"number": 1}),
+		"/wiki/rest/api/content/1/child/comment": results("", map[string]any{
+			"id": "10", "body": map[string]any{"storage": map[string]any{"value": "<p>use this key</p>"}},
+			"version": map[string]any{"number": 1, "when": "2024-01-03T00:00:00.000Z", "by": author},
+		}),
+		"/wiki/rest/api/content/2/child/comment": results(""),
+		"/wiki/rest/api/content/1/child/attachment": results("", map[string]any{
+			"id": "att20", "type": "attachment", "title": "creds.txt",
+			"version": map[string]any{"number": 1, "when": "2024-01-04T00:00:00.000Z", "by": author},
+			"_links":  map[string]any{"download": "/download/attachments/1/creds.txt"},
+		}),
+		"/wiki/rest/api/content/2/child/attachment": results(""),
+		"/wiki/download/attachments/1/creds.txt":    "aws_secret_access_key = abc\n",
+	})
+
+	conn, err := anypb.New(&sourcespb.Confluence{
+		Endpoint:           server.URL + "/wiki",
+		Credential:         &sourcespb.Confluence_Token{Token: "pat"},
+		IgnoreSpaces:       []string{"~jane"},
+		Cql:                "label = runbook",
+		Since:              timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		IncludeComments:    true,
+		IncludeAttachments: true,
+	})
+	require.NoError(t, err)
+	s := &Source{}
+	ctx := context.Background()
+	require.NoError(t, s.Init(ctx, "test confluence", 0, 0, false, conn, 1))
+
+	units := &sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(ctx, units))
+	require.Equal(t, []sources.SourceUnit{sources.CommonSourceUnit{Kind: UnitSpace, ID: "OPS"}}, units.Units)
+
+	chunks := &sourcestest.TestReporter{}
+	require.NoError(t, s.ChunkUnit(ctx, units.Units[0], chunks))
+	require.Empty(t, chunks.ChunkErrs)
+
+	var metadata []*source_metadatapb.Confluence
+	var data []string
+	for _, chunk := range chunks.Chunks {
+		metadata = append(metadata, chunk.SourceMetadata.GetConfluence())
+		data = append(data, string(chunk.Data))
+	}
+	require.Equal(t, []string{
+		"<p>password: current</p>",
+		"<p>password: previous</p>",
+		"<p>use this key</p>",
+		"aws_secret_access_key = abc\n",
+	}, data)
+
+	assert.Equal(t, &source_metadatapb.Confluence{
+		Page: "Runbook", Space: "OPS", Version: "2", Link: server.URL + "/wiki/spaces/OPS/pages/1",
+		Email: "jane@example.com", Timestamp: "2024-01-02T03:04:05.000Z", Location: "page", User: "Jane Doe",
+	}, metadata[0])
+	assert.Equal(t, "1", metadata[1].GetVersion())
+	assert.Equal(t, server.URL+"/wiki/pages/viewpage.action?pageId=1&pageVersion=1", metadata[1].GetLink())
+	assert.Equal(t, "comment", metadata[2].GetLocation())
+	assert.Equal(t, "10", metadata[2].GetCommentId())
+	assert.Equal(t, server.URL+"/wiki/spaces/OPS/pages/1?focusedCommentId=10", metadata[2].GetLink())
+	assert.Equal(t, "attachment", metadata[3].GetLocation())
+	assert.Equal(t, "creds.txt", metadata[3].GetFile())
+	assert.Equal(t, server.URL+"/wiki/download/attachments/1/creds.txt", metadata[3].GetLink())
+}
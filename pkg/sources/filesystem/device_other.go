@@ -0,0 +1,9 @@
+//go:build !unix
+
+package filesystem
+
+import "io/fs"
+
+// deviceID returns the ID of the device holding a file, and whether it is known. Device IDs aren't available on this
+// platform, so scans can't be confined to one filesystem.
+func deviceID(fs.FileInfo) (uint64, bool) { return 0, false }
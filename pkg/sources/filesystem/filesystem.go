@@ -3,13 +3,12 @@ package filesystem
 import (
 	"fmt"
 	"io"
-	"io/fs"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/go-logr/logr"
-	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -35,6 +34,23 @@ type Source struct {
 	log          logr.Logger
 	filter       *common.Filter
 	skipBinaries bool
+	// respectGitignore skips paths ignored by .gitignore and .trufflehogignore files when walking directories.
+	respectGitignore bool
+	// followSymlinks follows symlinks to files and directories rather than skipping them, and symlinksWithinRoot
+	// restricts the followed symlinks to those whose targets are within the scanned directory.
+	followSymlinks     bool
+	symlinksWithinRoot bool
+	// maxFileSize is the size in bytes of the largest file scanned, or 0 for no limit.
+	maxFileSize int64
+	// modifiedSince and modifiedBefore, if set, restrict the scan to files last modified in [modifiedSince,
+	// modifiedBefore).
+	modifiedSince  time.Time
+	modifiedBefore time.Time
+	// oneFileSystem skips directories on other filesystems than the scanned directory, such as mount points.
+	oneFileSystem bool
+	// state records the files scanned, to skip unchanged files on later scans. It is nil unless a state file is
+	// configured.
+	state *State
 	sources.Progress
 	sources.CommonSourceUnitUnmarshaller
 }
@@ -74,6 +90,29 @@ func (s *Source) Init(aCtx context.Context, name string, jobId sources.JobID, so
 	}
 	s.paths = append(conn.Paths, conn.Directories...)
 	s.skipBinaries = conn.GetSkipBinaries()
+	s.respectGitignore = conn.GetRespectGitignore()
+
+	s.followSymlinks = conn.GetFollowSymlinks()
+	s.symlinksWithinRoot = conn.GetSymlinksWithinRoot()
+	s.maxFileSize = conn.GetMaxFileSize()
+	s.oneFileSystem = conn.GetOneFileSystem()
+	if conn.GetModifiedSince() != nil {
+		s.modifiedSince = conn.GetModifiedSince().AsTime()
+	}
+	if conn.GetModifiedBefore() != nil {
+		s.modifiedBefore = conn.GetModifiedBefore().AsTime()
+	}
+	if !s.modifiedSince.IsZero() && !s.modifiedBefore.IsZero() && !s.modifiedSince.Before(s.modifiedBefore) {
+		return fmt.Errorf("modified since (%s) must be before modified before (%s)", s.modifiedSince, s.modifiedBefore)
+	}
+
+	if conn.GetStateFile() != "" {
+		state, err := loadState(conn.GetStateFile(), conn.GetRescanFilesWithFindings(), &s.Progress)
+		if err != nil {
+			return fmt.Errorf("unable to load state: %w", err)
+		}
+		s.state = state
+	}
 
 	filter, err := common.FilterFromFiles(conn.IncludePathsFile, conn.ExcludePathsFile)
 	if err != nil {
@@ -84,6 +123,10 @@ func (s *Source) Init(aCtx context.Context, name string, jobId sources.JobID, so
 	return nil
 }
 
+// State returns the state of incremental scans, or nil if the source isn't configured with a state file. The caller
+// is responsible for recording findings and saving the state once the scan's results have been reported.
+func (s *Source) State() *State { return s.state }
+
 // Chunks emits chunks of bytes over a channel.
 func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
 	for i, path := range s.paths {
@@ -94,7 +137,7 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ .
 		s.SetProgressComplete(i, len(s.paths), fmt.Sprintf("Path: %s", path), "")
 
 		cleanPath := filepath.Clean(path)
-		fileInfo, err := os.Lstat(cleanPath)
+		fileInfo, err := s.statPath(cleanPath)
 		if err != nil {
 			logger.Error(err, "unable to get file info")
 			continue
@@ -120,65 +163,20 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ .
 
 	return nil
 }
-func (s *Source) scanDir(ctx context.Context, path string, chunksChan chan *sources.Chunk) error {
-	workerPool := new(errgroup.Group)
-	workerPool.SetLimit(s.concurrency)
-	defer func() {
-		_ = workerPool.Wait()
-		s.ClearEncodedResumeInfoFor(path)
-	}()
-	startState := s.GetEncodedResumeInfoFor(path)
-	resuming := startState != ""
-
-	return fs.WalkDir(os.DirFS(path), ".", func(relativePath string, d fs.DirEntry, err error) error {
-		if err != nil {
-			ctx.Logger().Error(err, "error walking directory")
-			return nil
-		}
-
-		fullPath := filepath.Join(path, relativePath)
-
-		// check if the full path is not matching any pattern in include FilterRuleSet and matching any exclude FilterRuleSet.
-		if s.filter != nil && !s.filter.Pass(fullPath) {
-			// skip excluded directories
-			if d.IsDir() && s.filter.ShouldExclude(fullPath) {
-				return fs.SkipDir
-			}
-
-			return nil // skip the file
-		}
-
-		// Skip over non-regular files. We do this check here to suppress noisy
-		// logs for trying to scan directories and other non-regular files in
-		// our traversal.
-		if !d.Type().IsRegular() {
-			return nil
-		}
-
-		if resuming {
-			// The start state holds the path that last completed
-			// scanning. When we find it, we can start scanning
-			// again on the next one.
-			if fullPath == startState {
-				resuming = false
-			}
-			return nil
-		}
 
-		workerPool.Go(func() error {
-			if err = s.scanFile(ctx, fullPath, chunksChan); err != nil {
-				ctx.Logger().Error(err, "error scanning file", "path", fullPath, "error", err)
-			}
-			s.SetEncodedResumeInfoFor(path, fullPath)
-			return nil
-		})
+var skipSymlinkErr = errors.New("skipping symlink")
 
-		return nil
-	})
+// modifiedInWindow reports whether a modification time is within the configured window.
+func (s *Source) modifiedInWindow(modTime time.Time) bool {
+	if !s.modifiedSince.IsZero() && modTime.Before(s.modifiedSince) {
+		return false
+	}
+	if !s.modifiedBefore.IsZero() && !modTime.Before(s.modifiedBefore) {
+		return false
+	}
+	return true
 }
 
-var skipSymlinkErr = errors.New("skipping symlink")
-
 func (s *Source) scanFile(ctx context.Context, path string, chunksChan chan *sources.Chunk) error {
 	fileCtx := context.WithValues(ctx, "path", path)
 	fileStat, err := os.Lstat(path)
@@ -186,7 +184,21 @@ func (s *Source) scanFile(ctx context.Context, path string, chunksChan chan *sou
 		return fmt.Errorf("unable to stat file: %w", err)
 	}
 	if fileStat.Mode()&os.ModeSymlink != 0 {
-		return skipSymlinkErr
+		if !s.followSymlinks {
+			return skipSymlinkErr
+		}
+		if fileStat, err = os.Stat(path); err != nil {
+			return fmt.Errorf("unable to stat symlink target: %w", err)
+		}
+	}
+
+	if s.maxFileSize > 0 && fileStat.Size() > s.maxFileSize {
+		fileCtx.Logger().V(3).Info("skipping file: size exceeds max allowed", "size", fileStat.Size(), "limit", s.maxFileSize)
+		return nil
+	}
+	if !s.modifiedInWindow(fileStat.ModTime()) {
+		fileCtx.Logger().V(5).Info("skipping file: modified outside the configured window", "mod_time", fileStat.ModTime())
+		return nil
 	}
 
 	// Check if file is binary and should be skipped
@@ -195,12 +207,29 @@ func (s *Source) scanFile(ctx context.Context, path string, chunksChan chan *sou
 		return nil
 	}
 
+	if s.state != nil {
+		unchanged, err := s.state.unchanged(path, fileStat)
+		if err != nil {
+			return fmt.Errorf("unable to check file state: %w", err)
+		}
+		if unchanged {
+			fileCtx.Logger().V(5).Info("skipping file unchanged since the previous scan")
+			return nil
+		}
+	}
+
 	inputFile, err := os.Open(path)
 	if err != nil {
 		return fmt.Errorf("unable to open file: %w", err)
 	}
 	defer inputFile.Close()
 
+	var reader io.Reader = inputFile
+	recordState := func() error { return nil }
+	if s.state != nil {
+		reader, recordState = s.state.scanReader(path, fileStat, inputFile)
+	}
+
 	fileCtx.Logger().V(3).Info("scanning file")
 
 	chunkSkel := &sources.Chunk{
@@ -218,7 +247,10 @@ func (s *Source) scanFile(ctx context.Context, path string, chunksChan chan *sou
 		Verify: s.verify,
 	}
 
-	return handlers.HandleFile(fileCtx, inputFile, chunkSkel, sources.ChanReporter{Ch: chunksChan})
+	if err := handlers.HandleFile(fileCtx, reader, chunkSkel, sources.ChanReporter{Ch: chunksChan}); err != nil {
+		return err
+	}
+	return recordState()
 }
 
 // Enumerate implements SourceUnitEnumerator interface. This implementation simply
@@ -247,7 +279,7 @@ func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporte
 	logger := ctx.Logger().WithValues("path", path)
 
 	cleanPath := filepath.Clean(path)
-	fileInfo, err := os.Lstat(cleanPath)
+	fileInfo, err := s.statPath(cleanPath)
 	if err != nil {
 		return reporter.ChunkErr(ctx, fmt.Errorf("unable to get file info: %w", err))
 	}
@@ -1,10 +1,14 @@
 package filesystem
 
 import (
+	"encoding/json"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -12,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
@@ -461,6 +466,276 @@ func TestSkipBinaries(t *testing.T) {
 	require.NotContains(t, processedFiles, binaryFile, "Binary file should be skipped")
 }
 
+func TestRespectGitignore(t *testing.T) {
+	ctx := context.Background()
+
+	root := t.TempDir()
+	files := map[string]string{
+		".gitignore":                 "# dependencies\nnode_modules/\n*.log\n/build\n!keep.log\n",
+		".trufflehogignore":          "fixtures/\n!debug.log\n",
+		"main.go":                    "main",
+		"app.log":                    "log",
+		"debug.log":                  "re-included by .trufflehogignore",
+		"keep.log":                   "re-included by .gitignore",
+		"build/out.txt":              "root build output",
+		"node_modules/pkg/index.js":  "dependency",
+		"node_modules/pkg/.env":      "dependency",
+		"src/build/gen.go":           "not anchored to the root",
+		"src/.gitignore":             "*.tmp\n!important.log\n",
+		"src/cache.tmp":              "cache",
+		"src/important.log":          "re-included by nested .gitignore",
+		"src/fixtures/secret.txt":    "fixture",
+		"lib/cache.tmp":              "nested patterns only apply below their directory",
+		".git/config":                "git directory",
+		"vendor/.gitignore":          "*\n!.gitignore\n",
+		"vendor/dep/module.go":       "ignored by wildcard",
+		"docs/README.md":             "docs",
+		"docs/trufflehogignore.test": "name only",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	scan := func(respectGitignore bool) []string {
+		conn, err := anypb.New(&sourcespb.Filesystem{RespectGitignore: respectGitignore})
+		require.NoError(t, err)
+		s := Source{}
+		require.NoError(t, s.Init(ctx, "test respect gitignore", 0, 0, true, conn, 1))
+
+		reporter := sourcestest.TestReporter{}
+		require.NoError(t, s.ChunkUnit(ctx, sources.CommonSourceUnit{ID: root}, &reporter))
+		require.Empty(t, reporter.ChunkErrs)
+
+		var scanned []string
+		for _, chunk := range reporter.Chunks {
+			rel, err := filepath.Rel(root, chunk.SourceMetadata.GetFilesystem().GetFile())
+			require.NoError(t, err)
+			scanned = append(scanned, filepath.ToSlash(rel))
+		}
+		return scanned
+	}
+
+	assert.ElementsMatch(t, []string{
+		".gitignore",
+		".trufflehogignore",
+		"main.go",
+		"debug.log",
+		"keep.log",
+		"src/build/gen.go",
+		"src/.gitignore",
+		"src/important.log",
+		"lib/cache.tmp",
+		"vendor/.gitignore",
+		"docs/README.md",
+		"docs/trufflehogignore.test",
+	}, scan(true))
+	assert.Len(t, scan(false), len(files))
+}
+
+func TestIgnoreTreePrunesDirectories(t *testing.T) {
+	fsys := fstest.MapFS{
+		".gitignore":                {Data: []byte("node_modules/\n")},
+		"node_modules/.gitignore":   {Data: []byte("!*\n")},
+		"node_modules/pkg/index.js": {Data: []byte("dependency")},
+		"src/main.go":               {Data: []byte("main")},
+	}
+
+	ignores := newIgnoreTree(fsys)
+	var visited []string
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		require.NoError(t, err)
+		ignored, err := ignores.ignored(path, d)
+		require.NoError(t, err)
+		if ignored {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		visited = append(visited, path)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{".", ".gitignore", "src", "src/main.go"}, visited)
+	// Ignore files in pruned directories are never read.
+	assert.NotContains(t, ignores.patterns, "node_modules")
+}
+
+func TestStateFile(t *testing.T) {
+	ctx := context.Background()
+
+	root := t.TempDir()
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	write := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(root, name), []byte(content), 0o644))
+	}
+	write("unchanged.txt", "unchanged")
+	write("touched.txt", "same content")
+	write("modified.txt", "before")
+	write("finding.txt", "secret")
+	write("deleted.txt", "deleted")
+
+	scan := func(rescanFindings bool) []string {
+		conn, err := anypb.New(&sourcespb.Filesystem{StateFile: stateFile, RescanFilesWithFindings: rescanFindings})
+		require.NoError(t, err)
+		s := Source{}
+		require.NoError(t, s.Init(ctx, "test state file", 0, 0, true, conn, 1))
+		require.NotNil(t, s.State())
+
+		reporter := sourcestest.TestReporter{}
+		require.NoError(t, s.ChunkUnit(ctx, sources.CommonSourceUnit{ID: root}, &reporter))
+		require.Empty(t, reporter.ChunkErrs)
+
+		var scanned []string
+		for _, chunk := range reporter.Chunks {
+			file := chunk.SourceMetadata.GetFilesystem().GetFile()
+			if filepath.Base(file) == "finding.txt" {
+				s.State().RecordFinding(file)
+			}
+			scanned = append(scanned, filepath.Base(file))
+		}
+		require.NoError(t, s.State().Save())
+		return scanned
+	}
+
+	assert.ElementsMatch(t, []string{"unchanged.txt", "touched.txt", "modified.txt", "finding.txt", "deleted.txt"}, scan(false))
+
+	// Same size, so only the content tells the modified file apart.
+	write("modified.txt", "after!")
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(root, "touched.txt"), later, later))
+	require.NoError(t, os.Chtimes(filepath.Join(root, "modified.txt"), later, later))
+	require.NoError(t, os.Remove(filepath.Join(root, "deleted.txt")))
+	assert.Equal(t, []string{"modified.txt"}, scan(false))
+
+	// Findings of skipped files are kept, so their files can be rescanned later.
+	assert.Empty(t, scan(false))
+	assert.Equal(t, []string{"finding.txt"}, scan(true))
+
+	state, err := loadState(stateFile, false, new(sources.Progress))
+	require.NoError(t, err)
+	assert.Len(t, state.previous, 4)
+	assert.Equal(t, 1, state.previous[filepath.Join(root, "finding.txt")].Findings)
+	assert.True(t, state.previous[filepath.Join(root, "touched.txt")].ModTime.Equal(later))
+
+	// The state file holds the resume info, so an interrupted walk resumes after the last file it scanned.
+	data, err := os.ReadFile(stateFile)
+	require.NoError(t, err)
+	var resumeInfo map[string]string
+	require.NoError(t, json.Unmarshal(data, &resumeInfo))
+	require.Contains(t, resumeInfo, stateResumeID)
+	resumeInfo[root] = filepath.Join(root, "modified.txt")
+	data, err = json.Marshal(resumeInfo)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(stateFile, data, 0o644))
+	write("finding.txt", "changed")
+	write("unchanged.txt", "changed")
+	assert.Equal(t, []string{"unchanged.txt"}, scan(false))
+}
+
+func TestFollowSymlinks(t *testing.T) {
+	ctx := context.Background()
+
+	outside := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(outside, "outside.txt"), []byte("outside"), 0o644))
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "dir", "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "dir", "sub", "file.txt"), []byte("file"), 0o644))
+	for link, target := range map[string]string{
+		"file-link":     filepath.Join("dir", "sub", "file.txt"),
+		"dir/sub/loop":  "..",
+		"dir/self":      ".",
+		"outside-link":  outside,
+		"outside-again": outside,
+		"dangling":      "missing",
+	} {
+		require.NoError(t, os.Symlink(target, filepath.Join(root, link)))
+	}
+
+	scan := func(conn *sourcespb.Filesystem, path string) []string {
+		anyConn, err := anypb.New(conn)
+		require.NoError(t, err)
+		s := Source{}
+		require.NoError(t, s.Init(ctx, "test follow symlinks", 0, 0, true, anyConn, 1))
+
+		reporter := sourcestest.TestReporter{}
+		require.NoError(t, s.ChunkUnit(ctx, sources.CommonSourceUnit{ID: path}, &reporter))
+		require.Empty(t, reporter.ChunkErrs)
+
+		var scanned []string
+		for _, chunk := range reporter.Chunks {
+			rel, err := filepath.Rel(root, chunk.SourceMetadata.GetFilesystem().GetFile())
+			require.NoError(t, err)
+			scanned = append(scanned, filepath.ToSlash(rel))
+		}
+		return scanned
+	}
+
+	assert.Equal(t, []string{"dir/sub/file.txt"}, scan(&sourcespb.Filesystem{}, root))
+
+	// Loops are broken and a directory reached through two symlinks is scanned once.
+	scanned := scan(&sourcespb.Filesystem{FollowSymlinks: true}, root)
+	assert.Len(t, scanned, 3)
+	assert.Contains(t, scanned, "dir/sub/file.txt")
+	assert.Contains(t, scanned, "file-link")
+	assert.True(t, slices.Contains(scanned, "outside-link/outside.txt") != slices.Contains(scanned, "outside-again/outside.txt"))
+
+	assert.ElementsMatch(t,
+		[]string{"dir/sub/file.txt", "file-link"},
+		scan(&sourcespb.Filesystem{FollowSymlinks: true, SymlinksWithinRoot: true}, root))
+
+	// A symlink given as the path to scan is followed.
+	assert.Equal(t, []string{"file-link"}, scan(&sourcespb.Filesystem{FollowSymlinks: true}, filepath.Join(root, "file-link")))
+}
+
+func TestFileSizeAndModifiedWindow(t *testing.T) {
+	ctx := context.Background()
+
+	root := t.TempDir()
+	now := time.Now().Truncate(time.Second)
+	for name, age := range map[string]time.Duration{"old.txt": 48 * time.Hour, "recent.txt": time.Hour, "new.txt": 0} {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.WriteFile(path, []byte(name), 0o644))
+		require.NoError(t, os.Chtimes(path, now.Add(-age), now.Add(-age)))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(root, "large.txt"), []byte(strings.Repeat("a", 100)), 0o644))
+	require.NoError(t, os.Chtimes(filepath.Join(root, "large.txt"), now.Add(-time.Hour), now.Add(-time.Hour)))
+
+	scan := func(conn *sourcespb.Filesystem) []string {
+		anyConn, err := anypb.New(conn)
+		require.NoError(t, err)
+		s := Source{}
+		require.NoError(t, s.Init(ctx, "test filters", 0, 0, true, anyConn, 1))
+
+		reporter := sourcestest.TestReporter{}
+		require.NoError(t, s.ChunkUnit(ctx, sources.CommonSourceUnit{ID: root}, &reporter))
+
+		var scanned []string
+		for _, chunk := range reporter.Chunks {
+			scanned = append(scanned, filepath.Base(chunk.SourceMetadata.GetFilesystem().GetFile()))
+		}
+		return scanned
+	}
+
+	assert.ElementsMatch(t, []string{"old.txt", "recent.txt", "new.txt"}, scan(&sourcespb.Filesystem{MaxFileSize: 10}))
+	assert.ElementsMatch(t, []string{"recent.txt", "large.txt"}, scan(&sourcespb.Filesystem{
+		ModifiedSince:  timestamppb.New(now.Add(-24 * time.Hour)),
+		ModifiedBefore: timestamppb.New(now.Add(-time.Minute)),
+	}))
+	assert.Len(t, scan(&sourcespb.Filesystem{OneFileSystem: true}), 4)
+
+	conn, err := anypb.New(&sourcespb.Filesystem{
+		ModifiedSince:  timestamppb.New(now),
+		ModifiedBefore: timestamppb.New(now.Add(-time.Hour)),
+	})
+	require.NoError(t, err)
+	assert.Error(t, (&Source{}).Init(ctx, "test filters", 0, 0, true, conn, 1))
+}
+
 // createTempFile is a helper function to create a temporary file in the given
 // directory with the provided contents. If dir is "", the operating system's
 // temp directory is used.
@@ -0,0 +1,108 @@
+package filesystem
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"path"
+	"strings"
+	"syscall"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+const (
+	gitDir               = ".git"
+	gitignoreFile        = ".gitignore"
+	trufflehogignoreFile = ".trufflehogignore"
+	gitInfoExcludeFile   = gitDir + "/info/exclude"
+)
+
+// ignoreFiles are the files patterns are read from in each directory, in ascending order of priority. Patterns in a
+// .trufflehogignore file can therefore re-include files a .gitignore file in the same directory excludes.
+var ignoreFiles = []string{gitInfoExcludeFile, gitignoreFile, trufflehogignoreFile}
+
+// ignoreTree applies .gitignore semantics to a directory walk. Each directory's patterns are those of its parent
+// followed by the patterns of its own ignore files, so patterns in deeper directories take precedence, and negated
+// patterns re-include paths excluded by earlier ones. As in git, paths below an ignored directory can't be re-included,
+// which lets the walk prune ignored directories entirely.
+//
+// An ignoreTree is not safe for concurrent use; fs.WalkDir calls its function sequentially.
+type ignoreTree struct {
+	fsys fs.FS
+	// patterns holds the patterns in effect for each directory visited, keyed by its path in fsys.
+	patterns map[string][]gitignore.Pattern
+}
+
+func newIgnoreTree(fsys fs.FS) *ignoreTree {
+	return &ignoreTree{fsys: fsys, patterns: make(map[string][]gitignore.Pattern)}
+}
+
+// ignored reports whether the entry at the given path, relative to the root of the tree, is ignored. Directories
+// must be checked before their contents, as checking a directory that isn't ignored loads its ignore files.
+func (t *ignoreTree) ignored(relativePath string, d fs.DirEntry) (bool, error) {
+	if relativePath == "." {
+		return false, t.load(relativePath, nil)
+	}
+
+	parent := t.patterns[path.Dir(relativePath)]
+	if d.IsDir() && d.Name() == gitDir {
+		// git never tracks its own directory.
+		return true, nil
+	}
+	if gitignore.NewMatcher(parent).Match(strings.Split(relativePath, "/"), d.IsDir()) {
+		return true, nil
+	}
+	if d.IsDir() {
+		return false, t.load(relativePath, parent)
+	}
+	return false, nil
+}
+
+// load reads the ignore files of a directory and records its patterns, appended to those of its parent.
+func (t *ignoreTree) load(dir string, parent []gitignore.Pattern) error {
+	var domain []string
+	if dir != "." {
+		domain = strings.Split(dir, "/")
+	}
+
+	// Patterns are recorded even if an ignore file can't be read, so that the directory's contents are still
+	// matched against the patterns of its ancestors.
+	var errs []error
+	patterns := parent
+	for _, name := range ignoreFiles {
+		own, err := readIgnorePatterns(t.fsys, path.Join(dir, name), domain)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		// Copy before appending so sibling directories don't share the parent's backing array.
+		patterns = append(patterns[:len(patterns):len(patterns)], own...)
+	}
+	t.patterns[dir] = patterns
+	return errors.Join(errs...)
+}
+
+// readIgnorePatterns parses an ignore file, skipping blank lines and comments. A missing file has no patterns.
+func readIgnorePatterns(fsys fs.FS, name string, domain []string) ([]gitignore.Pattern, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		// A .git file rather than directory, as in worktrees and submodules, fails with ENOTDIR.
+		if errors.Is(err, fs.ErrNotExist) || errors.Is(err, syscall.ENOTDIR) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var patterns []gitignore.Pattern
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, domain))
+	}
+	return patterns, scanner.Err()
+}
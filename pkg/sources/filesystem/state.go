@@ -0,0 +1,220 @@
+package filesystem
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/hasher"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// stateVersion is the version of the state format, which is bumped when the format changes incompatibly. States of
+// other versions are ignored, so the next scan is a full scan.
+const stateVersion = 1
+
+// stateResumeID is the ID the state is kept under in the source's resume info. Units are identified by cleaned paths,
+// which are never empty.
+const stateResumeID = ""
+
+// State records the files scanned by incremental filesystem scans. A file whose size and modification time, or
+// failing that content hash, match those recorded by the previous scan is skipped. The state written by a scan holds
+// the files it scanned or skipped, so files that were deleted or failed to scan drop out of it.
+//
+// The state is kept in the source's resume info, next to the progress of its directory walks, and the state file
+// persists that resume info between runs. The recorded files are only added back to the resume info when the state
+// is saved, so that walks don't re-encode them each time they record their progress.
+//
+// Findings are recorded against the files they were found in, whether or not they're reported, so that files with
+// findings left out by the results filter aren't taken for clean ones. Only the number of findings is recorded, as the
+// findings themselves hold secrets that don't belong in the state file, so they can't be replayed. Instead, unchanged
+// files with findings in the previous scan can be rescanned, so that their findings are reported, and verified again,
+// on every scan rather than only the first.
+//
+// A State is safe for concurrent use.
+type State struct {
+	path           string
+	rescanFindings bool
+	progress       *sources.Progress
+	mu             sync.Mutex
+	previous       map[string]FileState
+	current        map[string]FileState
+	findings       map[string]int
+}
+
+// FileState is the recorded state of a single file.
+type FileState struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	// Hash is the hex encoded BLAKE2b-256 hash of the file's content.
+	Hash string `json:"hash"`
+	// Findings is the number of findings reported in the file.
+	Findings int `json:"findings,omitempty"`
+}
+
+type stateInfo struct {
+	Version int                  `json:"version"`
+	Files   map[string]FileState `json:"files"`
+}
+
+// loadState restores the source's resume info from the state file at the given path and takes the state out of it.
+// A missing state file, or a state written by an incompatible version, yields an empty state.
+func loadState(path string, rescanFindings bool, progress *sources.Progress) (*State, error) {
+	s := &State{
+		path:           path,
+		rescanFindings: rescanFindings,
+		progress:       progress,
+		previous:       make(map[string]FileState),
+		current:        make(map[string]FileState),
+		findings:       make(map[string]int),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read state file: %w", err)
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("unable to parse state file %s: invalid JSON", path)
+	}
+
+	progress.EncodedResumeInfo = string(data)
+	encoded := progress.GetEncodedResumeInfoFor(stateResumeID)
+	progress.ClearEncodedResumeInfoFor(stateResumeID)
+	if encoded == "" {
+		return s, nil
+	}
+
+	var info stateInfo
+	if err := json.Unmarshal([]byte(encoded), &info); err != nil {
+		return nil, fmt.Errorf("unable to parse state in %s: %w", path, err)
+	}
+	if info.Version == stateVersion && info.Files != nil {
+		s.previous = info.Files
+	}
+	return s, nil
+}
+
+// Save adds the state recorded by the current scan to the source's resume info, and atomically writes the resume
+// info to the state file.
+func (s *State) Save() error {
+	s.mu.Lock()
+	info := stateInfo{Version: stateVersion, Files: make(map[string]FileState, len(s.current))}
+	for path, file := range s.current {
+		file.Findings = s.findings[path]
+		info.Files[path] = file
+	}
+	s.mu.Unlock()
+
+	encoded, err := json.Marshal(info)
+	if err != nil {
+		return fmt.Errorf("unable to encode state: %w", err)
+	}
+	s.progress.SetEncodedResumeInfoFor(stateResumeID, string(encoded))
+	data := []byte(s.progress.GetProgress().EncodedResumeInfo)
+
+	// Write to a temporary file in the same directory and rename it over the state file, so an interrupted write
+	// never leaves a truncated state behind.
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("unable to create state file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("unable to write state file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("unable to write state file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("unable to write state file: %w", err)
+	}
+	return nil
+}
+
+// RecordFinding records a finding reported in the file at the given path.
+func (s *State) RecordFinding(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.findings[path]++
+}
+
+// unchanged reports whether the file at the given path is unchanged since the previous scan and can be skipped. If
+// so, its state is carried over to the current scan.
+func (s *State) unchanged(path string, info fs.FileInfo) (bool, error) {
+	// Files are keyed by the path reported in chunk metadata, which findings are recorded against.
+	key := sanitizer.UTF8(path)
+	s.mu.Lock()
+	prev, ok := s.previous[key]
+	s.mu.Unlock()
+	if !ok || prev.Size != info.Size() || (s.rescanFindings && prev.Findings > 0) {
+		return false, nil
+	}
+
+	if !prev.ModTime.Equal(info.ModTime()) {
+		// The file was touched, for example by a copy or restore, but may hold the same content.
+		hash, err := hashFile(path)
+		if err != nil {
+			return false, err
+		}
+		if hash != prev.Hash {
+			return false, nil
+		}
+		prev.ModTime = info.ModTime()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current[key] = prev
+	s.findings[key] += prev.Findings
+	return true, nil
+}
+
+// scanReader returns a reader for scanning a file, which hashes its content as it's read rather than reading the file
+// twice, and a function recording the state of the file once it's been scanned. The reader hides the file's Seek
+// method, so handlers buffer what they read again instead of rereading the file.
+//
+// The recorded size and modification time are those from before the scan, so a file changed during the scan doesn't
+// match them on the next scan, and its hash, that of the content scanned, is compared.
+func (s *State) scanReader(path string, info fs.FileInfo, file io.Reader) (io.Reader, func() error) {
+	reader, sum := hasher.NewBlake2B().TeeReader(file)
+	return reader, func() error {
+		// Handlers may stop reading before the end of the file, whose rest still needs hashing.
+		if _, err := io.Copy(io.Discard, reader); err != nil {
+			return fmt.Errorf("unable to hash file: %w", err)
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.current[sanitizer.UTF8(path)] = FileState{Size: info.Size(), ModTime: info.ModTime(), Hash: hex.EncodeToString(sum())}
+		return nil
+	}
+}
+
+// hashFile returns the hex encoded hash of the file's content.
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return hashReader(f)
+}
+
+func hashReader(r io.Reader) (string, error) {
+	hash, err := hasher.NewBlake2B().HashReader(r)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash), nil
+}
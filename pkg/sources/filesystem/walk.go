@@ -0,0 +1,243 @@
+package filesystem
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+var (
+	errSymlinkOutsideRoot = errors.New("symlink target is outside the scanned directory")
+	errSymlinkLoop        = errors.New("symlink points to one of its parent directories")
+	errSymlinkVisited     = errors.New("symlink target was already scanned")
+)
+
+// scanDir walks the directory at path and scans the files in it, applying ignore files, path filters and symlink
+// settings along the way.
+func (s *Source) scanDir(ctx context.Context, path string, chunksChan chan *sources.Chunk) error {
+	workerPool := new(errgroup.Group)
+	workerPool.SetLimit(s.concurrency)
+	defer func() {
+		_ = workerPool.Wait()
+		s.ClearEncodedResumeInfoFor(path)
+	}()
+	startState := s.GetEncodedResumeInfoFor(path)
+	resuming := startState != ""
+
+	var ignores *ignoreTree
+	if s.respectGitignore {
+		ignores = newIgnoreTree(os.DirFS(path))
+	}
+	w, err := newDirWalk(path)
+	if err != nil {
+		return err
+	}
+
+	// walk walks the directory at base, which is either the scanned directory or a followed symlink to a directory
+	// within it. Paths are always reported under the scanned directory.
+	var walk func(base string) error
+	walk = func(base string) error {
+		return fs.WalkDir(os.DirFS(base), ".", func(walkPath string, d fs.DirEntry, err error) error {
+			if err != nil {
+				ctx.Logger().Error(err, "error walking directory")
+				return nil
+			}
+			if walkPath == "." && base != path {
+				// The symlink to this directory was checked when it was found.
+				return nil
+			}
+
+			fullPath := filepath.Join(base, walkPath)
+			relativePath := walkPath
+			if base != path {
+				relativePath = filepath.ToSlash(filepath.Join(w.relativeBase[base], walkPath))
+			}
+
+			isSymlink := d.Type()&fs.ModeSymlink != 0
+			if isSymlink {
+				if !s.followSymlinks {
+					return nil
+				}
+				info, err := w.followSymlink(fullPath, s.symlinksWithinRoot)
+				if err != nil {
+					ctx.Logger().V(2).Info("skipping symlink", "path", fullPath, "reason", err)
+					return nil
+				}
+				d = fs.FileInfoToDirEntry(info)
+			}
+
+			if ignores != nil {
+				ignored, err := ignores.ignored(relativePath, d)
+				if err != nil {
+					ctx.Logger().Error(err, "error reading ignore files", "path", fullPath)
+				}
+				if ignored {
+					if d.IsDir() && !isSymlink {
+						return fs.SkipDir
+					}
+					return nil
+				}
+			}
+
+			// check if the full path is not matching any pattern in include FilterRuleSet and matching any exclude FilterRuleSet.
+			if s.filter != nil && !s.filter.Pass(fullPath) {
+				// skip excluded directories
+				if d.IsDir() && !isSymlink && s.filter.ShouldExclude(fullPath) {
+					return fs.SkipDir
+				}
+
+				return nil // skip the file
+			}
+
+			if d.IsDir() {
+				if walkPath != "." && s.oneFileSystem && !w.sameDevice(d) {
+					ctx.Logger().V(2).Info("skipping directory on another filesystem", "path", fullPath)
+					if isSymlink {
+						return nil
+					}
+					return fs.SkipDir
+				}
+				if isSymlink {
+					w.relativeBase[fullPath] = relativePath
+					if err := walk(fullPath); err != nil {
+						ctx.Logger().Error(err, "error walking symlinked directory", "path", fullPath)
+					}
+				}
+				return nil
+			}
+
+			// Skip over non-regular files. We do this check here to suppress noisy
+			// logs for trying to scan directories and other non-regular files in
+			// our traversal.
+			if !d.Type().IsRegular() {
+				return nil
+			}
+
+			if resuming {
+				// The start state holds the path that last completed
+				// scanning. When we find it, we can start scanning
+				// again on the next one.
+				if fullPath == startState {
+					resuming = false
+				}
+				return nil
+			}
+
+			workerPool.Go(func() error {
+				if err := s.scanFile(ctx, fullPath, chunksChan); err != nil {
+					ctx.Logger().Error(err, "error scanning file", "path", fullPath, "error", err)
+				}
+				s.SetEncodedResumeInfoFor(path, fullPath)
+				return nil
+			})
+
+			return nil
+		})
+	}
+	return walk(path)
+}
+
+// statPath returns the file info of a path to scan, following the path if it is a symlink and symlinks are followed.
+func (s *Source) statPath(path string) (fs.FileInfo, error) {
+	info, err := os.Lstat(path)
+	if err != nil || info.Mode()&os.ModeSymlink == 0 || !s.followSymlinks {
+		return info, err
+	}
+	return os.Stat(path)
+}
+
+// dirWalk holds the state of a walk of a scanned directory that isn't held by fs.WalkDir itself: the directories
+// entered through symlinks, for loop detection, and the device of the directory, to stay on one filesystem.
+//
+// A dirWalk is not safe for concurrent use; fs.WalkDir calls its function sequentially.
+type dirWalk struct {
+	// root is the scanned directory with all symlinks resolved.
+	root string
+	// rootDevice is the device holding the scanned directory, if known.
+	rootDevice      uint64
+	rootDeviceKnown bool
+	// visited holds the resolved paths of the directories entered through symlinks.
+	visited map[string]struct{}
+	// relativeBase maps the path of each followed symlink to a directory to its path relative to the scanned
+	// directory.
+	relativeBase map[string]string
+}
+
+func newDirWalk(path string) (*dirWalk, error) {
+	root, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve directory: %w", err)
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("unable to stat directory: %w", err)
+	}
+
+	w := &dirWalk{
+		root:         root,
+		visited:      map[string]struct{}{root: {}},
+		relativeBase: make(map[string]string),
+	}
+	w.rootDevice, w.rootDeviceKnown = deviceID(info)
+	return w, nil
+}
+
+// followSymlink resolves a symlink found during the walk and returns the file info of its target. Symlinks to
+// directories that contain the symlink, which would loop forever, and to directories already entered through another
+// symlink are rejected, as are symlinks pointing outside the scanned directory if withinRoot is set.
+func (w *dirWalk) followSymlink(path string, withinRoot bool) (fs.FileInfo, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, err
+	}
+	if withinRoot && !isWithin(w.root, target) {
+		return nil, errSymlinkOutsideRoot
+	}
+	if !info.IsDir() {
+		return info, nil
+	}
+
+	parent, err := filepath.EvalSymlinks(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+	if isWithin(target, parent) {
+		return nil, errSymlinkLoop
+	}
+	if _, ok := w.visited[target]; ok {
+		return nil, errSymlinkVisited
+	}
+	w.visited[target] = struct{}{}
+	return info, nil
+}
+
+// sameDevice reports whether a directory is on the same device as the scanned directory. If either device is
+// unknown, as on platforms without device IDs, the directory is assumed to be on the same device.
+func (w *dirWalk) sameDevice(d fs.DirEntry) bool {
+	if !w.rootDeviceKnown {
+		return true
+	}
+	info, err := d.Info()
+	if err != nil {
+		return true
+	}
+	dev, ok := deviceID(info)
+	return !ok || dev == w.rootDevice
+}
+
+// isWithin reports whether path is dir or inside it. Both paths must be clean.
+func isWithin(dir, path string) bool {
+	return path == dir || strings.HasPrefix(path, strings.TrimSuffix(dir, string(filepath.Separator))+string(filepath.Separator))
+}
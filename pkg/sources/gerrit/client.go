@@ -0,0 +1,276 @@
+package gerrit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// changesPerPage is the number of changes requested per page of a query.
+const changesPerPage = 500
+
+// xssiPrefix prefixes every JSON response of the Gerrit REST API.
+var xssiPrefix = []byte(")]}'")
+
+// change is a Gerrit change and its patch sets.
+type change struct {
+	// ID is the change's "<project>~<branch>~<Change-Id>" triplet.
+	ID        string
+	Project   string
+	Number    int64
+	Subject   string
+	PatchSets []patchSet
+}
+
+// patchSet is a revision of a change.
+type patchSet struct {
+	Number int64
+	// Ref is the ref the patch set is stored in, refs/changes/<last two digits>/<change>/<patch set>.
+	Ref    string
+	Commit string
+}
+
+// message is a change message, the text posted with a vote or a new patch set.
+type message struct {
+	ID       string
+	Author   string
+	Date     string
+	Text     string
+	PatchSet int64
+}
+
+// reviewComment is an inline comment on a file of a patch set.
+type reviewComment struct {
+	ID       string
+	Path     string
+	Author   string
+	Updated  string
+	Text     string
+	PatchSet int64
+}
+
+type account struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+}
+
+// String returns the account's email, or else its username or name.
+func (a account) String() string {
+	switch {
+	case a.Email != "":
+		return a.Email
+	case a.Username != "":
+		return a.Username
+	default:
+		return a.Name
+	}
+}
+
+// client sends requests to the Gerrit REST API.
+//
+// https://gerrit-review.googlesource.com/Documentation/rest-api.html
+type client struct {
+	baseURL    string
+	httpClient *http.Client
+	// username and password are the credentials of HTTP basic authentication, if set. Gerrit serves authenticated
+	// requests, to both the REST API and git, under the /a/ prefix.
+	username string
+	password string
+}
+
+func newClient(baseURL, username, password string) *client {
+	return &client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: common.RetryableHTTPClientTimeout(30),
+		username:   username,
+		password:   password,
+	}
+}
+
+func (c *client) authenticated() bool {
+	return c.password != ""
+}
+
+// urlOf returns the URL of a path, under the /a/ prefix if requests are authenticated.
+func (c *client) urlOf(path string) string {
+	if c.authenticated() {
+		return c.baseURL + "/a" + path
+	}
+	return c.baseURL + path
+}
+
+// cloneURL returns the URL to clone a project from.
+func (c *client) cloneURL(project string) string {
+	return c.urlOf("/" + project)
+}
+
+// get decodes the JSON response to a GET request of an API path into v.
+func (c *client) get(ctx context.Context, path string, query url.Values, v any) error {
+	reqURL := c.urlOf(path)
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.authenticated() {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, req.URL.Redacted())
+	}
+
+	body := bufio.NewReader(resp.Body)
+	if prefix, err := body.Peek(len(xssiPrefix)); err == nil && bytes.Equal(prefix, xssiPrefix) {
+		_, _ = body.ReadString('\n')
+	}
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding response for %s: %w", req.URL.Redacted(), err)
+	}
+	return nil
+}
+
+// listProjects lists the names of the code projects that aren't hidden.
+func (c *client) listProjects(ctx context.Context) ([]string, error) {
+	var projects map[string]struct {
+		State string `json:"state"`
+	}
+	if err := c.get(ctx, "/projects/", url.Values{"type": {"CODE"}}, &projects); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(projects))
+	for name, project := range projects {
+		if project.State == "HIDDEN" {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// listChanges lists the open changes of a project, and the closed ones updated within recentDays days if it's set.
+func (c *client) listChanges(ctx context.Context, project string, recentDays uint32) ([]change, error) {
+	q := fmt.Sprintf(`project:"%s" status:open`, project)
+	if recentDays > 0 {
+		q = fmt.Sprintf(`project:"%s" (status:open OR -age:%dd)`, project, recentDays)
+	}
+
+	var changes []change
+	for {
+		query := url.Values{
+			"q": {q},
+			"o": {"ALL_REVISIONS"},
+			"n": {strconv.Itoa(changesPerPage)},
+			"S": {strconv.Itoa(len(changes))},
+		}
+		var page []struct {
+			ID        string `json:"id"`
+			Project   string `json:"project"`
+			Number    int64  `json:"_number"`
+			Subject   string `json:"subject"`
+			Revisions map[string]struct {
+				Number int64  `json:"_number"`
+				Ref    string `json:"ref"`
+			} `json:"revisions"`
+			MoreChanges bool `json:"_more_changes"`
+		}
+		if err := c.get(ctx, "/changes/", query, &page); err != nil {
+			return nil, err
+		}
+		for _, ch := range page {
+			patchSets := make([]patchSet, 0, len(ch.Revisions))
+			for commit, rev := range ch.Revisions {
+				patchSets = append(patchSets, patchSet{Number: rev.Number, Ref: rev.Ref, Commit: commit})
+			}
+			changes = append(changes, change{
+				ID:        ch.ID,
+				Project:   ch.Project,
+				Number:    ch.Number,
+				Subject:   ch.Subject,
+				PatchSets: patchSets,
+			})
+		}
+		if len(page) == 0 || !page[len(page)-1].MoreChanges {
+			return changes, nil
+		}
+	}
+}
+
+// changePath returns the API path of a change's resources.
+func changePath(ch change) string {
+	return "/changes/" + url.PathEscape(ch.Project+"~"+strconv.FormatInt(ch.Number, 10))
+}
+
+// listMessages lists the messages of a change.
+func (c *client) listMessages(ctx context.Context, ch change) ([]message, error) {
+	var apiMessages []struct {
+		ID             string  `json:"id"`
+		Author         account `json:"author"`
+		Date           string  `json:"date"`
+		Message        string  `json:"message"`
+		RevisionNumber int64   `json:"_revision_number"`
+	}
+	if err := c.get(ctx, changePath(ch)+"/messages", nil, &apiMessages); err != nil {
+		return nil, err
+	}
+	messages := make([]message, 0, len(apiMessages))
+	for _, m := range apiMessages {
+		messages = append(messages, message{
+			ID:       m.ID,
+			Author:   m.Author.String(),
+			Date:     m.Date,
+			Text:     m.Message,
+			PatchSet: m.RevisionNumber,
+		})
+	}
+	return messages, nil
+}
+
+// listComments lists the published review comments of a change.
+func (c *client) listComments(ctx context.Context, ch change) ([]reviewComment, error) {
+	var files map[string][]struct {
+		ID       string  `json:"id"`
+		PatchSet int64   `json:"patch_set"`
+		Author   account `json:"author"`
+		Updated  string  `json:"updated"`
+		Message  string  `json:"message"`
+	}
+	if err := c.get(ctx, changePath(ch)+"/comments", nil, &files); err != nil {
+		return nil, err
+	}
+	var comments []reviewComment
+	for path, fileComments := range files {
+		for _, fc := range fileComments {
+			comments = append(comments, reviewComment{
+				ID:       fc.ID,
+				Path:     path,
+				Author:   fc.Author.String(),
+				Updated:  fc.Updated,
+				Text:     fc.Message,
+				PatchSet: fc.PatchSet,
+			})
+		}
+	}
+	return comments, nil
+}
@@ -0,0 +1,415 @@
+package gerrit
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	gogit "github.com/go-git/go-git/v5"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_GERRIT
+
+// Source scans the projects of a Gerrit server: their branches, the patch sets of their open and recently updated
+// changes, which often never reach a branch, and the messages and review comments of those changes.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	endpoint string
+	client   *client
+
+	projects     []string
+	recentDays   uint32
+	skipComments bool
+
+	useCustomContentWriter bool
+	git                    *git.Git
+	scanOptions            *git.ScanOptions
+
+	// patchSets maps the commits of the fetched patch sets to their changes and patch set numbers.
+	patchSets   map[string]patchSetOf
+	patchSetsMu sync.RWMutex
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// patchSetOf is a patch set of a change.
+type patchSetOf struct {
+	project  string
+	change   int64
+	patchSet int64
+}
+
+// WithCustomContentWriter sets the useCustomContentWriter flag on the source.
+func (s *Source) WithCustomContentWriter() { s.useCustomContentWriter = true }
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Gerrit source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	if err := git.CmdCheck(); err != nil {
+		return err
+	}
+
+	var conn sourcespb.Gerrit
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.endpoint = strings.TrimSuffix(conn.GetEndpoint(), "/")
+	if u, err := url.Parse(s.endpoint); err != nil || u.Host == "" {
+		return fmt.Errorf("invalid endpoint %q", conn.GetEndpoint())
+	}
+
+	var username, password string
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Gerrit_BasicAuth:
+		log.RedactGlobally(cred.BasicAuth.GetPassword())
+		username, password = cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword()
+	case *sourcespb.Gerrit_Unauthenticated, nil:
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+	s.client = newClient(s.endpoint, username, password)
+
+	s.projects = conn.GetProjects()
+	s.recentDays = conn.GetRecentChangeDays()
+	s.skipComments = conn.GetSkipComments()
+
+	cfg := &git.Config{
+		SourceName:   s.name,
+		JobID:        s.jobID,
+		SourceID:     s.sourceID,
+		SourceType:   s.Type(),
+		Verify:       s.verify,
+		SkipBinaries: conn.GetSkipBinaries(),
+		SkipArchives: conn.GetSkipArchives(),
+		Concurrency:  concurrency,
+		SourceMetadataFunc: func(file, email, commit, timestamp, repository, repositoryLocalPath string, line int64) *source_metadatapb.MetaData {
+			metadata := &source_metadatapb.Gerrit{
+				Commit:    sanitizer.UTF8(commit),
+				File:      sanitizer.UTF8(file),
+				Email:     sanitizer.UTF8(email),
+				Project:   sanitizer.UTF8(s.projectOf(repository)),
+				Timestamp: sanitizer.UTF8(timestamp),
+				Line:      line,
+			}
+			if ps, ok := s.patchSetOfCommit(commit); ok {
+				metadata.ChangeNumber = ps.change
+				metadata.PatchSet = ps.patchSet
+				metadata.Link = s.changeLink(ps.project, ps.change, ps.patchSet)
+				if file != "" {
+					metadata.Link += "/" + file
+					if line > 0 {
+						metadata.Link += "#" + strconv.FormatInt(line, 10)
+					}
+				}
+			}
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Gerrit{Gerrit: metadata},
+			}
+		},
+		UseCustomContentWriter: s.useCustomContentWriter,
+	}
+	s.git = git.NewGit(cfg)
+	s.patchSets = make(map[string]patchSetOf)
+
+	return nil
+}
+
+func (s *Source) WithScanOptions(scanOptions *git.ScanOptions) {
+	s.scanOptions = scanOptions
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var projects []string
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			projects = append(projects, id)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating projects")
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+	// We must sort the projects so we can resume later if necessary.
+	slices.Sort(projects)
+
+	// If there is resume information available, limit this scan to only the projects that still need scanning.
+	projectsToScan, progressIndexOffset := sources.FilterReposToResume(projects, s.GetProgress().EncodedResumeInfo)
+	scanErrs := sources.NewScanErrors()
+	for i, project := range projectsToScan {
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.setProgressCompleteWithRepo(i, progressIndexOffset, len(projectsToScan), project)
+			// Ensure the project is removed from the resume info after being scanned.
+			defer func() {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, project)
+			}()
+
+			unit := git.SourceUnit{Kind: git.UnitRepo, ID: project}
+			if err := s.ChunkUnit(ctx, unit, sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(projectsToScan), len(projectsToScan), "Completed Gerrit scan", "")
+	return nil
+}
+
+// setProgressCompleteWithRepo calls the s.SetProgressComplete after safely setting up the encoded resume info string.
+func (s *Source) setProgressCompleteWithRepo(index, offset, total int, project string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	s.resumeInfoSlice = append(s.resumeInfoSlice, project)
+	slices.Sort(s.resumeInfoSlice)
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	s.SetProgressComplete(index+offset, total+offset, fmt.Sprintf("Project: %s", project), encodedResumeInfo)
+}
+
+// Enumerate reports the configured projects, or else every project the credentials can read, to the reporter. Units
+// are identified by project name.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	projects := s.projects
+	if len(projects) == 0 {
+		var err error
+		if projects, err = s.client.listProjects(ctx); err != nil {
+			return fmt.Errorf("error listing projects: %w", err)
+		}
+		ctx.Logger().Info("enumerated Gerrit projects", "count", len(projects))
+	}
+	for _, project := range projects {
+		if err := reporter.UnitOk(ctx, git.SourceUnit{Kind: git.UnitRepo, ID: project}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit clones a project, fetches the patch sets of its open and recent changes, and scans them along with its
+// branches, then the messages and review comments of the changes unless they're skipped.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	project, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "repo", project)
+
+	changes, err := s.client.listChanges(ctx, project, s.recentDays)
+	if err != nil {
+		return fmt.Errorf("error listing changes of %s: %w", project, err)
+	}
+
+	var path string
+	var gitRepo *gogit.Repository
+	if s.client.authenticated() {
+		path, gitRepo, err = git.CloneRepoUsingToken(ctx, s.client.password, s.client.cloneURL(project), "", s.client.username, false)
+	} else {
+		path, gitRepo, err = git.CloneRepoUsingUnauthenticated(ctx, s.client.cloneURL(project), "")
+	}
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(path)
+
+	var refs []string
+	for _, ch := range changes {
+		for _, ps := range ch.PatchSets {
+			refs = append(refs, ps.Ref)
+			s.addPatchSet(ps.Commit, patchSetOf{project: project, change: ch.Number, patchSet: ps.Number})
+		}
+	}
+	if err := s.fetchRefs(ctx, path, refs); err != nil {
+		return err
+	}
+	ctx.Logger().V(2).Info("fetched patch sets", "changes", len(changes), "patch_sets", len(refs))
+
+	if err := s.git.ScanRepo(ctx, gitRepo, path, s.scanOptions, reporter); err != nil {
+		return err
+	}
+
+	if s.skipComments {
+		return nil
+	}
+	for _, ch := range changes {
+		if err := s.scanComments(ctx, ch, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("error scanning comments of change %d: %w", ch.Number, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fetchRefs fetches patch set refs from a project's remote into the same refs of its clone, which the git source
+// scans with the clone's branches.
+func (s *Source) fetchRefs(ctx context.Context, path string, refs []string) error {
+	if len(refs) == 0 {
+		return nil
+	}
+	args := []string{"-C", path}
+	if s.client.authenticated() {
+		// Authenticate like the clone does, without storing the credentials in the clone's configuration.
+		auth := base64.StdEncoding.EncodeToString([]byte(s.client.username + ":" + s.client.password))
+		args = append(args, "-c", "http.extraHeader=Authorization: Basic "+auth)
+	}
+	args = append(args, "fetch", "--quiet", "--no-tags", "--stdin", "origin")
+
+	var refspecs strings.Builder
+	for _, ref := range refs {
+		refspecs.WriteString("+" + ref + ":" + ref + "\n")
+	}
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Stdin = strings.NewReader(refspecs.String())
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("error fetching patch sets: %w, %s", err, out)
+	}
+	return nil
+}
+
+// scanComments chunks the messages and review comments of a change.
+func (s *Source) scanComments(ctx context.Context, ch change, reporter sources.ChunkReporter) error {
+	messages, err := s.client.listMessages(ctx, ch)
+	if err != nil {
+		return err
+	}
+	comments, err := s.client.listComments(ctx, ch)
+	if err != nil {
+		return err
+	}
+
+	report := func(metadata *source_metadatapb.Gerrit, text string) error {
+		metadata.Project = sanitizer.UTF8(ch.Project)
+		metadata.ChangeNumber = ch.Number
+		chunk := sources.Chunk{
+			SourceName:     s.name,
+			SourceID:       s.SourceID(),
+			JobID:          s.JobID(),
+			SourceType:     s.Type(),
+			SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Gerrit{Gerrit: metadata}},
+			Data:           []byte(text),
+			Verify:         s.verify,
+		}
+		return reporter.ChunkOk(ctx, chunk)
+	}
+	for _, m := range messages {
+		metadata := &source_metadatapb.Gerrit{
+			PatchSet:  m.PatchSet,
+			Email:     sanitizer.UTF8(m.Author),
+			Timestamp: sanitizer.UTF8(m.Date),
+			Link:      s.changeLink(ch.Project, ch.Number, m.PatchSet),
+		}
+		if err := report(metadata, m.Text); err != nil {
+			return err
+		}
+	}
+	for _, c := range comments {
+		metadata := &source_metadatapb.Gerrit{
+			File:      sanitizer.UTF8(c.Path),
+			PatchSet:  c.PatchSet,
+			Email:     sanitizer.UTF8(c.Author),
+			Timestamp: sanitizer.UTF8(c.Updated),
+			Link:      s.changeLink(ch.Project, ch.Number, 0) + "/comment/" + url.PathEscape(c.ID) + "/",
+		}
+		if err := report(metadata, c.Text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changeLink returns the link to a change, or to one of its patch sets if patchSet is set.
+func (s *Source) changeLink(project string, change, patchSet int64) string {
+	link := s.endpoint + "/c/" + project + "/+/" + strconv.FormatInt(change, 10)
+	if patchSet > 0 {
+		link += "/" + strconv.FormatInt(patchSet, 10)
+	}
+	return link
+}
+
+// projectOf returns the project of the URL a project was cloned from.
+func (s *Source) projectOf(repoURL string) string {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return repoURL
+	}
+	u.User = nil
+	return strings.TrimPrefix(u.String(), s.client.cloneURL(""))
+}
+
+func (s *Source) addPatchSet(commit string, ps patchSetOf) {
+	s.patchSetsMu.Lock()
+	defer s.patchSetsMu.Unlock()
+	s.patchSets[commit] = ps
+}
+
+func (s *Source) patchSetOfCommit(commit string) (patchSetOf, bool) {
+	s.patchSetsMu.RLock()
+	defer s.patchSetsMu.RUnlock()
+	ps, ok := s.patchSets[commit]
+	return ps, ok
+}
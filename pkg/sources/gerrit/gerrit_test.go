@@ -0,0 +1,197 @@
+package gerrit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/cgi"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+// gerritProject creates the bare repository of project "platform/app" under root. Its main branch holds one commit,
+// and change 1 has two patch sets that aren't on any branch. It returns the commits of the patch sets.
+func gerritProject(t *testing.T, root string) (ps1, ps2 string) {
+	t.Helper()
+	work := t.TempDir()
+	run := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", work}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	commit := func(file, content string) string {
+		t.Helper()
+		require.NoError(t, os.WriteFile(filepath.Join(work, file), []byte(content), 0644))
+		run("add", file)
+		run("commit", "-q", "-m", "add "+file)
+		return run("rev-parse", "HEAD")
+	}
+	run("init", "-q", "-b", "main")
+	commit("README.md", "app\n")
+	ps1 = commit("config.yaml", "password: first\n")
+	run("reset", "-q", "--hard", "HEAD~1")
+	ps2 = commit("config.yaml", "password: second\n")
+	run("reset", "-q", "--hard", "HEAD~1")
+
+	bare := filepath.Join(root, "platform", "app")
+	cmd := exec.Command("git", "clone", "-q", "--bare", work, bare)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	for ref, commit := range map[string]string{"refs/changes/01/1/1": ps1, "refs/changes/01/1/2": ps2} {
+		out, err := exec.Command("git", "-C", bare, "update-ref", ref, commit).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	return ps1, ps2
+}
+
+// gerritStandIn serves the git repositories under root with git-http-backend and a stand-in of the REST API, both
+// under the /a/ prefix of authenticated requests.
+func gerritStandIn(t *testing.T, root string, api map[string]any) *httptest.Server {
+	t.Helper()
+	execPath, err := exec.Command("git", "--exec-path").Output()
+	require.NoError(t, err)
+	gitBackend := &cgi.Handler{
+		Path: filepath.Join(strings.TrimSpace(string(execPath)), "git-http-backend"),
+		Root: "/a",
+		Env:  []string{"GIT_PROJECT_ROOT=" + root, "GIT_HTTP_EXPORT_ALL=1"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "http-password" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/info/refs") || strings.HasSuffix(r.URL.Path, "/git-upload-pack") {
+			gitBackend.ServeHTTP(w, r)
+			return
+		}
+		key := strings.TrimPrefix(r.URL.EscapedPath(), "/a")
+		if q := r.URL.Query().Get("q"); q != "" {
+			key += "?q=" + q
+		}
+		resp, ok := api[key]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(")]}'\n"))
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSource_Chunks(t *testing.T) {
+	root := t.TempDir()
+	ps1, ps2 := gerritProject(t, root)
+	author := map[string]any{"name": "Jane Doe", "email": "jane@example.com"}
+	server := gerritStandIn(t, root, map[string]any{
+		"/projects/": map[string]any{
+			"platform/app":  map[string]any{"state": "ACTIVE"},
+			"platform/gone": map[string]any{"state": "HIDDEN"},
+		},
+		`/changes/?q=project:"platform/app" (status:open OR -age:30d)`: []any{map[string]any{
+			"id": "platform%2Fapp~main~I1", "project": "platform/app", "_number": 1, "subject": "Add config",
+			"revisions": map[string]any{
+				ps1: map[string]any{"_number": 1, "ref": "refs/changes/01/1/1"},
+				ps2: map[string]any{"_number": 2, "ref": "refs/changes/01/1/2"},
+			},
+		}},
+		"/changes/platform%2Fapp~1/messages": []any{
+			map[string]any{"id": "m1", "author": author, "date": "2024-01-02 03:04:05.000000000", "message": "Uploaded patch set 1.", "_revision_number": 1},
+		},
+		"/changes/platform%2Fapp~1/comments": map[string]any{
+			"config.yaml": []any{
+				map[string]any{"id": "c1", "patch_set": 1, "author": author, "updated": "2024-01-02 03:04:05.000000000", "message": "don't commit the password"},
+			},
+		},
+	})
+
+	conn, err := anypb.New(&sourcespb.Gerrit{
+		Endpoint: server.URL,
+		Credential: &sourcespb.Gerrit_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{Username: "user", Password: "http-password"},
+		},
+		RecentChangeDays: 30,
+	})
+	require.NoError(t, err)
+	s := &Source{}
+	require.NoError(t, s.Init(context.Background(), "test gerrit", 0, 0, false, conn, 1))
+
+	ctx := context.Background()
+	units := &sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(ctx, units))
+	require.Equal(t, []sources.SourceUnit{git.SourceUnit{Kind: git.UnitRepo, ID: "platform/app"}}, units.Units)
+
+	chunks := &sourcestest.TestReporter{}
+	require.NoError(t, s.ChunkUnit(ctx, units.Units[0], chunks))
+	require.Empty(t, chunks.ChunkErrs)
+
+	var patchSets, comments []*source_metadatapb.Gerrit
+	var data []string
+	for _, chunk := range chunks.Chunks {
+		md := chunk.SourceMetadata.GetGerrit()
+		require.NotNil(t, md)
+		assert.Equal(t, "platform/app", md.GetProject())
+		switch {
+		case md.GetCommit() == "" && md.GetChangeNumber() == 1:
+			comments = append(comments, md)
+			data = append(data, string(chunk.Data))
+		case md.GetFile() == "config.yaml":
+			patchSets = append(patchSets, md)
+		case md.GetFile() == "README.md":
+			// Commits on branches aren't part of a scanned change.
+			assert.Zero(t, md.GetChangeNumber())
+			assert.Empty(t, md.GetLink())
+		}
+	}
+
+	require.Len(t, patchSets, 2)
+	for _, md := range patchSets {
+		assert.Equal(t, int64(1), md.GetChangeNumber())
+		want := map[string]int64{ps1: 1, ps2: 2}[md.GetCommit()]
+		assert.Equal(t, want, md.GetPatchSet())
+		assert.True(t, strings.HasPrefix(md.GetLink(), server.URL+"/c/platform/app/+/1/"+strconv.FormatInt(want, 10)+"/config.yaml"), md.GetLink())
+	}
+
+	assert.Equal(t, []string{"Uploaded patch set 1.", "don't commit the password"}, data)
+	require.Len(t, comments, 2)
+	assert.Equal(t, server.URL+"/c/platform/app/+/1/1", comments[0].GetLink())
+	assert.Equal(t, "jane@example.com", comments[0].GetEmail())
+	assert.Equal(t, "config.yaml", comments[1].GetFile())
+	assert.Equal(t, server.URL+"/c/platform/app/+/1/comment/c1/", comments[1].GetLink())
+}
+
+func TestSource_projectOf(t *testing.T) {
+	tests := []struct {
+		password string
+		repoURL  string
+		want     string
+	}{
+		{password: "secret", repoURL: "https://user@gerrit.example.com/a/platform/build", want: "platform/build"},
+		{repoURL: "https://gerrit.example.com/platform/build", want: "platform/build"},
+	}
+	for _, tt := range tests {
+		s := &Source{client: newClient("https://gerrit.example.com/", "user", tt.password)}
+		assert.Equal(t, tt.want, s.projectOf(tt.repoURL))
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-logr/logr"
 	"github.com/google/go-github/v67/github"
 	"golang.org/x/oauth2"
 	"golang.org/x/sync/semaphore"
@@ -165,6 +166,11 @@ func (s *Source) Init(aCtx context.Context, name string, jobId sources.JobID, so
 		if err != nil || repoPath == "" {
 			return fmt.Errorf("error preparing repo: %w", err)
 		}
+		if conn.GetScanUnreachable() && !conn.GetTrustLocalGitConfig() {
+			if err := borrowLocalObjects(uri, repoPath); err != nil {
+				return fmt.Errorf("error preparing repo: %w", err)
+			}
+		}
 
 		conn.Directories = append(conn.Directories, repoPath)
 	}
@@ -191,6 +197,15 @@ func (s *Source) Init(aCtx context.Context, name string, jobId sources.JobID, so
 	if isBare := conn.GetBare(); isBare {
 		opts = append(opts, ScanOptionBare(isBare))
 	}
+	if conn.GetScanUnreachable() {
+		opts = append(opts, ScanOptionUnreachable(true))
+	}
+	if revisions := conn.GetRevisions(); len(revisions) > 0 {
+		if err := validateRevisions(revisions); err != nil {
+			return err
+		}
+		opts = append(opts, ScanOptionRevisions(revisions))
+	}
 	s.withScanOptions(NewScanOptions(opts...))
 
 	s.conn = &conn
@@ -301,8 +316,9 @@ func (s *Source) scanRepo(ctx context.Context, repoURI string, reporter sources.
 	err := func() error {
 		path, repo, err := cloneFunc()
 		// remove the directory only if it was created as a temporary path, or if it is a clone path and --no-cleanup is not set.
-		// if legacy JSON is enabled, don't remove the directory because we need it for outputting legacy JSON.
-		if !s.conn.GetPrintLegacyJson() {
+		// if legacy JSON or lifecycle tracking is enabled, don't remove the directory because results are looked up in it
+		// after the scan.
+		if !s.keepClones() {
 			if strings.HasPrefix(path, filepath.Join(os.TempDir(), "trufflehog")) || (!s.conn.GetNoCleanup() && s.conn.GetClonePath() != "") {
 				defer os.RemoveAll(path)
 			}
@@ -355,8 +371,9 @@ func (s *Source) scanDir(ctx context.Context, gitDir string, reporter sources.Ch
 
 	err = func() error {
 		// remove the directory only if it was created as a temporary path, or if it is a clone path and --no-cleanup is not set.
-		// if legacy JSON is enabled, don't remove the directory because we need it for outputting legacy JSON.
-		if !s.conn.GetPrintLegacyJson() {
+		// if legacy JSON or lifecycle tracking is enabled, don't remove the directory because results are looked up in it
+		// after the scan.
+		if !s.keepClones() {
 			if strings.HasPrefix(gitDir, filepath.Join(os.TempDir(), "trufflehog")) || (!s.conn.GetNoCleanup() && s.conn.GetClonePath() != "") {
 				defer os.RemoveAll(gitDir)
 			}
@@ -370,6 +387,12 @@ func (s *Source) scanDir(ctx context.Context, gitDir string, reporter sources.Ch
 	return nil
 }
 
+// keepClones reports whether cloned repositories must outlive their scan, to be read when their results are output.
+// They're cleaned up at exit instead.
+func (s *Source) keepClones() bool {
+	return s.conn.GetPrintLegacyJson() || s.conn.GetTrackLifecycle()
+}
+
 // RepoFromPath opens a git repository from a given path.
 // If the repository is bare (--mirror or --bare), the directory referenced by the path variable
 // will contain the contents of the git directory (ex: path/HEAD, path/config, etc.). In this case,
@@ -701,7 +724,13 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 		logValues = append(logValues, "max_depth", scanOptions.MaxDepth)
 	}
 
-	diffChan, err := s.parser.RepoPath(repoCtx, path, scanOptions.HeadHash, scanOptions.BaseHash == "", scanOptions.ExcludeGlobs, isRepoBare(path))
+	head, revisions := scanOptions.HeadHash, []string(nil)
+	if len(scanOptions.Revisions) > 0 {
+		head, revisions = scanOptions.Revisions[0], scanOptions.Revisions[1:]
+		logValues = append(logValues, "revisions", scanOptions.Revisions)
+	}
+
+	diffChan, err := s.parser.RepoPath(repoCtx, path, head, scanOptions.BaseHash == "", scanOptions.ExcludeGlobs, isRepoBare(path), revisions...)
 	if err != nil {
 		return err
 	}
@@ -710,7 +739,18 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 	}
 
 	logger.Info("scanning repo", logValues...)
+	return s.scanDiffs(ctx, logger, path, remoteURL, diffChan, scanOptions, reporter)
+}
 
+// scanDiffs chunks the commits and diffs parsed from the git log of the repository at path.
+func (s *Git) scanDiffs(
+	ctx context.Context,
+	logger logr.Logger,
+	path, remoteURL string,
+	diffChan chan *gitparse.Diff,
+	scanOptions *ScanOptions,
+	reporter sources.ChunkReporter,
+) error {
 	var (
 		gitDir         = getGitDir(path)
 		depth          int64
@@ -775,11 +815,11 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 			continue
 		}
 
-		// Handle binary files by reading the entire file rather than using the diff.
-		if diff.IsBinary {
+		// Handle binary files, and notebooks scanned cell by cell, by reading the entire file rather than using the diff.
+		if diff.IsBinary || s.scanNotebookCells(fileName) {
 			commitHash := plumbing.NewHash(fullHash)
 
-			if s.skipBinaries || feature.ForceSkipBinaries.Load() {
+			if diff.IsBinary && (s.skipBinaries || feature.ForceSkipBinaries.Load()) {
 				logger.V(5).Info("skipping binary file",
 					"commit", commitHash.String()[:7],
 					"path", fileName)
@@ -808,7 +848,7 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 		}
 
 		if diff.Len() > sources.DefaultChunkSize+sources.DefaultPeekSize {
-			s.gitChunk(ctx, diff, fileName, email, fullHash, when, remoteURL, reporter)
+			s.gitChunk(ctx, diff, fileName, email, fullHash, when, remoteURL, path, reporter)
 			continue
 		}
 
@@ -853,7 +893,14 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 	return nil
 }
 
-func (s *Git) gitChunk(ctx context.Context, diff *gitparse.Diff, fileName, email, hash, when, urlMetadata string, reporter sources.ChunkReporter) {
+// scanNotebookCells reports whether a changed Jupyter notebook should be scanned in full rather than through its
+// diff. Lines of a notebook diff point into the notebook's JSON, while scanning the whole file attributes findings
+// to the cell they were found in, which only git metadata can record.
+func (s *Git) scanNotebookCells(fileName string) bool {
+	return s.sourceType == sourcespb.SourceType_SOURCE_TYPE_GIT && filepath.Ext(fileName) == ".ipynb"
+}
+
+func (s *Git) gitChunk(ctx context.Context, diff *gitparse.Diff, fileName, email, hash, when, urlMetadata, repoPath string, reporter sources.ChunkReporter) {
 	reader, err := diff.ReadCloser()
 	if err != nil {
 		ctx.Logger().Error(err, "error creating reader for chunk", "filename", fileName, "commit", hash, "file", diff.PathB)
@@ -872,7 +919,7 @@ func (s *Git) gitChunk(ctx context.Context, diff *gitparse.Diff, fileName, email
 			// Add oversize chunk info
 			if newChunkBuffer.Len() > 0 {
 				// Send the existing fragment.
-				metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, "", int64(diff.LineStart+lastOffset))
+				metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, repoPath, int64(diff.LineStart+lastOffset))
 				chunk := sources.Chunk{
 					SourceName:     s.sourceName,
 					SourceID:       s.sourceID,
@@ -892,7 +939,7 @@ func (s *Git) gitChunk(ctx context.Context, diff *gitparse.Diff, fileName, email
 			}
 			if len(line) > sources.DefaultChunkSize {
 				// Send the oversize line.
-				metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, "", int64(diff.LineStart+offset))
+				metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, repoPath, int64(diff.LineStart+offset))
 				chunk := sources.Chunk{
 					SourceName:     s.sourceName,
 					SourceID:       s.sourceID,
@@ -916,7 +963,7 @@ func (s *Git) gitChunk(ctx context.Context, diff *gitparse.Diff, fileName, email
 	}
 	// Send anything still in the new chunk buffer
 	if newChunkBuffer.Len() > 0 {
-		metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, "", int64(diff.LineStart+lastOffset))
+		metadata := s.sourceMetadataFunc(fileName, email, hash, when, urlMetadata, repoPath, int64(diff.LineStart+lastOffset))
 		chunk := sources.Chunk{
 			SourceName:     s.sourceName,
 			SourceID:       s.sourceID,
@@ -1006,11 +1053,11 @@ func (s *Git) ScanStaged(ctx context.Context, repo *git.Repository, path string,
 		email := diff.Commit.Author
 		when := diff.Commit.Date.UTC().Format("2006-01-02 15:04:05 -0700")
 
-		// Handle binary files by reading the entire file rather than using the diff.
-		if diff.IsBinary {
+		// Handle binary files, and notebooks scanned cell by cell, by reading the entire file rather than using the diff.
+		if diff.IsBinary || s.scanNotebookCells(fileName) {
 			commitHash := plumbing.NewHash(fullHash)
 
-			if s.skipBinaries || feature.ForceSkipBinaries.Load() {
+			if diff.IsBinary && (s.skipBinaries || feature.ForceSkipBinaries.Load()) {
 				logger.V(5).Info("skipping binary file",
 					"commit", commitHash.String()[:7],
 					"path", fileName)
@@ -1082,12 +1129,17 @@ func (s *Git) ScanRepo(ctx context.Context, repo *git.Repository, repoPath strin
 		s.metrics.RecordRepoScanned(statusFailure)
 		return err
 	}
-	// Skip staged scanning for mirror/bare clones
-	if !isRepoBare(repoPath) {
+	// Skip staged scanning for mirror/bare clones, and when only selected revisions are scanned
+	if !isRepoBare(repoPath) && len(scanOptions.Revisions) == 0 {
 		if err := s.ScanStaged(ctx, repo, repoPath, scanOptions, reporter); err != nil {
 			ctx.Logger().V(1).Info("error scanning unstaged changes", "error", err)
 		}
 	}
+	if scanOptions.Unreachable {
+		if err := s.ScanUnreachable(ctx, repo, repoPath, scanOptions, reporter); err != nil {
+			ctx.Logger().Error(err, "error scanning unreachable objects", "path", repoPath)
+		}
+	}
 
 	// Get the number of commits scanned in this repo
 	commitsScannedInRepo := atomic.LoadUint64(&s.repoCommitsScanned)
@@ -1118,6 +1170,37 @@ func (s *Git) ScanRepo(ctx context.Context, repo *git.Repository, repoPath strin
 	return nil
 }
 
+// revisionOptions are the git log options that may be given as revisions. Any other argument starting with a dash
+// is rejected, so revisions can't pass arbitrary options to git.
+var revisionOptions = map[string]bool{"--not": true, "--all": true, "--branches": true, "--tags": true, "--remotes": true}
+
+// revisionOptionPrefixes are the git log options taking a pattern that may be given as revisions.
+var revisionOptionPrefixes = []string{"--branches=", "--tags=", "--remotes=", "--glob="}
+
+// validateRevisions checks that revisions only hold revisions and the git log options that select them.
+func validateRevisions(revisions []string) error {
+	for i, rev := range revisions {
+		if rev == "" {
+			return fmt.Errorf("invalid revision: empty")
+		}
+		// The first revision is passed to git log on its own, so must select commits by itself.
+		if i == 0 && rev == "--not" {
+			return fmt.Errorf("invalid revision %q: revisions can't start with --not", rev)
+		}
+		if !strings.HasPrefix(rev, "-") || revisionOptions[rev] {
+			continue
+		}
+		valid := false
+		for _, prefix := range revisionOptionPrefixes {
+			valid = valid || strings.HasPrefix(rev, prefix)
+		}
+		if !valid {
+			return fmt.Errorf("invalid revision %q: unsupported option", rev)
+		}
+	}
+	return nil
+}
+
 // normalizeConfig updates scanOptions with the resolved base and head commit hashes.
 // It's designed to handle scenarios where BaseHash and HeadHash in scanOptions might be branch names or
 // other non-hash references. This ensures that both the base and head commits are resolved to actual commit hashes.
@@ -1430,6 +1513,18 @@ func HandleBinary(
 		return nil
 	}
 
+	return handleBlob(fileCtx, gitDir, reporter, chunkSkel, commitHash.String()+":"+path, skipArchives)
+}
+
+// handleBlob chunks the content of a blob, named by any object name `git cat-file` accepts, using the file handlers.
+func handleBlob(
+	ctx context.Context,
+	gitDir string,
+	reporter sources.ChunkReporter,
+	chunkSkel *sources.Chunk,
+	object string,
+	skipArchives bool,
+) (err error) {
 	const (
 		cmdTimeout = 60 * time.Second
 		waitDelay  = 5 * time.Second
@@ -1449,10 +1544,10 @@ func HandleBinary(
 
 	// Create a timeout context for the 'git cat-file' command to ensure it does not run indefinitely.
 	// This prevents potential resource exhaustion by terminating the command if it exceeds the specified duration.
-	catFileCtx, cancel := context.WithTimeoutCause(fileCtx, cmdTimeout, errors.New("git cat-file timeout"))
+	catFileCtx, cancel := context.WithTimeoutCause(ctx, cmdTimeout, errors.New("git cat-file timeout"))
 	defer cancel()
 
-	cmd := exec.CommandContext(catFileCtx, "git", "-C", gitDir, "cat-file", "blob", commitHash.String()+":"+path)
+	cmd := exec.CommandContext(catFileCtx, "git", "-C", gitDir, "cat-file", "blob", object)
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
 	cmd.WaitDelay = waitDelay // give the command a chance to finish before the timeout :)
@@ -12,6 +12,7 @@ import (
 
 	"github.com/kylelemons/godebug/pretty"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/feature"
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -1150,3 +1151,139 @@ func TestPrepareRepoWithNormalizationBare(t *testing.T) {
 		})
 	}
 }
+
+func TestScanUnreachable(t *testing.T) {
+	repoPath := setupTestRepo(t, "unreachable")
+	git := func(args ...string) {
+		t.Helper()
+		out, err := exec.Command("git", append([]string{"-C", repoPath}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	addTestFileAndCommit(t, repoPath, "config.txt", "reachable\n")
+
+	// A commit dropped by a reset is only recorded in the reflog.
+	addTestFileAndCommit(t, repoPath, "config.txt", "reset secret\n")
+	git("reset", "--hard", "HEAD~1")
+	// Two stash entries, the first of which is only recorded in the stash's reflog.
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "config.txt"), []byte("older stashed secret\n"), 0644))
+	git("stash")
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "config.txt"), []byte("stashed secret\n"), 0644))
+	git("stash")
+	// A file staged and then unstaged leaves a dangling blob.
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "unstaged.txt"), []byte("unstaged secret\n"), 0644))
+	git("add", "unstaged.txt")
+	git("rm", "--cached", "unstaged.txt")
+
+	unreachable := map[string]bool{
+		"reachable":            false,
+		"reset secret":         true,
+		"older stashed secret": true,
+		"stashed secret":       true,
+		"unstaged secret":      true,
+	}
+	for _, tt := range []struct {
+		name            string
+		scanUnreachable bool
+		trustConfig     bool
+		want            map[string]bool
+	}{
+		{
+			name: "reachable history only",
+			want: map[string]bool{"reachable": false},
+		},
+		{
+			name:            "unreachable objects of a clone",
+			scanUnreachable: true,
+			want:            unreachable,
+		},
+		{
+			name:            "unreachable objects in place",
+			scanUnreachable: true,
+			trustConfig:     true,
+			want:            unreachable,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			conn, err := anypb.New(&sourcespb.Git{
+				Uri:                 "file://" + repoPath,
+				ScanUnreachable:     tt.scanUnreachable,
+				TrustLocalGitConfig: tt.trustConfig,
+			})
+			require.NoError(t, err)
+			s := Source{}
+			require.NoError(t, s.Init(ctx, "test unreachable", 0, 0, false, conn, 1))
+
+			reporter := sourcestest.TestReporter{}
+			require.NoError(t, s.scanDirs(ctx, &reporter))
+			require.Empty(t, reporter.ChunkErrs)
+
+			// Diffs hold the lines a commit added, and dangling blobs their full content.
+			got := make(map[string]bool)
+			for _, chunk := range reporter.Chunks {
+				md := chunk.SourceMetadata.GetGit()
+				if md.GetFile() == "" && md.GetBlob() == "" {
+					continue // commit metadata
+				}
+				if md.GetBlob() != "" {
+					assert.Empty(t, md.GetCommit())
+				}
+				for _, line := range strings.Split(strings.TrimSpace(string(chunk.Data)), "\n") {
+					got[line] = md.GetUnreachable()
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestScanRevisions(t *testing.T) {
+	repoPath := setupTestRepo(t, "revisions")
+	addTestFileAndCommit(t, repoPath, "config.txt", "first\n")
+	addTestFileAndCommit(t, repoPath, "config.txt", "second\n")
+	out, err := exec.Command("git", "-C", repoPath, "rev-parse", "HEAD").Output()
+	require.NoError(t, err)
+	second := strings.TrimSpace(string(out))
+	addTestFileAndCommit(t, repoPath, "config.txt", "third\n")
+
+	for _, tt := range []struct {
+		name      string
+		revisions []string
+		want      []string
+		wantErr   bool
+	}{
+		{name: "commits after a revision", revisions: []string{"HEAD", "--not", second}, want: []string{"third"}},
+		{name: "commits not on any ref", revisions: []string{"HEAD", "--not", "--all"}},
+		{name: "unsupported option", revisions: []string{"HEAD", "--output=/tmp/file"}, wantErr: true},
+		{name: "leading --not", revisions: []string{"--not", "HEAD"}, wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			conn, err := anypb.New(&sourcespb.Git{
+				Uri:                 "file://" + repoPath,
+				TrustLocalGitConfig: true,
+				Revisions:           tt.revisions,
+			})
+			require.NoError(t, err)
+			s := Source{}
+			err = s.Init(ctx, "test revisions", 0, 0, false, conn, 1)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			reporter := sourcestest.TestReporter{}
+			require.NoError(t, s.scanDirs(ctx, &reporter))
+			require.Empty(t, reporter.ChunkErrs)
+
+			var got []string
+			for _, chunk := range reporter.Chunks {
+				if chunk.SourceMetadata.GetGit().GetFile() != "" {
+					got = append(got, strings.TrimSpace(string(chunk.Data)))
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
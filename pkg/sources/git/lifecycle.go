@@ -0,0 +1,250 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"slices"
+	"strings"
+
+	ahocorasick "github.com/BobuSumisu/aho-corasick"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// Lifecycles returns the lifecycles of secrets found in the repository at repoPath, in the order of the secrets. The
+// lifecycle of a secret is nil if it doesn't appear verbatim in the repository's history, as for secrets found in
+// encoded data.
+//
+// A finding only points at the commit whose diff it was found in, so the secrets are correlated across the history of
+// all refs, which is read once whatever the number of secrets: the oldest commit whose diff adds a secret introduced
+// it, and the newest commit in the history of HEAD whose diff removes more occurrences of it than it adds removed it.
+// The tree at HEAD shows whether it's still present. Secrets spanning several lines, such as private keys, are matched
+// by all their lines.
+//
+// Secrets are matched in-process, and only ever passed to git on its standard input rather than on its command line,
+// where other users of the machine could read them.
+func Lifecycles(ctx context.Context, repoPath string, secrets [][]byte) ([]*detectors.SecretLifecycle, error) {
+	lifecycles := make([]*detectors.SecretLifecycle, len(secrets))
+	p := newLifecyclePatterns(secrets)
+	if len(p.lines) == 0 {
+		return lifecycles, nil
+	}
+
+	out, err := gitOutput(ctx, repoPath, "rev-list", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	headCommits := make(map[string]struct{})
+	for _, commit := range strings.Fields(string(out)) {
+		headCommits[commit] = struct{}{}
+	}
+
+	removedIn := make([]string, len(secrets))
+	err = p.walkHistory(ctx, repoPath, func(commit, author string, added, deleted map[int]int) {
+		_, inHead := headCommits[commit]
+		for _, i := range p.touched(added, deleted) {
+			adds, removes := p.occurrences(i, added), p.occurrences(i, deleted)
+			if adds > 0 && lifecycles[i] == nil {
+				lifecycles[i] = &detectors.SecretLifecycle{FirstSeenCommit: commit, FirstSeenAuthor: author}
+			}
+			if inHead && adds != removes {
+				removedIn[i] = ""
+				if removes > adds {
+					removedIn[i] = commit
+				}
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	present, err := p.presentAt(ctx, repoPath, "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	for i, lifecycle := range lifecycles {
+		if lifecycle == nil {
+			continue
+		}
+		lifecycle.PresentAtHead = present[i]
+		if !present[i] {
+			lifecycle.RemovedCommit = removedIn[i]
+		}
+	}
+	return lifecycles, nil
+}
+
+// lifecyclePatterns matches the lines of secrets. Secrets are split into their non-blank lines, which are matched
+// individually, and a secret occurs as many times as the least frequent of its lines.
+type lifecyclePatterns struct {
+	trie *ahocorasick.Trie
+	// lines are the distinct lines of the secrets.
+	lines [][]byte
+	// secretLines are the indexes of the lines of each secret, and lineSecrets the indexes of the secrets each line
+	// belongs to.
+	secretLines [][]int
+	lineSecrets [][]int
+}
+
+func newLifecyclePatterns(secrets [][]byte) *lifecyclePatterns {
+	p := &lifecyclePatterns{secretLines: make([][]int, len(secrets))}
+	index := make(map[string]int)
+	for i, secret := range secrets {
+		for _, line := range bytes.Split(secret, []byte("\n")) {
+			if line = bytes.TrimSpace(line); len(line) == 0 {
+				continue
+			}
+			idx, ok := index[string(line)]
+			if !ok {
+				idx = len(p.lines)
+				index[string(line)] = idx
+				p.lines = append(p.lines, line)
+				p.lineSecrets = append(p.lineSecrets, nil)
+			}
+			if !slices.Contains(p.secretLines[i], idx) {
+				p.secretLines[i] = append(p.secretLines[i], idx)
+				p.lineSecrets[idx] = append(p.lineSecrets[idx], i)
+			}
+		}
+	}
+	p.trie = ahocorasick.NewTrieBuilder().AddPatterns(p.lines).Build()
+	return p
+}
+
+// count adds the occurrences of each line in data to counts.
+func (p *lifecyclePatterns) count(data []byte, counts map[int]int) {
+	for _, match := range p.trie.Match(data) {
+		counts[int(match.Pattern())]++
+	}
+}
+
+// occurrences returns the number of occurrences of a secret, given the counts of the lines.
+func (p *lifecyclePatterns) occurrences(secret int, counts map[int]int) int {
+	n := -1
+	for _, line := range p.secretLines[secret] {
+		if c := counts[line]; n < 0 || c < n {
+			n = c
+		}
+	}
+	return max(n, 0)
+}
+
+// touched returns the secrets any of whose lines were counted.
+func (p *lifecyclePatterns) touched(counts ...map[int]int) []int {
+	var secrets []int
+	for _, c := range counts {
+		for line := range c {
+			secrets = append(secrets, p.lineSecrets[line]...)
+		}
+	}
+	slices.Sort(secrets)
+	return slices.Compact(secrets)
+}
+
+// walkHistory reads the diffs of the commits reachable from any ref and calls fn with the counts of the lines of the
+// secrets that each commit added and deleted. Commits are read after their parents, and merge commits have no diff of
+// their own.
+func (p *lifecyclePatterns) walkHistory(ctx context.Context, repoPath string, fn func(commit, author string, added, deleted map[int]int)) error {
+	cmd := exec.CommandContext(ctx, "git", "-C", repoPath, "log", "--all", "--topo-order", "--reverse", "--format=%x00%H%x00%an <%ae>",
+		"--patch", "--unified=0", "--no-color", "--no-ext-diff", "--no-textconv", "--no-renames")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("error running git log: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("error running git log: %w", err)
+	}
+
+	var commit, author string
+	added, deleted := make(map[int]int), make(map[int]int)
+	flush := func() {
+		if commit != "" {
+			fn(commit, author, added, deleted)
+		}
+		clear(added)
+		clear(deleted)
+	}
+
+	// Commit headers start with a NUL byte, which diff lines never do.
+	r := bufio.NewReader(stdout)
+	inHunk := false
+	for {
+		line, readErr := r.ReadBytes('\n')
+		line = bytes.TrimSuffix(line, []byte("\n"))
+		switch {
+		case len(line) == 0:
+		case line[0] == 0:
+			flush()
+			c, a, _ := bytes.Cut(line[1:], []byte{0})
+			commit, author = string(c), string(a)
+			inHunk = false
+		case bytes.HasPrefix(line, []byte("diff ")):
+			inHunk = false
+		case bytes.HasPrefix(line, []byte("@@")):
+			inHunk = true
+		case inHunk && line[0] == '+':
+			p.count(line[1:], added)
+		case inHunk && line[0] == '-':
+			p.count(line[1:], deleted)
+		}
+		if readErr != nil {
+			if !errors.Is(readErr, io.EOF) {
+				_ = cmd.Wait()
+				return fmt.Errorf("error reading git log: %w", readErr)
+			}
+			break
+		}
+	}
+	flush()
+
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("error running git log: %w\n%s", err, stderr.Bytes())
+	}
+	return nil
+}
+
+// presentAt reports which secrets are in the tree of a revision. git grep prints the matches of the lines, which are
+// matched against the lines again, as it leaves out lines contained in longer matches.
+func (p *lifecyclePatterns) presentAt(ctx context.Context, repoPath, rev string) ([]bool, error) {
+	cmd := exec.CommandContext(ctx, "git", "-C", repoPath, "grep", "--fixed-strings", "--only-matching",
+		"-h", "--text", "-f", "-", rev, "--")
+	cmd.Stdin = bytes.NewReader(bytes.Join(p.lines, []byte("\n")))
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	// git grep exits with status 1 when nothing matches.
+	if exitErr := (*exec.ExitError)(nil); errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		err = nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error running git grep: %w\n%s", err, stderr.Bytes())
+	}
+
+	found := make(map[int]int)
+	p.count(out, found)
+	present := make([]bool, len(p.secretLines))
+	for i := range present {
+		present[i] = p.occurrences(i, found) > 0
+	}
+	return present, nil
+}
+
+// gitOutput runs a git command in the repository at repoPath and returns its output.
+func gitOutput(ctx context.Context, repoPath string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", repoPath}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("error running git %s: %w\n%s", args[0], err, stderr.Bytes())
+	}
+	return out, nil
+}
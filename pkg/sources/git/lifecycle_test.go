@@ -0,0 +1,103 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+func TestLifecycles(t *testing.T) {
+	repoPath := setupTestRepo(t, "lifecycle")
+	git := func(args ...string) string {
+		t.Helper()
+		out, err := exec.Command("git", append([]string{"-C", repoPath}, args...)...).CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	commit := func(file, content string) string {
+		t.Helper()
+		addTestFileAndCommit(t, repoPath, file, content)
+		return git("rev-parse", "HEAD")
+	}
+	const key = "-----BEGIN KEY-----\nMIIEvQIBADANBgkqhkiG9w0BAQEFAASC\n-----END KEY-----"
+
+	introduced := commit("config.txt", "token = removed-secret\n")
+	git("checkout", "-q", "-b", "side")
+	side := commit("side.txt", "token = side-secret\n")
+	git("checkout", "-q", "-")
+	removed := commit("config.txt", "token = current-secret\n")
+	require.NoError(t, os.Remove(filepath.Join(repoPath, "config.txt")))
+	current := commit("moved.txt", "token = current-secret\n"+key+"\n")
+	readded := commit("again.txt", "token = readded-secret\n")
+	commit("again.txt", "token = none\n")
+	commit("again.txt", "token = readded-secret\n")
+	removedAgain := commit("again.txt", "token = none again\n")
+
+	author := "Test User <test@example.com>"
+	tests := []struct {
+		secret string
+		want   *detectors.SecretLifecycle
+	}{
+		{
+			secret: "removed-secret",
+			want:   &detectors.SecretLifecycle{FirstSeenCommit: introduced, FirstSeenAuthor: author, RemovedCommit: removed},
+		},
+		{
+			// The secret moved to another file, but was never removed.
+			secret: "current-secret",
+			want:   &detectors.SecretLifecycle{FirstSeenCommit: removed, FirstSeenAuthor: author, PresentAtHead: true},
+		},
+		{
+			// The secret was never in the history of HEAD.
+			secret: "side-secret",
+			want:   &detectors.SecretLifecycle{FirstSeenCommit: side, FirstSeenAuthor: author},
+		},
+		{
+			// The secret was removed, added back and removed again.
+			secret: "readded-secret",
+			want:   &detectors.SecretLifecycle{FirstSeenCommit: readded, FirstSeenAuthor: author, RemovedCommit: removedAgain},
+		},
+		{
+			// A secret contained in another one is still matched on its own.
+			secret: "secret",
+			want:   &detectors.SecretLifecycle{FirstSeenCommit: introduced, FirstSeenAuthor: author, PresentAtHead: true},
+		},
+		{
+			secret: key,
+			want:   &detectors.SecretLifecycle{FirstSeenCommit: current, FirstSeenAuthor: author, PresentAtHead: true},
+		},
+		{
+			// Secrets found in encoded data don't appear in the repository.
+			secret: "encoded-secret",
+		},
+	}
+
+	secrets := make([][]byte, len(tests))
+	for i, tt := range tests {
+		secrets[i] = []byte(tt.secret)
+	}
+
+	// Secrets must not be passed on the command line of git, where other users could read them.
+	trace := filepath.Join(t.TempDir(), "trace")
+	t.Setenv("GIT_TRACE", trace)
+	got, err := Lifecycles(context.Background(), repoPath, secrets)
+	require.NoError(t, err)
+	commands, err := os.ReadFile(trace)
+	require.NoError(t, err)
+	require.Contains(t, string(commands), "grep")
+
+	for i, tt := range tests {
+		t.Run(tt.secret, func(t *testing.T) {
+			assert.Equal(t, tt.want, got[i])
+			assert.NotContains(t, string(commands), strings.Split(tt.secret, "\n")[0])
+		})
+	}
+}
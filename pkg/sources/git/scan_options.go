@@ -13,6 +13,12 @@ type ScanOptions struct {
 	Bare         bool
 	ExcludeGlobs []string
 	LogOptions   *git.LogOptions
+	// Unreachable also scans the stash, reflogs and dangling commits and blobs, which the git log of the repository
+	// doesn't include.
+	Unreachable bool
+	// Revisions, if set, selects the commits to scan with git log revision arguments, such as commit hashes and
+	// "--not --all", in place of HeadHash. Staged changes aren't scanned.
+	Revisions []string
 }
 
 type ScanOption func(*ScanOptions)
@@ -59,6 +65,18 @@ func ScanOptionBare(bare bool) ScanOption {
 	}
 }
 
+func ScanOptionUnreachable(unreachable bool) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.Unreachable = unreachable
+	}
+}
+
+func ScanOptionRevisions(revisions []string) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.Revisions = revisions
+	}
+}
+
 func NewScanOptions(options ...ScanOption) *ScanOptions {
 	scanOptions := &ScanOptions{
 		Filter:   common.FilterEmpty(),
@@ -0,0 +1,132 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// ScanUnreachable chunks the objects of a repository that its git log doesn't include: every stash entry, commits
+// recorded only in reflogs, such as those dropped by a reset or rebase, and dangling commits and blobs, such as
+// files that were staged and then unstaged. Notes attached to these commits are scanned with their messages.
+//
+// The git metadata of every chunk is marked as unreachable, and chunks of dangling blobs, which belong to no commit,
+// record the blob's hash instead.
+func (s *Git) ScanUnreachable(ctx context.Context, repo *git.Repository, path string, scanOptions *ScanOptions, reporter sources.ChunkReporter) error {
+	remoteURL := GetSafeRemoteURL(repo, "origin")
+	gitDir := getGitDir(path)
+	logger := ctx.Logger().WithValues("path", path)
+
+	commits, blobs, err := danglingObjects(ctx, gitDir)
+	if err != nil {
+		return err
+	}
+	logger.V(1).Info("scanning unreachable objects", "dangling_commits", len(commits), "dangling_blobs", len(blobs))
+
+	reporter = unreachableReporter{reporter}
+	diffChan, err := s.parser.Unreachable(ctx, path, commits, isRepoBare(path))
+	if err != nil {
+		return err
+	}
+	// Base and head bound the log of reachable history, and don't apply here.
+	unreachableOptions := NewScanOptions(ScanOptionFilter(scanOptions.Filter))
+	if err := s.scanDiffs(ctx, logger, path, remoteURL, diffChan, unreachableOptions, reporter); err != nil {
+		return err
+	}
+
+	for _, blob := range blobs {
+		metadata := s.sourceMetadataFunc("", "", "", "", remoteURL, path, 0)
+		if md := metadata.GetGit(); md != nil {
+			md.Blob = blob
+		}
+		chunkSkel := &sources.Chunk{
+			SourceName:     s.sourceName,
+			SourceID:       s.sourceID,
+			JobID:          s.jobID,
+			SourceType:     s.sourceType,
+			SourceMetadata: metadata,
+			Verify:         s.verify,
+		}
+		if err := handleBlob(ctx, gitDir, reporter, chunkSkel, blob, s.skipArchives); err != nil {
+			logger.Error(err, "error handling dangling blob", "blob", blob)
+		}
+	}
+	return nil
+}
+
+// danglingObjects returns the hashes of the commits and blobs in a repository that no other object or ref refers
+// to, as reported by `git fsck`. Reflogs are ignored, so commits only they refer to are reported as well.
+func danglingObjects(ctx context.Context, gitDir string) (commits, blobs []string, err error) {
+	cmd := exec.CommandContext(ctx, "git", "-C", gitDir, "fsck", "--dangling", "--no-reflogs", "--no-progress", "--connectivity-only")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error running git fsck: %w\n%s", err, stderr.Bytes())
+	}
+
+	// Each line has the form "dangling <type> <hash>".
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || fields[0] != "dangling" {
+			continue
+		}
+		switch fields[1] {
+		case "commit":
+			commits = append(commits, fields[2])
+		case "blob":
+			blobs = append(blobs, fields[2])
+		}
+	}
+	return commits, blobs, scanner.Err()
+}
+
+// borrowLocalObjects makes the objects of the local repository at uri available to its clone at clonePath. Local
+// repositories are cloned, rather than scanned in place, unless their configuration is trusted, and a clone holds
+// neither the original's unreachable objects nor its stash and reflogs. With the original's objects borrowed, its
+// stash entries and reflog commits are dangling in the clone, where ScanUnreachable finds them. Other URIs are left
+// alone.
+func borrowLocalObjects(uri, clonePath string) error {
+	parsed, err := GitURLParse(uri)
+	if err != nil || parsed.Scheme != "file" {
+		return err
+	}
+	original, err := normalizeFileURI(parsed)
+	if err != nil {
+		return err
+	}
+
+	alternates := filepath.Join(getGitDir(clonePath), "objects", "info", "alternates")
+	f, err := os.OpenFile(alternates, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("unable to open alternates file: %w", err)
+	}
+	if _, err := fmt.Fprintln(f, filepath.Join(getGitDir(original.Path), "objects")); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("unable to write alternates file: %w", err)
+	}
+	return f.Close()
+}
+
+// unreachableReporter marks the git metadata of the chunks it reports as unreachable.
+type unreachableReporter struct {
+	sources.ChunkReporter
+}
+
+func (r unreachableReporter) ChunkOk(ctx context.Context, chunk sources.Chunk) error {
+	// Chunks of the same file can share their metadata, which may already be in use by earlier chunks.
+	if md := chunk.SourceMetadata.GetGit(); md != nil && !md.Unreachable {
+		md.Unreachable = true
+	}
+	return r.ChunkReporter.ChunkOk(ctx, chunk)
+}
@@ -0,0 +1,247 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// issuesPerPage is the number of issues requested per page of a search, and commentsPerPage the number of comments
+// per page of an issue's comments.
+const (
+	issuesPerPage   = 100
+	commentsPerPage = 100
+)
+
+// issueFields are the fields requested for each issue of a search.
+const issueFields = "summary,description,environment,reporter,updated,attachment"
+
+type account struct {
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+	// Name is the username of Data Center accounts.
+	Name string `json:"name"`
+}
+
+// String returns the account's display name, or else its username.
+func (a account) String() string {
+	if a.DisplayName != "" {
+		return a.DisplayName
+	}
+	return a.Name
+}
+
+type issue struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary     string       `json:"summary"`
+		Description string       `json:"description"`
+		Environment string       `json:"environment"`
+		Reporter    account      `json:"reporter"`
+		Updated     string       `json:"updated"`
+		Attachment  []attachment `json:"attachment"`
+	} `json:"fields"`
+}
+
+type comment struct {
+	ID      string  `json:"id"`
+	Body    string  `json:"body"`
+	Author  account `json:"author"`
+	Updated string  `json:"updated"`
+}
+
+type attachment struct {
+	ID       string  `json:"id"`
+	Filename string  `json:"filename"`
+	Author   account `json:"author"`
+	Created  string  `json:"created"`
+	Size     int64   `json:"size"`
+	// Content is the URL to download the attachment from.
+	Content string `json:"content"`
+}
+
+// client sends requests to the REST API of a Jira Cloud site or Jira Data Center server.
+//
+// https://developer.atlassian.com/cloud/jira/platform/rest/v2/
+// https://developer.atlassian.com/server/jira/platform/rest/
+type client struct {
+	baseURL    string
+	httpClient *http.Client
+	// cloud selects the search API of Jira Cloud, which pages with tokens rather than offsets.
+	cloud bool
+	// authenticate adds the credentials to a request.
+	authenticate func(*http.Request)
+}
+
+// do sends a GET request to a URL, which is either absolute or relative to the base URL, and returns the response if
+// its status is OK. The Accept header is set to accept if it isn't empty.
+func (c *client) do(ctx context.Context, reqURL, accept string) (*http.Response, error) {
+	if !strings.HasPrefix(reqURL, "http://") && !strings.HasPrefix(reqURL, "https://") {
+		reqURL = c.baseURL + reqURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	c.authenticate(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, req.URL.Redacted())
+	}
+	return resp, nil
+}
+
+// get decodes the JSON response to a GET request of an API path into v.
+func (c *client) get(ctx context.Context, path string, query url.Values, v any) error {
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	resp, err := c.do(ctx, path, "application/json")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding response for %s: %w", resp.Request.URL.Redacted(), err)
+	}
+	return nil
+}
+
+// isCloud reports whether the server is a Jira Cloud site.
+func (c *client) isCloud(ctx context.Context) (bool, error) {
+	var info struct {
+		DeploymentType string `json:"deploymentType"`
+	}
+	if err := c.get(ctx, "/rest/api/2/serverInfo", nil, &info); err != nil {
+		return false, err
+	}
+	return info.DeploymentType == "Cloud", nil
+}
+
+// listProjects lists the keys of the projects the credentials can browse.
+func (c *client) listProjects(ctx context.Context) ([]string, error) {
+	var projects []struct {
+		Key string `json:"key"`
+	}
+	if err := c.get(ctx, "/rest/api/2/project", nil, &projects); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(projects))
+	for _, p := range projects {
+		keys = append(keys, p.Key)
+	}
+	return keys, nil
+}
+
+// searchIssues calls visit with each issue matching a JQL query, a page at a time.
+func (c *client) searchIssues(ctx context.Context, jql string, visit func(issue) error) error {
+	if c.cloud {
+		return c.searchIssuesCloud(ctx, jql, visit)
+	}
+
+	for startAt := 0; ; {
+		query := url.Values{
+			"jql":        {jql},
+			"fields":     {issueFields},
+			"startAt":    {strconv.Itoa(startAt)},
+			"maxResults": {strconv.Itoa(issuesPerPage)},
+		}
+		var page struct {
+			Issues []issue `json:"issues"`
+			Total  int     `json:"total"`
+		}
+		if err := c.get(ctx, "/rest/api/2/search", query, &page); err != nil {
+			return err
+		}
+		for _, is := range page.Issues {
+			if err := visit(is); err != nil {
+				return err
+			}
+		}
+		startAt += len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return nil
+		}
+	}
+}
+
+// searchIssuesCloud calls visit with each issue matching a JQL query through the enhanced search API of Jira Cloud.
+func (c *client) searchIssuesCloud(ctx context.Context, jql string, visit func(issue) error) error {
+	var pageToken string
+	for {
+		query := url.Values{
+			"jql":        {jql},
+			"fields":     {issueFields},
+			"maxResults": {strconv.Itoa(issuesPerPage)},
+		}
+		if pageToken != "" {
+			query.Set("nextPageToken", pageToken)
+		}
+		var page struct {
+			Issues        []issue `json:"issues"`
+			NextPageToken string  `json:"nextPageToken"`
+			IsLast        bool    `json:"isLast"`
+		}
+		if err := c.get(ctx, "/rest/api/2/search/jql", query, &page); err != nil {
+			return err
+		}
+		for _, is := range page.Issues {
+			if err := visit(is); err != nil {
+				return err
+			}
+		}
+		if page.IsLast || page.NextPageToken == "" {
+			return nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// listComments lists the comments of an issue.
+func (c *client) listComments(ctx context.Context, issueKey string) ([]comment, error) {
+	var comments []comment
+	for {
+		query := url.Values{
+			"startAt":    {strconv.Itoa(len(comments))},
+			"maxResults": {strconv.Itoa(commentsPerPage)},
+		}
+		var page struct {
+			Comments []comment `json:"comments"`
+			Total    int       `json:"total"`
+		}
+		if err := c.get(ctx, "/rest/api/2/issue/"+url.PathEscape(issueKey)+"/comment", query, &page); err != nil {
+			return nil, err
+		}
+		comments = append(comments, page.Comments...)
+		if len(page.Comments) == 0 || len(comments) >= page.Total {
+			return comments, nil
+		}
+	}
+}
+
+// download returns the content of an attachment.
+func (c *client) download(ctx context.Context, a attachment) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, a.Content, "")
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
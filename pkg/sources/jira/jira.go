@@ -0,0 +1,369 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/roundtripper"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_JIRA
+
+// UnitProject is the kind of the units of the source. Their IDs are project keys.
+const UnitProject sources.SourceUnitKind = "project"
+
+// Locations of the chunks of an issue, recorded in their metadata.
+const (
+	locationIssue      = "issue"
+	locationComment    = "comment"
+	locationAttachment = "attachment"
+)
+
+// Source scans the issues of the projects of a Jira Cloud site or Jira Data Center server: their summary,
+// description and environment, their comments, and optionally their attachments.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	endpoint string
+	client   *client
+
+	projects           []string
+	ignoreProjects     []string
+	jql                string
+	since              time.Time
+	includeAttachments bool
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Jira source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.JIRA
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.endpoint = strings.TrimSuffix(conn.GetEndpoint(), "/")
+	if u, err := url.Parse(s.endpoint); err != nil || u.Host == "" {
+		return fmt.Errorf("invalid endpoint %q", conn.GetEndpoint())
+	}
+
+	var authenticate func(*http.Request)
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.JIRA_BasicAuth:
+		log.RedactGlobally(cred.BasicAuth.GetPassword())
+		authenticate = func(req *http.Request) {
+			req.SetBasicAuth(cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword())
+		}
+	case *sourcespb.JIRA_Token:
+		log.RedactGlobally(cred.Token)
+		authenticate = bearer(cred.Token)
+	case *sourcespb.JIRA_Oauth:
+		log.RedactGlobally(cred.Oauth.GetAccessToken())
+		authenticate = bearer(cred.Oauth.GetAccessToken())
+	case *sourcespb.JIRA_Unauthenticated:
+		authenticate = func(*http.Request) {}
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+
+	opts := []func(*roundtripper.RoundTripper){
+		roundtripper.WithLogger(ctx.Logger()),
+		roundtripper.WithRetryable(),
+	}
+	if conn.GetInsecureSkipVerifyTls() {
+		opts = append(opts, roundtripper.WithInsecureTLS())
+	}
+	s.client = &client{
+		baseURL:      s.endpoint,
+		httpClient:   &http.Client{Transport: roundtripper.NewRoundTripper(nil, opts...)},
+		authenticate: authenticate,
+	}
+
+	switch conn.GetInstallationType() {
+	case sourcespb.JiraInstallationType_JIRA_INSTALLATION_TYPE_CLOUD:
+		s.client.cloud = true
+	case sourcespb.JiraInstallationType_JIRA_INSTALLATION_TYPE_DATA_CENTER:
+	default:
+		cloud, err := s.client.isCloud(ctx)
+		if err != nil {
+			return fmt.Errorf("error detecting the Jira installation type: %w", err)
+		}
+		s.client.cloud = cloud
+	}
+
+	s.projects = conn.GetProjects()
+	s.ignoreProjects = conn.GetIgnoreProjects()
+	s.jql = conn.GetJql()
+	if conn.GetSince() != nil {
+		s.since = conn.GetSince().AsTime()
+	}
+	s.includeAttachments = conn.GetIncludeAttachments()
+
+	return nil
+}
+
+func bearer(token string) func(*http.Request) {
+	return func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var projects []string
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			projects = append(projects, id)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating projects")
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+	// We must sort the projects so we can resume later if necessary.
+	slices.Sort(projects)
+
+	// If there is resume information available, limit this scan to only the projects that still need scanning.
+	projectsToScan, progressIndexOffset := sources.FilterReposToResume(projects, s.GetProgress().EncodedResumeInfo)
+	scanErrs := sources.NewScanErrors()
+	for i, project := range projectsToScan {
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.setProgressCompleteWithProject(i, progressIndexOffset, len(projectsToScan), project)
+			// Ensure the project is removed from the resume info after being scanned.
+			defer func() {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, project)
+			}()
+
+			unit := sources.CommonSourceUnit{Kind: UnitProject, ID: project}
+			if err := s.ChunkUnit(ctx, unit, sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(projectsToScan), len(projectsToScan), "Completed Jira scan", "")
+	return nil
+}
+
+// setProgressCompleteWithProject calls the s.SetProgressComplete after safely setting up the encoded resume info
+// string.
+func (s *Source) setProgressCompleteWithProject(index, offset, total int, project string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	s.resumeInfoSlice = append(s.resumeInfoSlice, project)
+	slices.Sort(s.resumeInfoSlice)
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	s.SetProgressComplete(index+offset, total+offset, fmt.Sprintf("Project: %s", project), encodedResumeInfo)
+}
+
+// Enumerate reports the configured projects, or else every project the credentials can browse, except the ignored
+// ones, to the reporter. Units are identified by project key.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	projects := s.projects
+	if len(projects) == 0 {
+		var err error
+		if projects, err = s.client.listProjects(ctx); err != nil {
+			return fmt.Errorf("error listing projects: %w", err)
+		}
+		ctx.Logger().Info("enumerated Jira projects", "count", len(projects))
+	}
+	for _, project := range projects {
+		if slices.ContainsFunc(s.ignoreProjects, func(ignored string) bool { return strings.EqualFold(ignored, project) }) {
+			ctx.Logger().V(3).Info("skipping project", "project", project, "reason", "ignored in config")
+			continue
+		}
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitProject, ID: project}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit chunks the issues of a project matching the configured JQL query and updated since the configured time,
+// along with their comments and attachments.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	project, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "project", project)
+
+	var count int
+	err := s.client.searchIssues(ctx, issueQuery(project, s.jql, s.since), func(is issue) error {
+		count++
+		if err := s.scanIssue(ctx, is, reporter); err != nil {
+			return reporter.ChunkErr(ctx, fmt.Errorf("error scanning issue %s: %w", is.Key, err))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error searching the issues of %s: %w", project, err)
+	}
+	ctx.Logger().V(2).Info("scanned issues", "count", count)
+	return nil
+}
+
+// issueQuery returns the JQL query of the issues of a project to scan. The configured query mustn't have an ORDER BY
+// clause since it's combined with the others.
+func issueQuery(project, jql string, since time.Time) string {
+	clauses := []string{fmt.Sprintf("project = %q", project)}
+	if jql != "" {
+		clauses = append(clauses, "("+jql+")")
+	}
+	if !since.IsZero() {
+		// JQL dates have a precision of minutes, so round down to not miss any issue.
+		clauses = append(clauses, fmt.Sprintf("updated >= %q", since.UTC().Format("2006/01/02 15:04")))
+	}
+	return strings.Join(clauses, " AND ") + " ORDER BY key ASC"
+}
+
+// scanIssue chunks the text fields, the comments, and the attachments if they're included, of an issue.
+func (s *Source) scanIssue(ctx context.Context, is issue, reporter sources.ChunkReporter) error {
+	link := s.endpoint + "/browse/" + is.Key
+	text := strings.Join([]string{is.Fields.Summary, is.Fields.Description, is.Fields.Environment}, "\n")
+	chunk := s.chunk(&source_metadatapb.Jira{
+		Issue:     sanitizer.UTF8(is.Key),
+		Author:    sanitizer.UTF8(is.Fields.Reporter.String()),
+		Email:     sanitizer.UTF8(is.Fields.Reporter.EmailAddress),
+		Timestamp: sanitizer.UTF8(is.Fields.Updated),
+		Location:  locationIssue,
+		Link:      link,
+	})
+	chunk.Data = []byte(text)
+	if err := reporter.ChunkOk(ctx, *chunk); err != nil {
+		return err
+	}
+
+	comments, err := s.client.listComments(ctx, is.Key)
+	if err != nil {
+		return fmt.Errorf("error listing comments: %w", err)
+	}
+	for _, c := range comments {
+		chunk := s.chunk(&source_metadatapb.Jira{
+			Issue:     sanitizer.UTF8(is.Key),
+			Author:    sanitizer.UTF8(c.Author.String()),
+			Email:     sanitizer.UTF8(c.Author.EmailAddress),
+			Timestamp: sanitizer.UTF8(c.Updated),
+			Location:  locationComment,
+			Link:      link + "?focusedCommentId=" + url.QueryEscape(c.ID),
+		})
+		chunk.Data = []byte(c.Body)
+		if err := reporter.ChunkOk(ctx, *chunk); err != nil {
+			return err
+		}
+	}
+
+	if !s.includeAttachments {
+		return nil
+	}
+	for _, a := range is.Fields.Attachment {
+		if err := s.scanAttachment(ctx, is, a, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("error scanning attachment %s of issue %s: %w", a.Filename, is.Key, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanAttachment downloads an attachment and chunks it with the file handlers, so archives and documents are
+// extracted.
+func (s *Source) scanAttachment(ctx context.Context, is issue, a attachment, reporter sources.ChunkReporter) error {
+	body, err := s.client.download(ctx, a)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	// The attachment's link ends with its file name, which the file handlers take its extension from.
+	link := a.Content
+	if strings.HasPrefix(link, "/") {
+		link = s.endpoint + link
+	}
+	chunkSkel := s.chunk(&source_metadatapb.Jira{
+		Issue:     sanitizer.UTF8(is.Key),
+		Author:    sanitizer.UTF8(a.Author.String()),
+		Email:     sanitizer.UTF8(a.Author.EmailAddress),
+		Timestamp: sanitizer.UTF8(a.Created),
+		Location:  locationAttachment,
+		Link:      sanitizer.UTF8(link),
+	})
+	return handlers.HandleFile(ctx, body, chunkSkel, reporter)
+}
+
+// chunk returns a chunk of the source with metadata.
+func (s *Source) chunk(metadata *source_metadatapb.Jira) *sources.Chunk {
+	return &sources.Chunk{
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		JobID:          s.JobID(),
+		SourceType:     s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Jira{Jira: metadata}},
+		Verify:         s.verify,
+	}
+}
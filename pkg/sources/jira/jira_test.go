@@ -0,0 +1,146 @@
+package jira
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+// jiraStandIn serves a stand-in of the REST API of a Jira Data Center server. Responses are keyed by path, and by
+// the JQL query for searches.
+func jiraStandIn(t *testing.T, api map[string]any) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "api-token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		key := r.URL.Path
+		if jql := r.URL.Query().Get("jql"); jql != "" {
+			key += "?jql=" + jql
+		}
+		resp, ok := api[key]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if content, ok := resp.(string); ok {
+			_, _ = w.Write([]byte(content))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSource_Chunks(t *testing.T) {
+	reporter := map[string]any{"displayName": "Jane Doe", "emailAddress": "jane@example.com"}
+	server := jiraStandIn(t, map[string]any{
+		"/rest/api/2/serverInfo": map[string]any{"deploymentType": "Server"},
+		"/rest/api/2/project":    []any{map[string]any{"key": "OPS"}, map[string]any{"key": "ARCHIVE"}},
+		`/rest/api/2/search?jql=project = "OPS" AND (labels = incident) AND updated >= "2024/01/02 03:04" ORDER BY key ASC`: map[string]any{
+			"total": 1,
+			"issues": []any{map[string]any{
+				"key": "OPS-1",
+				"fields": map[string]any{
+					"summary":     "Deploy fails",
+					"description": "logs below",
+					"reporter":    reporter,
+					"updated":     "2024-01-02T03:04:05.000+0000",
+					"attachment": []any{map[string]any{
+						"id": "10", "filename": "deploy.log", "author": reporter, "created": "2024-01-02T03:04:05.000+0000",
+						"content": "/secure/attachment/10/deploy.log",
+					}},
+				},
+			}},
+		},
+		"/rest/api/2/issue/OPS-1/comment": map[string]any{
+			"total": 1,
+			"comments": []any{
+				map[string]any{"id": "100", "body": "try this token", "author": reporter, "updated": "2024-01-03T00:00:00.000+0000"},
+			},
+		},
+		"/secure/attachment/10/deploy.log": "deploying with password hunter2\n",
+	})
+
+	conn, err := anypb.New(&sourcespb.JIRA{
+		Endpoint: server.URL + "/",
+		Credential: &sourcespb.JIRA_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{Username: "user", Password: "api-token"},
+		},
+		IgnoreProjects:     []string{"archive"},
+		Jql:                "labels = incident",
+		Since:              timestamppb.New(time.Date(2024, 1, 2, 3, 4, 59, 0, time.UTC)),
+		IncludeAttachments: true,
+	})
+	require.NoError(t, err)
+	s := &Source{}
+	ctx := context.Background()
+	require.NoError(t, s.Init(ctx, "test jira", 0, 0, false, conn, 1))
+	assert.False(t, s.client.cloud)
+
+	units := &sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(ctx, units))
+	require.Equal(t, []sources.SourceUnit{sources.CommonSourceUnit{Kind: UnitProject, ID: "OPS"}}, units.Units)
+
+	chunks := &sourcestest.TestReporter{}
+	require.NoError(t, s.ChunkUnit(ctx, units.Units[0], chunks))
+	require.Empty(t, chunks.ChunkErrs)
+	require.Len(t, chunks.Chunks, 3)
+
+	var metadata []*source_metadatapb.Jira
+	var data []string
+	for _, chunk := range chunks.Chunks {
+		metadata = append(metadata, chunk.SourceMetadata.GetJira())
+		data = append(data, string(chunk.Data))
+	}
+	assert.Equal(t, []string{"Deploy fails\nlogs below\n", "try this token", "deploying with password hunter2\n"}, data)
+	assert.Equal(t, &source_metadatapb.Jira{
+		Issue: "OPS-1", Author: "Jane Doe", Email: "jane@example.com", Timestamp: "2024-01-02T03:04:05.000+0000",
+		Location: "issue", Link: server.URL + "/browse/OPS-1",
+	}, metadata[0])
+	assert.Equal(t, "comment", metadata[1].GetLocation())
+	assert.Equal(t, server.URL+"/browse/OPS-1?focusedCommentId=100", metadata[1].GetLink())
+	assert.Equal(t, "attachment", metadata[2].GetLocation())
+	assert.Equal(t, server.URL+"/secure/attachment/10/deploy.log", metadata[2].GetLink())
+}
+
+func TestClient_searchIssuesCloud(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/rest/api/2/search/jql", r.URL.Path)
+		assert.Equal(t, "Bearer pat", r.Header.Get("Authorization"))
+		switch r.URL.Query().Get("nextPageToken") {
+		case "":
+			_, _ = w.Write([]byte(`{"issues": [{"key": "OPS-1"}], "nextPageToken": "page2"}`))
+		case "page2":
+			_, _ = w.Write([]byte(`{"issues": [{"key": "OPS-2"}], "isLast": true}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	c := &client{baseURL: server.URL, httpClient: server.Client(), cloud: true, authenticate: bearer("pat")}
+	var keys []string
+	err := c.searchIssues(context.Background(), `project = "OPS"`, func(is issue) error {
+		keys = append(keys, is.Key)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"OPS-1", "OPS-2"}, keys)
+}
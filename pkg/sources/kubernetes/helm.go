@@ -0,0 +1,68 @@
+package kubernetes
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// helmReleaseSecretType is the type of the Secrets Helm stores its releases in.
+const helmReleaseSecretType = "helm.sh/release.v1"
+
+// helmRelease holds the parts of a Helm release which carry user supplied values and rendered objects.
+type helmRelease struct {
+	Name    string `json:"name"`
+	Version int    `json:"version"`
+	// Config are the values supplied when installing or upgrading the release.
+	Config map[string]any `json:"config"`
+	// Manifest is the rendered manifest of the objects of the release, Secrets included.
+	Manifest string `json:"manifest"`
+	Chart    struct {
+		// Values are the default values of the chart.
+		Values map[string]any `json:"values"`
+	} `json:"chart"`
+}
+
+// decodeHelmRelease decodes the release stored by Helm in the "release" key of a Secret or ConfigMap, which is the
+// base64 encoding of the gzipped JSON of the release.
+//
+// https://github.com/helm/helm/blob/main/pkg/storage/driver/util.go
+func decodeHelmRelease(data []byte) (*helmRelease, error) {
+	decoded, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding base64: %w", err)
+	}
+	if bytes.HasPrefix(decoded, []byte{0x1f, 0x8b}) {
+		gr, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing: %w", err)
+		}
+		defer gr.Close()
+		if decoded, err = io.ReadAll(gr); err != nil {
+			return nil, fmt.Errorf("error decompressing: %w", err)
+		}
+	}
+	var release helmRelease
+	if err := json.Unmarshal(decoded, &release); err != nil {
+		return nil, fmt.Errorf("error decoding JSON: %w", err)
+	}
+	return &release, nil
+}
+
+// fields returns the parts of the release to scan, keyed by their path within the decoded release.
+func (r *helmRelease) fields() map[string][]byte {
+	fields := make(map[string][]byte, 3)
+	if len(r.Config) > 0 {
+		fields["config"], _ = json.MarshalIndent(r.Config, "", "  ")
+	}
+	if r.Manifest != "" {
+		fields["manifest"] = []byte(r.Manifest)
+	}
+	if len(r.Chart.Values) > 0 {
+		fields["chart.values"], _ = json.MarshalIndent(r.Chart.Values, "", "  ")
+	}
+	return fields
+}
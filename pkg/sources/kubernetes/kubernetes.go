@@ -0,0 +1,506 @@
+package kubernetes
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_KUBERNETES
+
+// UnitNamespace is the kind of the units of the source. Their IDs are namespace names.
+const UnitNamespace sources.SourceUnitKind = "namespace"
+
+// pageSize is the number of objects listed per request.
+const pageSize = 500
+
+// Source scans the objects of a Kubernetes cluster where credentials end up outside of Secrets: ConfigMaps, the
+// specs of Pods, Deployments and CronJobs, and the values of Helm releases. Secrets themselves are optionally scanned.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	client k8s.Interface
+	// cluster is the name of the kubeconfig context used, which findings are attributed to.
+	cluster string
+
+	namespaces       []string
+	ignoreNamespaces []string
+	includeSecrets   bool
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Kubernetes source.
+func (s *Source) Init(_ context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.Kubernetes
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	config, cluster, err := restConfig(&conn)
+	if err != nil {
+		return fmt.Errorf("error loading Kubernetes configuration: %w", err)
+	}
+	if s.client, err = k8s.NewForConfig(config); err != nil {
+		return fmt.Errorf("error creating Kubernetes client: %w", err)
+	}
+	s.cluster = cluster
+
+	s.namespaces = conn.GetNamespaces()
+	s.ignoreNamespaces = conn.GetIgnoreNamespaces()
+	s.includeSecrets = conn.GetIncludeSecrets()
+	return nil
+}
+
+// restConfig returns the configuration of the client and the name of the cluster, which is the name of the kubeconfig
+// context, or "in-cluster" with the service account of the pod TruffleHog runs in.
+func restConfig(conn *sourcespb.Kubernetes) (*rest.Config, string, error) {
+	if conn.GetInCluster() {
+		config, err := rest.InClusterConfig()
+		return config, "in-cluster", err
+	}
+
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = conn.GetKubeconfig()
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{
+		CurrentContext: conn.GetContext(),
+	})
+	raw, err := clientConfig.RawConfig()
+	if err != nil {
+		return nil, "", err
+	}
+	cluster := conn.GetContext()
+	if cluster == "" {
+		cluster = raw.CurrentContext
+	}
+	config, err := clientConfig.ClientConfig()
+	return config, cluster, err
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var namespaces []string
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			namespaces = append(namespaces, id)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating namespaces")
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+	// We must sort the namespaces so we can resume later if necessary.
+	slices.Sort(namespaces)
+
+	// If there is resume information available, limit this scan to only the namespaces that still need scanning.
+	namespacesToScan, progressIndexOffset := sources.FilterReposToResume(namespaces, s.GetProgress().EncodedResumeInfo)
+	scanErrs := sources.NewScanErrors()
+	for i, namespace := range namespacesToScan {
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.setProgressCompleteWithNamespace(i, progressIndexOffset, len(namespacesToScan), namespace)
+			// Ensure the namespace is removed from the resume info after being scanned.
+			defer func() {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, namespace)
+			}()
+
+			unit := sources.CommonSourceUnit{Kind: UnitNamespace, ID: namespace}
+			if err := s.ChunkUnit(ctx, unit, sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(namespacesToScan), len(namespacesToScan), "Completed Kubernetes scan", "")
+	return nil
+}
+
+// setProgressCompleteWithNamespace calls the s.SetProgressComplete after safely setting up the encoded resume info
+// string.
+func (s *Source) setProgressCompleteWithNamespace(index, offset, total int, namespace string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	s.resumeInfoSlice = append(s.resumeInfoSlice, namespace)
+	slices.Sort(s.resumeInfoSlice)
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	s.SetProgressComplete(index+offset, total+offset, fmt.Sprintf("Namespace: %s", namespace), encodedResumeInfo)
+}
+
+// Enumerate reports the configured namespaces, or else every namespace which isn't ignored, to the reporter. Units
+// are identified by namespace name.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	namespaces := s.namespaces
+	if len(namespaces) == 0 {
+		list, err := listAll(ctx, func(ctx context.Context, opts metav1.ListOptions) ([]corev1.Namespace, string, error) {
+			l, err := s.client.CoreV1().Namespaces().List(ctx, opts)
+			if err != nil {
+				return nil, "", err
+			}
+			return l.Items, l.Continue, nil
+		})
+		if err != nil {
+			return fmt.Errorf("error listing namespaces: %w", err)
+		}
+		for _, namespace := range list {
+			if !slices.Contains(s.ignoreNamespaces, namespace.Name) {
+				namespaces = append(namespaces, namespace.Name)
+			}
+		}
+		ctx.Logger().Info("enumerated Kubernetes namespaces", "count", len(namespaces))
+	}
+	for _, namespace := range namespaces {
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitNamespace, ID: namespace}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit chunks the objects of a namespace. Errors listing a kind of object, such as when RBAC forbids it, are
+// reported without stopping the other kinds from being scanned.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	namespace, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "namespace", namespace)
+
+	scanners := []struct {
+		kind string
+		scan func(context.Context, string) ([]object, error)
+	}{
+		{"ConfigMap", s.configMaps},
+		{"Secret", s.secrets},
+		{"Pod", s.pods},
+		{"Deployment", s.deployments},
+		{"CronJob", s.cronJobs},
+	}
+	for _, scanner := range scanners {
+		objects, err := scanner.scan(ctx, namespace)
+		if err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("error listing %s objects in namespace %s: %w", scanner.kind, namespace, err)); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, obj := range objects {
+			if err := s.scanObject(ctx, namespace, obj, reporter); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// object is an object of the cluster, reduced to the fields to scan.
+type object struct {
+	kind string
+	name string
+	// fields are the values to scan, keyed by their field path within the object.
+	fields map[string][]byte
+}
+
+// scanObject chunks the fields of an object, in the order of their paths.
+func (s *Source) scanObject(ctx context.Context, namespace string, obj object, reporter sources.ChunkReporter) error {
+	paths := make([]string, 0, len(obj.fields))
+	for path, data := range obj.fields {
+		if len(bytes.TrimSpace(data)) > 0 {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		chunkSkel := &sources.Chunk{
+			SourceName: s.name,
+			SourceID:   s.SourceID(),
+			JobID:      s.JobID(),
+			SourceType: s.Type(),
+			SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Kubernetes{
+				Kubernetes: &source_metadatapb.Kubernetes{
+					Cluster:   sanitizer.UTF8(s.cluster),
+					Namespace: sanitizer.UTF8(namespace),
+					Kind:      obj.kind,
+					Name:      sanitizer.UTF8(obj.name),
+					FieldPath: sanitizer.UTF8(path),
+				},
+			}},
+			Verify: s.verify,
+		}
+		if err := handlers.HandleFile(ctx, bytes.NewReader(obj.fields[path]), chunkSkel, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("error scanning %s %s/%s %s: %w", obj.kind, namespace, obj.name, path, err)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// configMaps returns the data and annotations of the ConfigMaps of a namespace. Helm releases stored in ConfigMaps
+// are decoded.
+func (s *Source) configMaps(ctx context.Context, namespace string) ([]object, error) {
+	list, err := listAll(ctx, func(ctx context.Context, opts metav1.ListOptions) ([]corev1.ConfigMap, string, error) {
+		l, err := s.client.CoreV1().ConfigMaps(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return l.Items, l.Continue, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]object, 0, len(list))
+	for _, cm := range list {
+		fields := annotations(cm.ObjectMeta, "metadata")
+		for key, value := range cm.Data {
+			fields["data["+key+"]"] = []byte(value)
+		}
+		for key, value := range cm.BinaryData {
+			fields["binaryData["+key+"]"] = value
+		}
+		if release, ok := cm.Data["release"]; ok && cm.Labels["owner"] == "helm" {
+			s.addHelmRelease(ctx, fields, []byte(release))
+		}
+		objects = append(objects, object{kind: "ConfigMap", name: cm.Name, fields: fields})
+	}
+	return objects, nil
+}
+
+// secrets returns the Helm releases of a namespace, and the data and annotations of its other Secrets if they are
+// included.
+func (s *Source) secrets(ctx context.Context, namespace string) ([]object, error) {
+	opts := metav1.ListOptions{}
+	if !s.includeSecrets {
+		opts.FieldSelector = "type=" + helmReleaseSecretType
+	}
+	list, err := listAll(ctx, func(ctx context.Context, page metav1.ListOptions) ([]corev1.Secret, string, error) {
+		page.FieldSelector = opts.FieldSelector
+		l, err := s.client.CoreV1().Secrets(namespace).List(ctx, page)
+		if err != nil {
+			return nil, "", err
+		}
+		return l.Items, l.Continue, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]object, 0, len(list))
+	for _, secret := range list {
+		fields := make(map[string][]byte)
+		if secret.Type == helmReleaseSecretType {
+			s.addHelmRelease(ctx, fields, secret.Data["release"])
+		} else if s.includeSecrets {
+			fields = annotations(secret.ObjectMeta, "metadata")
+			for key, value := range secret.Data {
+				fields["data["+key+"]"] = value
+			}
+		}
+		objects = append(objects, object{kind: "Secret", name: secret.Name, fields: fields})
+	}
+	return objects, nil
+}
+
+// addHelmRelease adds the values and manifest of a Helm release to the fields of the object it's stored in.
+func (s *Source) addHelmRelease(ctx context.Context, fields map[string][]byte, data []byte) {
+	release, err := decodeHelmRelease(data)
+	if err != nil {
+		ctx.Logger().V(2).Info("skipping Helm release", "error", err)
+		return
+	}
+	delete(fields, "data[release]")
+	for path, value := range release.fields() {
+		fields["data[release]."+path] = value
+	}
+}
+
+// pods returns the specs and annotations of the Pods of a namespace, except those of ReplicaSets, which are scanned
+// through their Deployments.
+func (s *Source) pods(ctx context.Context, namespace string) ([]object, error) {
+	list, err := listAll(ctx, func(ctx context.Context, opts metav1.ListOptions) ([]corev1.Pod, string, error) {
+		l, err := s.client.CoreV1().Pods(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return l.Items, l.Continue, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]object, 0, len(list))
+	for _, pod := range list {
+		if owner := metav1.GetControllerOf(&pod); owner != nil && owner.Kind == "ReplicaSet" {
+			continue
+		}
+		fields := annotations(pod.ObjectMeta, "metadata")
+		addPodSpec(fields, pod.Spec, "spec")
+		objects = append(objects, object{kind: "Pod", name: pod.Name, fields: fields})
+	}
+	return objects, nil
+}
+
+// deployments returns the pod templates and annotations of the Deployments of a namespace.
+func (s *Source) deployments(ctx context.Context, namespace string) ([]object, error) {
+	list, err := listAll(ctx, func(ctx context.Context, opts metav1.ListOptions) ([]appsv1.Deployment, string, error) {
+		l, err := s.client.AppsV1().Deployments(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return l.Items, l.Continue, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]object, 0, len(list))
+	for _, deployment := range list {
+		fields := annotations(deployment.ObjectMeta, "metadata")
+		addPodTemplate(fields, deployment.Spec.Template, "spec.template")
+		objects = append(objects, object{kind: "Deployment", name: deployment.Name, fields: fields})
+	}
+	return objects, nil
+}
+
+// cronJobs returns the pod templates and annotations of the CronJobs of a namespace.
+func (s *Source) cronJobs(ctx context.Context, namespace string) ([]object, error) {
+	list, err := listAll(ctx, func(ctx context.Context, opts metav1.ListOptions) ([]batchv1.CronJob, string, error) {
+		l, err := s.client.BatchV1().CronJobs(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		return l.Items, l.Continue, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]object, 0, len(list))
+	for _, cronJob := range list {
+		fields := annotations(cronJob.ObjectMeta, "metadata")
+		addPodTemplate(fields, cronJob.Spec.JobTemplate.Spec.Template, "spec.jobTemplate.spec.template")
+		objects = append(objects, object{kind: "CronJob", name: cronJob.Name, fields: fields})
+	}
+	return objects, nil
+}
+
+// listAll lists the objects of every page, passing the continue token of each page to the request of the next one.
+func listAll[T any](ctx context.Context, list func(context.Context, metav1.ListOptions) ([]T, string, error)) ([]T, error) {
+	var all []T
+	opts := metav1.ListOptions{Limit: pageSize}
+	for {
+		items, next, err := list(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if next == "" {
+			return all, nil
+		}
+		opts.Continue = next
+	}
+}
+
+// annotations returns the annotations of an object as fields, which include the last applied configuration kubectl
+// records, so the whole manifest is scanned even for fields this source doesn't know about.
+func annotations(meta metav1.ObjectMeta, path string) map[string][]byte {
+	fields := make(map[string][]byte, len(meta.Annotations))
+	for key, value := range meta.Annotations {
+		fields[path+".annotations["+key+"]"] = []byte(value)
+	}
+	return fields
+}
+
+// addPodTemplate adds the annotations and spec of a pod template to fields.
+func addPodTemplate(fields map[string][]byte, template corev1.PodTemplateSpec, path string) {
+	for key, value := range annotations(template.ObjectMeta, path+".metadata") {
+		fields[key] = value
+	}
+	addPodSpec(fields, template.Spec, path+".spec")
+}
+
+// addPodSpec adds the literal environment variables, commands and arguments of the containers of a pod spec to fields.
+// Environment variables are scanned as NAME=value, so detectors relying on keywords such as "password" see the name.
+func addPodSpec(fields map[string][]byte, spec corev1.PodSpec, path string) {
+	addContainers := func(containers []corev1.Container, path string) {
+		for _, c := range containers {
+			containerPath := path + "[" + c.Name + "]"
+			for _, env := range c.Env {
+				if env.Value != "" {
+					fields[containerPath+".env["+env.Name+"].value"] = []byte(env.Name + "=" + env.Value)
+				}
+			}
+			if len(c.Command) > 0 {
+				fields[containerPath+".command"] = []byte(strings.Join(c.Command, "\n"))
+			}
+			if len(c.Args) > 0 {
+				fields[containerPath+".args"] = []byte(strings.Join(c.Args, "\n"))
+			}
+		}
+	}
+	addContainers(spec.InitContainers, path+".initContainers")
+	addContainers(spec.Containers, path+".containers")
+}
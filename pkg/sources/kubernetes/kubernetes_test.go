@@ -0,0 +1,182 @@
+package kubernetes
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+func helmPayload(t *testing.T, release map[string]any) []byte {
+	t.Helper()
+	data, err := json.Marshal(release)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, err = gw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gw.Close())
+	return []byte(base64.StdEncoding.EncodeToString(buf.Bytes()))
+}
+
+func podSpec(env ...corev1.EnvVar) corev1.PodSpec {
+	return corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Env: env}}}
+}
+
+func TestSource_Chunks(t *testing.T) {
+	helmSecret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "sh.helm.release.v1.api.v2"},
+		Type:       helmReleaseSecretType,
+		Data: map[string][]byte{"release": helmPayload(t, map[string]any{
+			"name":    "api",
+			"version": 2,
+			"config":  map[string]any{"database": map[string]any{"password": "helm-password"}},
+		})},
+	}
+	opaqueSecret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "api-token"},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{"token": []byte("opaque-token")},
+	}
+	objects := map[string]any{
+		"/api/v1/namespaces": corev1.NamespaceList{Items: []corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "prod"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+		}},
+		"/api/v1/namespaces/prod/configmaps": corev1.ConfigMapList{Items: []corev1.ConfigMap{{
+			ObjectMeta: metav1.ObjectMeta{Name: "api-config", Annotations: map[string]string{"owner": "payments"}},
+			Data:       map[string]string{"app.env": "DB_PASSWORD=configmap-password"},
+		}}},
+		"/api/v1/namespaces/prod/pods": corev1.PodList{Items: []corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: "debug"}, Spec: podSpec(corev1.EnvVar{Name: "TOKEN", Value: "pod-token"})},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "api-5d9f-x2", OwnerReferences: []metav1.OwnerReference{
+					{Kind: "ReplicaSet", Name: "api-5d9f", Controller: &[]bool{true}[0]},
+				}},
+				Spec: podSpec(corev1.EnvVar{Name: "TOKEN", Value: "duplicate"}),
+			},
+		}},
+		"/apis/apps/v1/namespaces/prod/deployments": appsv1.DeploymentList{Items: []appsv1.Deployment{{
+			ObjectMeta: metav1.ObjectMeta{Name: "api"},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: podSpec(
+				corev1.EnvVar{Name: "API_KEY", Value: "deployment-key"},
+				corev1.EnvVar{Name: "FROM_SECRET", ValueFrom: &corev1.EnvVarSource{}},
+			)}},
+		}}},
+		"/apis/batch/v1/namespaces/prod/cronjobs": batchv1.CronJobList{Items: []batchv1.CronJob{{
+			ObjectMeta: metav1.ObjectMeta{Name: "backup"},
+			Spec: batchv1.CronJobSpec{JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{
+					{Name: "backup", Args: []string{"--password", "cronjob-password"}},
+				}}},
+			}}},
+		}}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/namespaces/prod/secrets" {
+			list := corev1.SecretList{Items: []corev1.Secret{helmSecret, opaqueSecret}}
+			if r.URL.Query().Get("fieldSelector") == "type="+helmReleaseSecretType {
+				list.Items = list.Items[:1]
+			}
+			_ = json.NewEncoder(w).Encode(list)
+			return
+		}
+		list, ok := objects[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	}))
+	defer server.Close()
+
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(kubeconfig, []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+contexts:
+- name: test-context
+  context:
+    cluster: test
+    user: test
+current-context: test-context
+users:
+- name: test
+  user:
+    token: test-token
+`, server.URL)), 0o600))
+
+	scan := func(t *testing.T, includeSecrets bool) []sources.Chunk {
+		conn, err := anypb.New(&sourcespb.Kubernetes{
+			Kubeconfig:       kubeconfig,
+			IgnoreNamespaces: []string{"kube-system"},
+			IncludeSecrets:   includeSecrets,
+		})
+		require.NoError(t, err)
+		s := &Source{}
+		ctx := context.Background()
+		require.NoError(t, s.Init(ctx, "test kubernetes", 0, 0, false, conn, 1))
+
+		units := &sourcestest.TestReporter{}
+		require.NoError(t, s.Enumerate(ctx, units))
+		require.Equal(t, []sources.SourceUnit{sources.CommonSourceUnit{Kind: UnitNamespace, ID: "prod"}}, units.Units)
+
+		chunks := &sourcestest.TestReporter{}
+		require.NoError(t, s.ChunkUnit(ctx, units.Units[0], chunks))
+		require.Empty(t, chunks.ChunkErrs)
+		return chunks.Chunks
+	}
+
+	type found struct {
+		metadata *source_metadatapb.Kubernetes
+		data     string
+	}
+	toFound := func(chunks []sources.Chunk) []found {
+		var got []found
+		for _, chunk := range chunks {
+			got = append(got, found{chunk.SourceMetadata.GetKubernetes(), string(chunk.Data)})
+		}
+		return got
+	}
+	meta := func(kind, name, path string) *source_metadatapb.Kubernetes {
+		return &source_metadatapb.Kubernetes{Cluster: "test-context", Namespace: "prod", Kind: kind, Name: name, FieldPath: path}
+	}
+
+	want := []found{
+		{meta("ConfigMap", "api-config", "data[app.env]"), "DB_PASSWORD=configmap-password"},
+		{meta("ConfigMap", "api-config", "metadata.annotations[owner]"), "payments"},
+		{meta("Secret", "sh.helm.release.v1.api.v2", "data[release].config"), "{\n  \"database\": {\n    \"password\": \"helm-password\"\n  }\n}"},
+		{meta("Pod", "debug", "spec.containers[app].env[TOKEN].value"), "TOKEN=pod-token"},
+		{meta("Deployment", "api", "spec.template.spec.containers[app].env[API_KEY].value"), "API_KEY=deployment-key"},
+		{meta("CronJob", "backup", "spec.jobTemplate.spec.template.spec.containers[backup].args"), "--password\ncronjob-password"},
+	}
+	assert.Equal(t, want, toFound(scan(t, false)))
+
+	withSecrets := toFound(scan(t, true))
+	assert.Contains(t, withSecrets, found{meta("Secret", "api-token", "data[token]"), "opaque-token"})
+	assert.Len(t, withSecrets, len(want)+1)
+}
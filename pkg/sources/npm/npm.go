@@ -0,0 +1,249 @@
+package npm
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/roundtripper"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_NPM_UNAUTHD_PACKAGES
+
+// defaultRegistryURL is the URL of the public npm registry.
+const defaultRegistryURL = "https://registry.npmjs.org"
+
+// UnitPackage is the kind of the units of the source. Their IDs are package names.
+const UnitPackage sources.SourceUnitKind = "package"
+
+// Source scans the tarball of every published version of npm packages, given by name or by scope, so that files
+// which were published by mistake, such as .npmrc and .env files, are found even after later versions left them out.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	registry *registry
+	packages []string
+	scope    string
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized npm source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.NPMUnauthenticatedPackage
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	registryURL := strings.TrimSuffix(cmp.Or(conn.GetRegistryUrl(), defaultRegistryURL), "/")
+	if u, err := url.Parse(registryURL); err != nil || u.Host == "" {
+		return fmt.Errorf("invalid registry URL %q", conn.GetRegistryUrl())
+	}
+	s.registry = &registry{
+		baseURL: registryURL,
+		httpClient: &http.Client{Transport: roundtripper.NewRoundTripper(nil,
+			roundtripper.WithLogger(ctx.Logger()),
+			roundtripper.WithRetryable(),
+		)},
+	}
+
+	s.packages = conn.GetPackages()
+	s.scope = conn.GetScope()
+	if len(s.packages) == 0 && s.scope == "" {
+		return fmt.Errorf("no packages or scope given for source %q (%s)", name, s.Type().String())
+	}
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var packages []string
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			packages = append(packages, id)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating packages")
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+	// We must sort the packages so we can resume later if necessary.
+	slices.Sort(packages)
+
+	// If there is resume information available, limit this scan to only the packages that still need scanning.
+	packagesToScan, progressIndexOffset := sources.FilterReposToResume(packages, s.GetProgress().EncodedResumeInfo)
+	scanErrs := sources.NewScanErrors()
+	for i, pkg := range packagesToScan {
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.setProgressCompleteWithPackage(i, progressIndexOffset, len(packagesToScan), pkg)
+			// Ensure the package is removed from the resume info after being scanned.
+			defer func() {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, pkg)
+			}()
+
+			unit := sources.CommonSourceUnit{Kind: UnitPackage, ID: pkg}
+			if err := s.ChunkUnit(ctx, unit, sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(packagesToScan), len(packagesToScan), "Completed npm scan", "")
+	return nil
+}
+
+// setProgressCompleteWithPackage calls the s.SetProgressComplete after safely setting up the encoded resume info
+// string.
+func (s *Source) setProgressCompleteWithPackage(index, offset, total int, pkg string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	s.resumeInfoSlice = append(s.resumeInfoSlice, pkg)
+	slices.Sort(s.resumeInfoSlice)
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	s.SetProgressComplete(index+offset, total+offset, fmt.Sprintf("Package: %s", pkg), encodedResumeInfo)
+}
+
+// Enumerate reports the configured packages, and the packages of the configured scope, to the reporter. Units are
+// identified by package name.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	packages := slices.Clone(s.packages)
+	if s.scope != "" {
+		scoped, err := s.registry.searchScope(ctx, s.scope)
+		if err != nil {
+			return fmt.Errorf("error searching the packages of scope %s: %w", s.scope, err)
+		}
+		ctx.Logger().Info("enumerated npm packages", "scope", s.scope, "count", len(scoped))
+		packages = append(packages, scoped...)
+	}
+	slices.Sort(packages)
+	for _, pkg := range slices.Compact(packages) {
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitPackage, ID: pkg}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit chunks the tarball of every published version of a package, in the order they were published.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	pkg, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "package", pkg)
+
+	p, err := s.registry.getPackument(ctx, pkg)
+	if err != nil {
+		return fmt.Errorf("error getting package %s: %w", pkg, err)
+	}
+	versions := slices.SortedFunc(maps.Keys(p.Versions), func(a, b string) int {
+		return cmp.Or(cmp.Compare(p.Time[a], p.Time[b]), cmp.Compare(a, b))
+	})
+	for _, version := range versions {
+		if err := s.scanVersion(ctx, pkg, p.Versions[version], reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("error scanning %s@%s: %w", pkg, version, err)); err != nil {
+				return err
+			}
+		}
+	}
+	ctx.Logger().V(2).Info("scanned package versions", "count", len(versions))
+	return nil
+}
+
+// scanVersion downloads the tarball of a version and chunks it with the file handlers, which extract its files.
+func (s *Source) scanVersion(ctx context.Context, pkg string, v packageVersion, reporter sources.ChunkReporter) error {
+	if v.Dist.Tarball == "" {
+		return errors.New("no tarball")
+	}
+	body, err := s.registry.download(ctx, v.Dist.Tarball)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	file := v.Dist.Tarball
+	if u, err := url.Parse(v.Dist.Tarball); err == nil {
+		file = path.Base(u.Path)
+	}
+	chunkSkel := &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		JobID:      s.JobID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Npm{Npm: &source_metadatapb.NPM{
+			File:    sanitizer.UTF8(file),
+			Package: sanitizer.UTF8(pkg),
+			Release: sanitizer.UTF8(v.Version),
+			Link:    sanitizer.UTF8(v.Dist.Tarball),
+			Email:   sanitizer.UTF8(v.NPMUser.Email),
+		}}},
+		Verify: s.verify,
+	}
+	return handlers.HandleFile(ctx, body, chunkSkel, reporter)
+}
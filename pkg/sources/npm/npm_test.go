@@ -0,0 +1,99 @@
+package npm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+// tarball returns a gzipped tarball of files under package/, as npm packs them.
+func tarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "package/" + name, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func TestSource_Chunks(t *testing.T) {
+	tarballs := map[string][]byte{
+		"/@acme/app/-/app-1.0.0.tgz": tarball(t, map[string]string{".npmrc": "//registry.npmjs.org/:_authToken=npm_leaked"}),
+		"/@acme/app/-/app-1.1.0.tgz": tarball(t, map[string]string{"index.js": "module.exports = 1\n"}),
+	}
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/-/v1/search":
+			assert.Equal(t, "scope:acme", r.URL.Query().Get("text"))
+			_, _ = w.Write([]byte(`{"objects": [{"package": {"name": "@acme/app"}}, {"package": {"name": "acme-unrelated"}}], "total": 2}`))
+		case "/@acme/app":
+			// The slash of the scoped name is escaped.
+			assert.Equal(t, "/@acme%2Fapp", r.URL.EscapedPath())
+			version := func(v string) map[string]any {
+				return map[string]any{
+					"version":  v,
+					"dist":     map[string]any{"tarball": server.URL + "/@acme/app/-/app-" + v + ".tgz"},
+					"_npmUser": map[string]any{"name": "jane", "email": "jane@example.com"},
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"name":     "@acme/app",
+				"versions": map[string]any{"1.1.0": version("1.1.0"), "1.0.0": version("1.0.0")},
+				"time":     map[string]any{"1.0.0": "2024-01-01T00:00:00.000Z", "1.1.0": "2024-02-01T00:00:00.000Z"},
+			})
+		default:
+			content, ok := tarballs[r.URL.Path]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			_, _ = w.Write(content)
+		}
+	}))
+	defer server.Close()
+
+	conn, err := anypb.New(&sourcespb.NPMUnauthenticatedPackage{RegistryUrl: server.URL, Scope: "@acme"})
+	require.NoError(t, err)
+	s := &Source{}
+	ctx := context.Background()
+	require.NoError(t, s.Init(ctx, "test npm", 0, 0, false, conn, 1))
+
+	units := &sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(ctx, units))
+	require.Equal(t, []sources.SourceUnit{sources.CommonSourceUnit{Kind: UnitPackage, ID: "@acme/app"}}, units.Units)
+
+	chunks := &sourcestest.TestReporter{}
+	require.NoError(t, s.ChunkUnit(ctx, units.Units[0], chunks))
+	require.Empty(t, chunks.ChunkErrs)
+	require.Len(t, chunks.Chunks, 2)
+
+	first := chunks.Chunks[0].SourceMetadata.GetNpm()
+	assert.Equal(t, "//registry.npmjs.org/:_authToken=npm_leaked", string(chunks.Chunks[0].Data))
+	assert.Equal(t, "@acme/app", first.GetPackage())
+	assert.Equal(t, "1.0.0", first.GetRelease())
+	assert.Equal(t, "app-1.0.0.tgz", first.GetFile())
+	assert.Equal(t, server.URL+"/@acme/app/-/app-1.0.0.tgz", first.GetLink())
+	assert.Equal(t, "jane@example.com", first.GetEmail())
+	assert.Equal(t, "package/.npmrc", first.GetArchiveMember())
+	assert.Equal(t, "1.1.0", chunks.Chunks[1].SourceMetadata.GetNpm().GetRelease())
+}
@@ -0,0 +1,130 @@
+package npm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// searchPageSize is the number of packages requested per page of a search.
+const searchPageSize = 250
+
+// packument is the document of a package in the registry, listing all of its published versions.
+//
+// https://github.com/npm/registry/blob/main/docs/REGISTRY-API.md#getpackage
+type packument struct {
+	Name     string                    `json:"name"`
+	Versions map[string]packageVersion `json:"versions"`
+	// Time maps versions to the time they were published.
+	Time map[string]string `json:"time"`
+}
+
+type packageVersion struct {
+	Version string `json:"version"`
+	Dist    struct {
+		Tarball string `json:"tarball"`
+	} `json:"dist"`
+	// NPMUser is the user who published the version.
+	NPMUser struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"_npmUser"`
+}
+
+// registry sends requests to an npm registry, such as the public registry or a Verdaccio server.
+type registry struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// do sends a GET request to a URL and returns the response if its status is OK.
+func (r *registry) do(ctx context.Context, rawURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, req.URL.Redacted())
+	}
+	return resp, nil
+}
+
+// get decodes the JSON response to a GET request of a registry path into v.
+func (r *registry) get(ctx context.Context, path string, v any) error {
+	resp, err := r.do(ctx, r.baseURL+path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding response for %s: %w", resp.Request.URL.Redacted(), err)
+	}
+	return nil
+}
+
+// getPackument returns the document of a package. The slash of scoped package names is escaped, as registries
+// expect.
+func (r *registry) getPackument(ctx context.Context, name string) (packument, error) {
+	var p packument
+	err := r.get(ctx, "/"+url.PathEscape(name), &p)
+	return p, err
+}
+
+// searchScope lists the names of the packages of a scope, such as @acme.
+//
+// https://github.com/npm/registry/blob/main/docs/REGISTRY-API.md#get-v1search
+func (r *registry) searchScope(ctx context.Context, scope string) ([]string, error) {
+	scope = strings.TrimPrefix(scope, "@")
+	var names []string
+	for from := 0; ; from += searchPageSize {
+		query := url.Values{
+			"text": {"scope:" + scope},
+			"size": {strconv.Itoa(searchPageSize)},
+			"from": {strconv.Itoa(from)},
+		}
+		var page struct {
+			Objects []struct {
+				Package struct {
+					Name string `json:"name"`
+				} `json:"package"`
+			} `json:"objects"`
+			Total int `json:"total"`
+		}
+		if err := r.get(ctx, "/-/v1/search?"+query.Encode(), &page); err != nil {
+			return nil, err
+		}
+		for _, o := range page.Objects {
+			// The search matches the scope's text loosely, so only keep the packages actually in the scope.
+			if strings.HasPrefix(o.Package.Name, "@"+scope+"/") {
+				names = append(names, o.Package.Name)
+			}
+		}
+		if len(page.Objects) < searchPageSize || from+len(page.Objects) >= page.Total {
+			return names, nil
+		}
+	}
+}
+
+// download returns the content of the tarball of a version.
+func (r *registry) download(ctx context.Context, tarball string) (io.ReadCloser, error) {
+	resp, err := r.do(ctx, tarball)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,161 @@
+package pypi
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// link is a link of a page of the simple repository API: a project of the index, or a file of a project.
+type link struct {
+	// Text is the name of the project or of the file.
+	Text string
+	// URL is the absolute URL of the link, without the fragment carrying the file's hash.
+	URL string
+}
+
+// index sends requests to the simple repository API of a package index, such as PyPI or a devpi server.
+//
+// https://packaging.python.org/en/latest/specifications/simple-repository-api/
+type index struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// do sends a GET request to a URL and returns the response if its status is OK.
+func (i *index) do(ctx context.Context, rawURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := i.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, req.URL.Redacted())
+	}
+	return resp, nil
+}
+
+// links returns the links of an HTML page of the API.
+func (i *index) links(ctx context.Context, pageURL string) ([]link, error) {
+	resp, err := i.do(ctx, pageURL)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	var links []link
+	var href string
+	var text strings.Builder
+	inLink := false
+	z := html.NewTokenizer(resp.Body)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if z.Err() == io.EOF {
+				return links, nil
+			}
+			return nil, fmt.Errorf("error parsing %s: %w", resp.Request.URL.Redacted(), z.Err())
+		case html.StartTagToken:
+			if token := z.Token(); token.Data == "a" {
+				inLink, href = true, ""
+				text.Reset()
+				for _, attr := range token.Attr {
+					if attr.Key == "href" {
+						href = attr.Val
+					}
+				}
+			}
+		case html.TextToken:
+			if inLink {
+				text.Write(z.Text())
+			}
+		case html.EndTagToken:
+			if name, _ := z.TagName(); string(name) != "a" || !inLink {
+				continue
+			}
+			inLink = false
+			u, err := resp.Request.URL.Parse(href)
+			if err != nil || href == "" {
+				continue
+			}
+			u.Fragment = ""
+			links = append(links, link{Text: strings.TrimSpace(text.String()), URL: u.String()})
+		}
+	}
+}
+
+// listProjects lists the names of the projects of the index.
+func (i *index) listProjects(ctx context.Context) ([]string, error) {
+	links, err := i.links(ctx, i.baseURL+"/")
+	if err != nil {
+		return nil, err
+	}
+	projects := make([]string, 0, len(links))
+	for _, l := range links {
+		projects = append(projects, l.Text)
+	}
+	return projects, nil
+}
+
+// listFiles lists the files of every release of a project: its wheels and sdists.
+func (i *index) listFiles(ctx context.Context, project string) ([]link, error) {
+	return i.links(ctx, i.baseURL+"/"+url.PathEscape(normalize(project))+"/")
+}
+
+// download returns the content of a file.
+func (i *index) download(ctx context.Context, file link) (io.ReadCloser, error) {
+	resp, err := i.do(ctx, file.URL)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+var separators = regexp.MustCompile(`[-_.]+`)
+
+// normalize normalizes the name of a project as the simple repository API expects.
+//
+// https://packaging.python.org/en/latest/specifications/name-normalization/
+func normalize(project string) string {
+	return strings.ToLower(separators.ReplaceAllString(project, "-"))
+}
+
+// sdistExtensions are the extensions of sdists, including legacy ones.
+var sdistExtensions = []string{".tar.gz", ".tar.bz2", ".tar.xz", ".tgz", ".zip", ".tar"}
+
+// version returns the version of the release a file belongs to, from the file's name. Wheels and eggs are named
+// {name}-{version}-{tags}, where the name has no dash, while sdists are named {name}-{version}, where legacy names may
+// have dashes.
+//
+// https://packaging.python.org/en/latest/specifications/binary-distribution-format/#file-name-convention
+func version(filename string) string {
+	if strings.HasSuffix(filename, ".whl") || strings.HasSuffix(filename, ".egg") {
+		if parts := strings.Split(filename, "-"); len(parts) >= 3 {
+			return parts[1]
+		}
+		return ""
+	}
+	for _, ext := range sdistExtensions {
+		if stem, ok := strings.CutSuffix(filename, ext); ok {
+			if i := strings.LastIndex(stem, "-"); i >= 0 {
+				return stem[i+1:]
+			}
+		}
+	}
+	return ""
+}
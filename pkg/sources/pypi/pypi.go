@@ -0,0 +1,239 @@
+package pypi
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/roundtripper"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_PYPI_UNAUTHD_PACKAGES
+
+// defaultIndexURL is the URL of the simple repository API of PyPI.
+const defaultIndexURL = "https://pypi.org/simple"
+
+// UnitPackage is the kind of the units of the source. Their IDs are package names.
+const UnitPackage sources.SourceUnitKind = "package"
+
+// Source scans the wheels and sdists of every release of Python projects, given by name or as every project of a
+// private index, so that files which were published by mistake, such as .pypirc and .env files, are found even after
+// later releases left them out.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	index       *index
+	packages    []string
+	allPackages bool
+
+	resumeInfoSlice []string
+	resumeInfoMutex sync.Mutex
+	sources.Progress
+
+	jobPool *errgroup.Group
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized PyPI source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.jobPool = &errgroup.Group{}
+	s.jobPool.SetLimit(concurrency)
+
+	var conn sourcespb.PyPIUnauthenticatedPackage
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	indexURL := strings.TrimSuffix(cmp.Or(conn.GetIndexUrl(), defaultIndexURL), "/")
+	if u, err := url.Parse(indexURL); err != nil || u.Host == "" {
+		return fmt.Errorf("invalid index URL %q", conn.GetIndexUrl())
+	}
+	s.index = &index{
+		baseURL: indexURL,
+		httpClient: &http.Client{Transport: roundtripper.NewRoundTripper(nil,
+			roundtripper.WithLogger(ctx.Logger()),
+			roundtripper.WithRetryable(),
+		)},
+	}
+
+	s.packages = conn.GetPackages()
+	s.allPackages = conn.GetAllPackages()
+	if len(s.packages) == 0 && !s.allPackages {
+		return fmt.Errorf("no packages given for source %q (%s)", name, s.Type().String())
+	}
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var packages []string
+	reporter := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			packages = append(packages, id)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating packages")
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, reporter); err != nil {
+		return err
+	}
+	// We must sort the packages so we can resume later if necessary.
+	slices.Sort(packages)
+
+	// If there is resume information available, limit this scan to only the packages that still need scanning.
+	packagesToScan, progressIndexOffset := sources.FilterReposToResume(packages, s.GetProgress().EncodedResumeInfo)
+	scanErrs := sources.NewScanErrors()
+	for i, pkg := range packagesToScan {
+		s.jobPool.Go(func() error {
+			if common.IsDone(ctx) {
+				return nil
+			}
+			s.setProgressCompleteWithPackage(i, progressIndexOffset, len(packagesToScan), pkg)
+			// Ensure the package is removed from the resume info after being scanned.
+			defer func() {
+				s.resumeInfoMutex.Lock()
+				defer s.resumeInfoMutex.Unlock()
+				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, pkg)
+			}()
+
+			unit := sources.CommonSourceUnit{Kind: UnitPackage, ID: pkg}
+			if err := s.ChunkUnit(ctx, unit, sources.ChanReporter{Ch: chunksChan}); err != nil {
+				scanErrs.Add(err)
+			}
+			return nil
+		})
+	}
+	_ = s.jobPool.Wait()
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	s.SetProgressComplete(len(packagesToScan), len(packagesToScan), "Completed PyPI scan", "")
+	return nil
+}
+
+// setProgressCompleteWithPackage calls the s.SetProgressComplete after safely setting up the encoded resume info
+// string.
+func (s *Source) setProgressCompleteWithPackage(index, offset, total int, pkg string) {
+	s.resumeInfoMutex.Lock()
+	defer s.resumeInfoMutex.Unlock()
+
+	s.resumeInfoSlice = append(s.resumeInfoSlice, pkg)
+	slices.Sort(s.resumeInfoSlice)
+	encodedResumeInfo := sources.EncodeResumeInfo(s.resumeInfoSlice)
+
+	s.SetProgressComplete(index+offset, total+offset, fmt.Sprintf("Package: %s", pkg), encodedResumeInfo)
+}
+
+// Enumerate reports the configured packages, or every project of the index if configured to, to the reporter. Units
+// are identified by normalized project name.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	packages := slices.Clone(s.packages)
+	if s.allPackages {
+		projects, err := s.index.listProjects(ctx)
+		if err != nil {
+			return fmt.Errorf("error listing the projects of the index: %w", err)
+		}
+		ctx.Logger().Info("enumerated PyPI projects", "count", len(projects))
+		packages = append(packages, projects...)
+	}
+	for i, pkg := range packages {
+		packages[i] = normalize(pkg)
+	}
+	slices.Sort(packages)
+	for _, pkg := range slices.Compact(packages) {
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitPackage, ID: pkg}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit chunks the files of every release of a project.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	pkg, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "package", pkg)
+
+	files, err := s.index.listFiles(ctx, pkg)
+	if err != nil {
+		return fmt.Errorf("error listing the files of %s: %w", pkg, err)
+	}
+	for _, file := range files {
+		if err := s.scanFile(ctx, pkg, file, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, fmt.Errorf("error scanning %s: %w", file.Text, err)); err != nil {
+				return err
+			}
+		}
+	}
+	ctx.Logger().V(2).Info("scanned package files", "count", len(files))
+	return nil
+}
+
+// scanFile downloads a wheel or sdist and chunks it with the file handlers, which extract its files.
+func (s *Source) scanFile(ctx context.Context, pkg string, file link, reporter sources.ChunkReporter) error {
+	body, err := s.index.download(ctx, file)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		JobID:      s.JobID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Pypi{Pypi: &source_metadatapb.PyPi{
+			File:    sanitizer.UTF8(file.Text),
+			Package: sanitizer.UTF8(pkg),
+			Release: sanitizer.UTF8(version(file.Text)),
+			Link:    sanitizer.UTF8(file.URL),
+		}}},
+		Verify: s.verify,
+	}
+	return handlers.HandleFile(ctx, body, chunkSkel, reporter)
+}
@@ -0,0 +1,112 @@
+package pypi
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+func wheel(t *testing.T, name, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create(name)
+	require.NoError(t, err)
+	_, err = w.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func sdist(t *testing.T, name, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}))
+	_, err := tw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func TestSource_Chunks(t *testing.T) {
+	pages := map[string][]byte{
+		"/root/internal/+simple/": []byte(`<html><body><a href="acme-tools/">Acme_Tools</a></body></html>`),
+		"/root/internal/+simple/acme-tools/": []byte(`<html><body>
+			<a href="../../+f/acme_tools-1.0.0-py3-none-any.whl#sha256=abc">acme_tools-1.0.0-py3-none-any.whl</a>
+			<a href="/root/internal/+f/acme-tools-1.1.0.tar.gz#sha256=def" data-requires-python="&gt;=3.8">acme-tools-1.1.0.tar.gz</a>
+		</body></html>`),
+		"/root/internal/+f/acme_tools-1.0.0-py3-none-any.whl": wheel(t, "acme_tools/.env", "AWS_SECRET_ACCESS_KEY=leaked"),
+		"/root/internal/+f/acme-tools-1.1.0.tar.gz":           sdist(t, "acme-tools-1.1.0/setup.py", "from setuptools import setup\n"),
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := pages[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	conn, err := anypb.New(&sourcespb.PyPIUnauthenticatedPackage{
+		IndexUrl:    server.URL + "/root/internal/+simple",
+		Packages:    []string{"acme.tools"},
+		AllPackages: true,
+	})
+	require.NoError(t, err)
+	s := &Source{}
+	ctx := context.Background()
+	require.NoError(t, s.Init(ctx, "test pypi", 0, 0, false, conn, 1))
+
+	units := &sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(ctx, units))
+	require.Equal(t, []sources.SourceUnit{sources.CommonSourceUnit{Kind: UnitPackage, ID: "acme-tools"}}, units.Units)
+
+	chunks := &sourcestest.TestReporter{}
+	require.NoError(t, s.ChunkUnit(ctx, units.Units[0], chunks))
+	require.Empty(t, chunks.ChunkErrs)
+	require.Len(t, chunks.Chunks, 2)
+
+	assert.Equal(t, "AWS_SECRET_ACCESS_KEY=leaked", string(chunks.Chunks[0].Data))
+	assert.Equal(t, &source_metadatapb.PyPi{
+		File:              "acme_tools-1.0.0-py3-none-any.whl",
+		Package:           "acme-tools",
+		Release:           "1.0.0",
+		Link:              server.URL + "/root/internal/+f/acme_tools-1.0.0-py3-none-any.whl",
+		ArchiveMember:     "acme_tools/.env",
+		ArchiveMemberLine: 1,
+	}, chunks.Chunks[0].SourceMetadata.GetPypi())
+	assert.Equal(t, "1.1.0", chunks.Chunks[1].SourceMetadata.GetPypi().GetRelease())
+	assert.Equal(t, "acme-tools-1.1.0/setup.py", chunks.Chunks[1].SourceMetadata.GetPypi().GetArchiveMember())
+}
+
+func TestVersion(t *testing.T) {
+	tests := map[string]string{
+		"acme_tools-1.0.0-py3-none-any.whl":    "1.0.0",
+		"acme_tools-2.0-1-cp312-abi3-any.whl":  "2.0",
+		"python-dateutil-2.8.2.tar.gz":         "2.8.2",
+		"acme-tools-1.1.0.zip":                 "1.1.0",
+		"acme_tools-0.9-py2.7.egg":             "0.9",
+		"acme_tools-1.0.0-py3-none-any.whl.gz": "",
+	}
+	for filename, want := range tests {
+		assert.Equal(t, want, version(filename), filename)
+	}
+}
@@ -0,0 +1,262 @@
+package slack
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Files listing the users and the conversations of a workspace export. Each conversation has a directory of daily
+// message files, named after the channel, or after the conversation ID for direct messages.
+//
+// https://slack.com/help/articles/220556107-How-to-read-Slack-data-exports
+const (
+	usersFile    = "users.json"
+	channelsFile = "channels.json"
+	groupsFile   = "groups.json"
+	mpimsFile    = "mpims.json"
+	dmsFile      = "dms.json"
+)
+
+type user struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	RealName string `json:"real_name"`
+	Profile  struct {
+		Email string `json:"email"`
+	} `json:"profile"`
+}
+
+// conversation is a public or private channel, a group direct message or a direct message.
+type conversation struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Members     []string `json:"members"`
+	IsExtShared bool     `json:"is_ext_shared"`
+}
+
+type message struct {
+	User     string `json:"user"`
+	Text     string `json:"text"`
+	TS       string `json:"ts"`
+	ThreadTS string `json:"thread_ts"`
+	// Attachments are the unfurled links and the bot attachments of the message.
+	Attachments []struct {
+		Pretext  string `json:"pretext"`
+		Text     string `json:"text"`
+		Fallback string `json:"fallback"`
+	} `json:"attachments"`
+	Files []file `json:"files"`
+}
+
+// file is a file shared in a message. Exports don't include the content of files, but they include the preview of
+// snippets and the text of posts.
+type file struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Title     string `json:"title"`
+	Preview   string `json:"preview"`
+	PlainText string `json:"plain_text"`
+}
+
+// isReply returns whether the message is a reply in a thread, rather than a message of the channel or the parent of
+// a thread.
+func (m message) isReply() bool {
+	return m.ThreadTS != "" && m.ThreadTS != m.TS
+}
+
+// text returns the text of the message along with the text of its attachments.
+func (m message) text() string {
+	parts := []string{m.Text}
+	for _, a := range m.Attachments {
+		parts = append(parts, a.Pretext, a.Text, a.Fallback)
+	}
+	parts = slices.DeleteFunc(parts, func(part string) bool { return part == "" })
+	return strings.Join(parts, "\n")
+}
+
+// content returns the text of a snippet or post shared in a message.
+func (f file) content() string {
+	if f.PlainText != "" {
+		return f.PlainText
+	}
+	return f.Preview
+}
+
+// export is an opened workspace export.
+type export struct {
+	fsys  fs.FS
+	close func() error
+	users map[string]user
+	// conversations maps the directory of each conversation to it.
+	conversations map[string]exportedConversation
+}
+
+// exportedConversation is a conversation of an export with its type.
+type exportedConversation struct {
+	conversation
+	// private is set for private channels, group direct messages and direct messages.
+	private bool
+	// direct is set for direct messages, which have no name.
+	direct bool
+}
+
+// openExport opens a workspace export, either a directory or a zip of it, and reads its users and conversations.
+func openExport(exportPath string) (*export, error) {
+	info, err := os.Stat(exportPath)
+	if err != nil {
+		return nil, err
+	}
+	e := &export{close: func() error { return nil }}
+	if info.IsDir() {
+		e.fsys = os.DirFS(exportPath)
+	} else {
+		zr, err := zip.OpenReader(exportPath)
+		if err != nil {
+			return nil, fmt.Errorf("error opening zip: %w", err)
+		}
+		e.fsys, e.close = zr, zr.Close
+	}
+	if err := e.load(); err != nil {
+		_ = e.close()
+		return nil, err
+	}
+	return e, nil
+}
+
+// load reads the users and conversations of the export. Exports that were re-zipped with their directory are read
+// from that directory.
+func (e *export) load() error {
+	if _, err := fs.Stat(e.fsys, usersFile); errors.Is(err, fs.ErrNotExist) {
+		entries, err := fs.ReadDir(e.fsys, ".")
+		if err != nil {
+			return err
+		}
+		if len(entries) != 1 || !entries[0].IsDir() {
+			return fmt.Errorf("no %s found, not a Slack export", usersFile)
+		}
+		if e.fsys, err = fs.Sub(e.fsys, entries[0].Name()); err != nil {
+			return err
+		}
+	}
+
+	var users []user
+	if err := e.readJSON(usersFile, &users); err != nil {
+		return err
+	}
+	e.users = make(map[string]user, len(users))
+	for _, u := range users {
+		e.users[u.ID] = u
+	}
+
+	e.conversations = make(map[string]exportedConversation)
+	for _, f := range []struct {
+		name            string
+		private, direct bool
+	}{
+		{name: channelsFile},
+		{name: groupsFile, private: true},
+		{name: mpimsFile, private: true},
+		{name: dmsFile, private: true, direct: true},
+	} {
+		var conversations []conversation
+		if err := e.readJSON(f.name, &conversations); errors.Is(err, fs.ErrNotExist) {
+			// Exports only have the conversations their plan and the export's settings include.
+			continue
+		} else if err != nil {
+			return err
+		}
+		for _, c := range conversations {
+			dir := c.Name
+			if f.direct {
+				dir = c.ID
+			}
+			e.conversations[dir] = exportedConversation{conversation: c, private: f.private, direct: f.direct}
+		}
+	}
+	return nil
+}
+
+// readJSON decodes a JSON file of the export into v.
+func (e *export) readJSON(name string, v any) error {
+	f, err := e.fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := json.NewDecoder(f).Decode(v); err != nil {
+		return fmt.Errorf("error decoding %s: %w", name, err)
+	}
+	return nil
+}
+
+// directories returns the sorted directories of the conversations in the export, including those missing from its
+// conversation lists.
+func (e *export) directories() ([]string, error) {
+	entries, err := fs.ReadDir(e.fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	var dirs []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			dirs = append(dirs, entry.Name())
+		}
+	}
+	return dirs, nil
+}
+
+// days returns the sorted daily message files of the conversation in a directory.
+func (e *export) days(dir string) ([]string, error) {
+	return fs.Glob(e.fsys, path.Join(escapeGlob(dir), "*.json"))
+}
+
+// messages reads the messages of a daily message file.
+func (e *export) messages(day string) ([]message, error) {
+	var messages []message
+	err := e.readJSON(day, &messages)
+	return messages, err
+}
+
+// escapeGlob escapes the characters of a name which have a meaning in glob patterns.
+func escapeGlob(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if strings.ContainsRune(`*?[\`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// permalink returns the path of the permalink to a message, relative to the URL of its workspace.
+//
+// https://api.slack.com/methods/chat.getPermalink
+func permalink(channelID string, m message) string {
+	link := "/archives/" + channelID + "/p" + strings.Replace(m.TS, ".", "", 1)
+	if m.isReply() {
+		link += "?thread_ts=" + m.ThreadTS + "&cid=" + channelID
+	}
+	return link
+}
+
+// formatTS formats the timestamp of a message, which is seconds since the epoch with microseconds, as RFC 3339. It
+// returns the timestamp as is if it can't be parsed.
+func formatTS(ts string) string {
+	secs, micros, _ := strings.Cut(ts, ".")
+	s, err := strconv.ParseInt(secs, 10, 64)
+	if err != nil {
+		return ts
+	}
+	us, _ := strconv.ParseInt(micros, 10, 64)
+	return time.Unix(s, us*int64(time.Microsecond)).UTC().Format(time.RFC3339Nano)
+}
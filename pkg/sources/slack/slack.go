@@ -0,0 +1,270 @@
+package slack
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_SLACK
+
+// UnitExport is the kind of the units of the source. Their IDs are the paths of workspace exports.
+const UnitExport sources.SourceUnitKind = "export"
+
+// Source scans Slack workspace exports offline, from their directory or zip: the messages of their public and
+// private channels and direct messages, including thread replies, and the snippets and posts shared in them. Scanning
+// a workspace through the Slack API isn't supported.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	exportPaths []string
+	// workspaceURL is the URL of the exported workspace which permalinks are relative to, if it's known.
+	workspaceURL   string
+	channels       []string
+	ignoreChannels []string
+
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Slack export source.
+func (s *Source) Init(_ context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, _ int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+
+	var conn sourcespb.Slack
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.exportPaths = conn.GetExportPaths()
+	if len(s.exportPaths) == 0 {
+		return fmt.Errorf("no export paths given for source %q (%s), only workspace exports can be scanned", name, s.Type().String())
+	}
+	s.workspaceURL = strings.TrimSuffix(conn.GetEndpoint(), "/")
+	if s.workspaceURL != "" {
+		if u, err := url.Parse(s.workspaceURL); err != nil || u.Host == "" {
+			return fmt.Errorf("invalid endpoint %q", conn.GetEndpoint())
+		}
+	}
+	s.channels = conn.GetChannels()
+	s.ignoreChannels = conn.GetIgnoreList()
+
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	for i, exportPath := range s.exportPaths {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		s.SetProgressComplete(i, len(s.exportPaths), fmt.Sprintf("Export: %s", exportPath), "")
+
+		unit := sources.CommonSourceUnit{Kind: UnitExport, ID: exportPath}
+		if err := s.ChunkUnit(ctx, unit, sources.ChanReporter{Ch: chunksChan}); err != nil {
+			ctx.Logger().Error(err, "error scanning export", "path", exportPath)
+		}
+	}
+	s.SetProgressComplete(len(s.exportPaths), len(s.exportPaths), "Completed Slack export scan", "")
+	return nil
+}
+
+// Enumerate reports the configured exports to the reporter. Units are identified by the path of the export.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	for _, exportPath := range s.exportPaths {
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitExport, ID: exportPath}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit chunks the messages of the conversations of an export, except those filtered out by the configured
+// channels.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	exportPath, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "export", exportPath)
+
+	e, err := openExport(exportPath)
+	if err != nil {
+		return fmt.Errorf("error opening Slack export %s: %w", exportPath, err)
+	}
+	defer e.close()
+
+	dirs, err := e.directories()
+	if err != nil {
+		return fmt.Errorf("error listing the conversations of %s: %w", exportPath, err)
+	}
+	var count int
+	for _, dir := range dirs {
+		c, ok := e.conversations[dir]
+		if !ok {
+			// Conversations missing from the lists are still scanned, named after their directory.
+			c = exportedConversation{conversation: conversation{ID: dir, Name: dir}}
+		}
+		if !s.includeConversation(c) {
+			ctx.Logger().V(3).Info("skipping conversation", "conversation", dir, "reason", "filtered in config")
+			continue
+		}
+		count++
+		if err := s.scanConversation(ctx, e, dir, c, ok, reporter); err != nil {
+			return err
+		}
+	}
+	ctx.Logger().V(2).Info("scanned conversations", "count", count)
+	return nil
+}
+
+// includeConversation returns whether a conversation, matched by name or ID, passes the configured channels.
+func (s *Source) includeConversation(c exportedConversation) bool {
+	matches := func(channel string) bool { return channel == c.ID || strings.EqualFold(channel, c.Name) }
+	if slices.ContainsFunc(s.ignoreChannels, matches) {
+		return false
+	}
+	return len(s.channels) == 0 || slices.ContainsFunc(s.channels, matches)
+}
+
+// scanConversation chunks the messages of a conversation, reading its daily message files in order. The visibility
+// of conversations which aren't listed in the export is unknown.
+func (s *Source) scanConversation(ctx context.Context, e *export, dir string, c exportedConversation, listed bool, reporter sources.ChunkReporter) error {
+	visibility := source_metadatapb.Visibility_public
+	switch {
+	case !listed:
+		visibility = source_metadatapb.Visibility_unknown
+	case c.IsExtShared:
+		visibility = source_metadatapb.Visibility_shared
+	case c.private:
+		visibility = source_metadatapb.Visibility_private
+	}
+	channelName := c.Name
+	if c.direct {
+		channelName = e.directName(c.conversation)
+	}
+
+	days, err := e.days(dir)
+	if err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("error listing the messages of %s: %w", dir, err))
+	}
+	for _, day := range days {
+		messages, err := e.messages(day)
+		if err != nil {
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, m := range messages {
+			if err := s.scanMessage(ctx, e, c, channelName, visibility, m, reporter); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanMessage chunks the text of a message and the snippets and posts shared in it. Their location is the path of
+// the message's permalink.
+func (s *Source) scanMessage(ctx context.Context, e *export, c exportedConversation, channelName string, visibility source_metadatapb.Visibility, m message, reporter sources.ChunkReporter) error {
+	metadata := func() *source_metadatapb.Slack {
+		return &source_metadatapb.Slack{
+			ChannelId:   sanitizer.UTF8(c.ID),
+			ChannelName: sanitizer.UTF8(channelName),
+			Timestamp:   sanitizer.UTF8(formatTS(m.TS)),
+			UserId:      sanitizer.UTF8(m.User),
+			Email:       sanitizer.UTF8(e.users[m.User].Profile.Email),
+			Visibility:  visibility,
+			Location:    sanitizer.UTF8(permalink(c.ID, m)),
+		}
+	}
+
+	if text := m.text(); text != "" {
+		chunk := s.chunk(metadata())
+		chunk.Data = []byte(text)
+		if err := reporter.ChunkOk(ctx, *chunk); err != nil {
+			return err
+		}
+	}
+	for _, f := range m.Files {
+		content := f.content()
+		if content == "" {
+			continue
+		}
+		fileMetadata := metadata()
+		fileMetadata.File = sanitizer.UTF8(cmp.Or(f.Name, f.Title))
+		chunk := s.chunk(fileMetadata)
+		chunk.Data = []byte(content)
+		if err := reporter.ChunkOk(ctx, *chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// directName names a direct message after the usernames of its members.
+func (e *export) directName(c conversation) string {
+	names := make([]string, 0, len(c.Members))
+	for _, member := range c.Members {
+		if u, ok := e.users[member]; ok && u.Name != "" {
+			names = append(names, u.Name)
+		} else {
+			names = append(names, member)
+		}
+	}
+	if len(names) == 0 {
+		return c.ID
+	}
+	return strings.Join(names, ", ")
+}
+
+// chunk returns a chunk of the source with metadata, linking to the permalink of its location if the workspace's URL
+// is known.
+func (s *Source) chunk(metadata *source_metadatapb.Slack) *sources.Chunk {
+	if s.workspaceURL != "" {
+		metadata.Link = s.workspaceURL + metadata.Location
+	}
+	return &sources.Chunk{
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		JobID:          s.JobID(),
+		SourceType:     s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Slack{Slack: metadata}},
+		Verify:         s.verify,
+	}
+}
@@ -0,0 +1,132 @@
+package slack
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+// exportFixture is a workspace export with a public channel with a thread and a snippet, an ignored channel, and a
+// direct message.
+var exportFixture = map[string]string{
+	"users.json": `[
+		{"id": "U1", "name": "jane", "profile": {"email": "jane@example.com"}},
+		{"id": "U2", "name": "john", "profile": {"email": "john@example.com"}}
+	]`,
+	"channels.json": `[{"id": "C1", "name": "ops"}, {"id": "C2", "name": "random"}]`,
+	"dms.json":      `[{"id": "D1", "members": ["U1", "U2"]}]`,
+	"ops/2024-01-02.json": `[
+		{"type": "message", "user": "U1", "text": "deploy key below", "ts": "1704164645.000100", "thread_ts": "1704164645.000100",
+		 "files": [{"id": "F1", "name": "deploy.sh", "mode": "snippet", "preview": "export TOKEN=abc"}]},
+		{"type": "message", "user": "U2", "text": "", "ts": "1704164700.000200",
+		 "attachments": [{"fallback": "unfurled password: hunter2"}]}
+	]`,
+	"ops/2024-01-03.json": `[
+		{"type": "message", "user": "U2", "text": "rotated it", "ts": "1704250000.000300", "thread_ts": "1704164645.000100"}
+	]`,
+	"random/2024-01-02.json": `[{"type": "message", "user": "U1", "text": "lunch?", "ts": "1704164800.000400"}]`,
+	"D1/2024-01-04.json":     `[{"type": "message", "user": "U2", "text": "my password is hunter2", "ts": "1704336400.000500"}]`,
+}
+
+func writeExportDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	return dir
+}
+
+// writeExportZip zips an export under a top-level directory, as when the extracted export is zipped again.
+func writeExportZip(t *testing.T, files map[string]string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "export.zip")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	zw := zip.NewWriter(f)
+	for name, content := range files {
+		w, err := zw.Create("Acme Slack export/" + name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return path
+}
+
+func TestSource_ChunkUnit(t *testing.T) {
+	tests := []struct {
+		name       string
+		exportPath string
+	}{
+		{name: "directory", exportPath: writeExportDir(t, exportFixture)},
+		{name: "zip", exportPath: writeExportZip(t, exportFixture)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, err := anypb.New(&sourcespb.Slack{
+				Endpoint:    "https://acme.slack.com/",
+				ExportPaths: []string{tt.exportPath},
+				IgnoreList:  []string{"random"},
+			})
+			require.NoError(t, err)
+			s := &Source{}
+			ctx := context.Background()
+			require.NoError(t, s.Init(ctx, "test slack export", 0, 0, false, conn, 1))
+
+			units := &sourcestest.TestReporter{}
+			require.NoError(t, s.Enumerate(ctx, units))
+			require.Equal(t, []sources.SourceUnit{sources.CommonSourceUnit{Kind: UnitExport, ID: tt.exportPath}}, units.Units)
+
+			chunks := &sourcestest.TestReporter{}
+			require.NoError(t, s.ChunkUnit(ctx, units.Units[0], chunks))
+			require.Empty(t, chunks.ChunkErrs)
+
+			var metadata []*source_metadatapb.Slack
+			var data []string
+			for _, chunk := range chunks.Chunks {
+				metadata = append(metadata, chunk.SourceMetadata.GetSlack())
+				data = append(data, string(chunk.Data))
+			}
+			require.Equal(t, []string{
+				"my password is hunter2",
+				"deploy key below",
+				"export TOKEN=abc",
+				"unfurled password: hunter2",
+				"rotated it",
+			}, data)
+
+			assert.Equal(t, &source_metadatapb.Slack{
+				ChannelId: "D1", ChannelName: "jane, john", Timestamp: "2024-01-04T02:46:40.0005Z", UserId: "U2",
+				Email: "john@example.com", Visibility: source_metadatapb.Visibility_private,
+				Location: "/archives/D1/p1704336400000500", Link: "https://acme.slack.com/archives/D1/p1704336400000500",
+			}, metadata[0])
+			assert.Equal(t, "ops", metadata[1].GetChannelName())
+			assert.Equal(t, source_metadatapb.Visibility_public, metadata[1].GetVisibility())
+			assert.Equal(t, "/archives/C1/p1704164645000100", metadata[1].GetLocation())
+			assert.Equal(t, "deploy.sh", metadata[2].GetFile())
+			assert.Equal(t, "jane@example.com", metadata[2].GetEmail())
+			assert.Equal(t, "/archives/C1/p1704250000000300?thread_ts=1704164645.000100&cid=C1", metadata[4].GetLocation())
+		})
+	}
+}
+
+func TestInit_requiresExportPaths(t *testing.T) {
+	conn, err := anypb.New(&sourcespb.Slack{Credential: &sourcespb.Slack_Token{Token: "xoxb-token"}})
+	require.NoError(t, err)
+	assert.Error(t, (&Source{}).Init(context.Background(), "test slack", 0, 0, false, conn, 1))
+}
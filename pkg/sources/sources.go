@@ -5,6 +5,7 @@ import (
 	"errors"
 	"runtime"
 	"sync"
+	"time"
 
 	"google.golang.org/protobuf/types/known/anypb"
 
@@ -292,6 +293,12 @@ type GitConfig struct {
 	PrintLegacyJSON bool
 	// TrustLocalGitConfig allows to trust the local git config.
 	TrustLocalGitConfig bool
+	// ScanUnreachable also scans stashes, reflogs and dangling commits and blobs.
+	ScanUnreachable bool
+	// TrackLifecycle looks up when each secret found was introduced and removed.
+	TrackLifecycle bool
+	// Revisions, if set, limits the scan to the commits these git log revision arguments select.
+	Revisions []string
 }
 
 // GithubConfig defines the optional configuration for a github source.
@@ -381,6 +388,138 @@ type GitlabConfig struct {
 	PrintLegacyJSON bool
 }
 
+// BitbucketConfig defines the optional configuration for a Bitbucket source.
+type BitbucketConfig struct {
+	// Endpoint is the endpoint of the source. Empty means Bitbucket Cloud.
+	Endpoint string
+	// InstallationType is "cloud", "data-center", or empty to detect it from the endpoint.
+	InstallationType string
+	// Token is the access token to use to authenticate with the source.
+	Token string
+	// Username and Password are the username and app password to authenticate with instead of a token.
+	Username string
+	Password string
+	// Repos is the list of repositories to scan, as <project key or workspace>/<slug>.
+	Repos []string
+	// Projects is the list of Data Center project keys or Cloud workspaces to scan.
+	Projects []string
+	// IncludeRepos is a list of repositories to include in the scan.
+	IncludeRepos []string
+	// ExcludeRepos is a list of repositories to exclude from the scan.
+	ExcludeRepos []string
+	// IncludePullRequestComments determines whether to scan the comments of pull requests.
+	IncludePullRequestComments bool
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
+	// SkipBinaries allows skipping binary files from the scan.
+	SkipBinaries bool
+}
+
+// AzureReposConfig defines the optional configuration for an Azure Repos source.
+type AzureReposConfig struct {
+	// Endpoint is the endpoint of Azure DevOps Services or of an Azure DevOps Server.
+	Endpoint string
+	// Token is the personal access token to authenticate with.
+	Token string
+	// Repos is the list of repository URLs to scan.
+	Repos []string
+	// Organizations is the list of organizations, or Azure DevOps Server collections, to scan.
+	Organizations []string
+	// Projects is the list of projects to scan, as <organization>/<project>.
+	Projects []string
+	// IncludeRepos and ExcludeRepos are globs of <organization>/<project>/<repository> to include in or exclude from
+	// the scan.
+	IncludeRepos []string
+	ExcludeRepos []string
+	// IncludeProjects and ExcludeProjects are globs of <organization>/<project> to include in or exclude from the
+	// scan.
+	IncludeProjects []string
+	ExcludeProjects []string
+	// IncludeForks determines whether to scan forked repositories.
+	IncludeForks bool
+	// IncludePipelineVariables determines whether to scan the variables of build pipelines and variable groups.
+	IncludePipelineVariables bool
+	// IncludeWikis determines whether to scan the repositories of project wikis.
+	IncludeWikis bool
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
+	// SkipBinaries allows skipping binary files from the scan.
+	SkipBinaries bool
+}
+
+// GerritConfig defines the optional configuration for a Gerrit source.
+type GerritConfig struct {
+	// Endpoint is the URL of the Gerrit server.
+	Endpoint string
+	// Username and Password are the HTTP credentials to authenticate with, if any.
+	Username string
+	Password string
+	// Projects is the list of projects to scan. Leave empty to scan every project.
+	Projects []string
+	// RecentChangeDays also scans the closed changes updated within this many days. Open changes are always scanned.
+	RecentChangeDays uint32
+	// SkipComments skips scanning change messages and review comments.
+	SkipComments bool
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
+	// SkipBinaries allows skipping binary files from the scan.
+	SkipBinaries bool
+}
+
+// JiraConfig defines the optional configuration for a Jira source.
+type JiraConfig struct {
+	// Endpoint is the URL of the Jira Cloud site or Jira Data Center server.
+	Endpoint string
+	// InstallationType is "cloud", "data-center", or empty to detect it from the endpoint.
+	InstallationType string
+	// Username and Password are the email or username and API token or password to authenticate with.
+	Username string
+	Password string
+	// Token is the personal access token to authenticate with instead of a username and password.
+	Token string
+	// Projects is the list of project keys to scan. Leave empty to scan every project.
+	Projects []string
+	// IgnoreProjects is the list of project keys to skip.
+	IgnoreProjects []string
+	// JQL only scans the issues matching this query.
+	JQL string
+	// Since only scans the issues updated at or after this time, if it isn't zero.
+	Since time.Time
+	// IncludeAttachments also scans the attachments of issues.
+	IncludeAttachments bool
+	// InsecureSkipVerifyTLS skips verifying the TLS certificate of the server.
+	InsecureSkipVerifyTLS bool
+}
+
+// ConfluenceConfig defines the optional configuration for a Confluence source.
+type ConfluenceConfig struct {
+	// Endpoint is the URL of the Confluence Cloud site or Confluence Data Center server.
+	Endpoint string
+	// Username and Password are the email or username and API token or password to authenticate with.
+	Username string
+	Password string
+	// Token is the personal access token to authenticate with instead of a username and password.
+	Token string
+	// Spaces is the list of space keys to scan. Leave empty to scan every space of SpacesScope.
+	Spaces []string
+	// IgnoreSpaces is the list of space keys to skip.
+	IgnoreSpaces []string
+	// SpacesScope is "global", "personal", or empty to scan every space when Spaces is empty.
+	SpacesScope string
+	// CQL only scans the pages and blog posts matching this query.
+	CQL string
+	// Since only scans the pages and blog posts modified at or after this time, if it isn't zero.
+	Since time.Time
+	// IncludeComments also scans the comments of pages and blog posts.
+	IncludeComments bool
+	// IncludeAttachments also scans the attachments of pages and blog posts.
+	IncludeAttachments bool
+	// SkipHistory only scans the current version of pages and blog posts.
+	SkipHistory bool
+	// InsecureSkipVerifyTLS skips verifying the TLS certificate of the server.
+	InsecureSkipVerifyTLS bool
+}
+
 // FilesystemConfig defines the optional configuration for a filesystem source.
 type FilesystemConfig struct {
 	// Paths is the list of files and directories to scan.
@@ -389,6 +528,25 @@ type FilesystemConfig struct {
 	IncludePathsFile string
 	// ExcludePathsFile is the path to a file containing a list of regexps to exclude from the scan.
 	ExcludePathsFile string
+	// RespectGitignore skips paths ignored by .gitignore and .trufflehogignore files.
+	RespectGitignore bool
+	// StateFile is the path to a file recording the files scanned, so that later scans skip unchanged files.
+	StateFile string
+	// RescanFilesWithFindings rescans unchanged files that had findings in the previous scan, rather than skipping
+	// them, so that their findings are reported again.
+	RescanFilesWithFindings bool
+	// FollowSymlinks follows symlinks to files and directories, which are skipped by default.
+	FollowSymlinks bool
+	// SymlinksWithinRoot only follows symlinks whose targets are within the scanned directory.
+	SymlinksWithinRoot bool
+	// MaxFileSize is the size in bytes of the largest file to scan, or 0 for no limit.
+	MaxFileSize int64
+	// ModifiedSince, if set, restricts the scan to files modified at or after this time.
+	ModifiedSince time.Time
+	// ModifiedBefore, if set, restricts the scan to files modified before this time.
+	ModifiedBefore time.Time
+	// OneFileSystem skips directories on other filesystems than the scanned directory, such as mount points.
+	OneFileSystem bool
 }
 
 // S3Config defines the optional configuration for an S3 source.
@@ -412,6 +570,94 @@ type S3Config struct {
 	MaxObjectSize int64
 }
 
+// SlackExportConfig defines the optional configuration for a Slack export source.
+type SlackExportConfig struct {
+	// Paths is the list of workspace export directories and zips to scan.
+	Paths []string
+	// WorkspaceURL is the URL of the exported workspace, used to link to messages. Example: https://acme.slack.com
+	WorkspaceURL string
+	// Channels is the list of channel names or IDs to scan. Leave empty to scan every conversation.
+	Channels []string
+	// IgnoreChannels is the list of channel names or IDs to skip.
+	IgnoreChannels []string
+}
+
+// NPMConfig defines the optional configuration for an npm source.
+type NPMConfig struct {
+	// RegistryURL is the URL of the registry. Empty means the public npm registry.
+	RegistryURL string
+	// Packages is the list of packages to scan.
+	Packages []string
+	// Scope also scans every package of this scope, such as @acme.
+	Scope string
+}
+
+// PyPIConfig defines the optional configuration for a PyPI source.
+type PyPIConfig struct {
+	// IndexURL is the URL of the simple repository API of the index. Empty means PyPI.
+	IndexURL string
+	// Packages is the list of projects to scan.
+	Packages []string
+	// AllPackages scans every project of the index, for private indexes.
+	AllPackages bool
+}
+
+// ArtifactoryConfig defines the optional configuration for a JFrog Artifactory source.
+type ArtifactoryConfig struct {
+	// Endpoint is the URL of Artifactory. Example: https://acme.jfrog.io/artifactory
+	Endpoint string
+	// Username and Password are the credentials to authenticate with, if any.
+	Username string
+	Password string
+	// AccessToken is the access token to authenticate with instead of a username and password.
+	AccessToken string
+	// Repositories is the list of repositories to scan. Leave empty to scan every local repository.
+	Repositories []string
+	// IncludePaths is the list of globs of the artifact paths to scan.
+	IncludePaths []string
+	// IgnorePaths is the list of globs of the artifact paths to skip.
+	IgnorePaths []string
+	// MaxArtifactSize is the maximum size of the artifacts to scan.
+	MaxArtifactSize int64
+}
+
+// KubernetesConfig defines the optional configuration for a Kubernetes source.
+type KubernetesConfig struct {
+	// Kubeconfig is the path of the kubeconfig file. Defaults to $KUBECONFIG or ~/.kube/config.
+	Kubeconfig string
+	// Context is the kubeconfig context to use. Defaults to the current context.
+	Context string
+	// InCluster authenticates with the service account of the pod TruffleHog runs in instead of a kubeconfig.
+	InCluster bool
+	// Namespaces is the list of namespaces to scan. Leave empty to scan every namespace.
+	Namespaces []string
+	// IgnoreNamespaces is the list of namespaces to skip.
+	IgnoreNamespaces []string
+	// IncludeSecrets scans the Secrets of the namespaces, besides the Helm releases stored in Secrets.
+	IncludeSecrets bool
+}
+
+// WebhookConfig defines the optional configuration for a webhook source.
+type WebhookConfig struct {
+	// ListenAddress is the address to listen for requests on. Example: :8080
+	ListenAddress string
+	// HeaderName and HeaderValue are a header requests must carry, if any.
+	HeaderName  string
+	HeaderValue string
+	// HMACSecret is the secret of the HMAC-SHA256 signature of the body requests must carry, if any.
+	HMACSecret string
+	// SignatureHeader is the header carrying the signature. Defaults to X-Hub-Signature-256.
+	SignatureHeader string
+	// MetadataHeaders is the list of request headers to record in the metadata of findings.
+	MetadataHeaders []string
+	// MaxBodySize is the maximum size of the request bodies to scan.
+	MaxBodySize int64
+	// RespondWithFindings makes requests wait for the findings in their body and respond with them.
+	RespondWithFindings bool
+	// ResponseTimeout is how long requests wait for their findings.
+	ResponseTimeout time.Duration
+}
+
 // SyslogConfig defines the optional configuration for a syslog source.
 type SyslogConfig struct {
 	// Address used to connect to the source.
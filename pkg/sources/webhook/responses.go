@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"sync"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// Finding is a finding returned in the response to the request it was found in.
+type Finding struct {
+	DetectorName      string
+	DecoderName       string
+	Verified          bool
+	VerificationError string `json:",omitempty"`
+	// Redacted is the redacted version of the secret, so the response doesn't echo the secret back.
+	Redacted  string
+	ExtraData map[string]string `json:",omitempty"`
+}
+
+// Responses collects the findings of requests until every chunk of their body has been scanned, so the handlers of
+// the requests can respond with them. The engine tracks the work in flight for the chunks of each request, since chunks
+// carry no completion signal of their own.
+type Responses struct {
+	mu      sync.Mutex
+	pending map[string]*response
+}
+
+// response is the state of a request waiting for its findings.
+type response struct {
+	// inFlight counts the chunks, detections and results of the request which haven't been dispatched yet, plus one
+	// for the request itself while its chunks are being sent.
+	inFlight int
+	findings []Finding
+	// done is closed once nothing is in flight anymore.
+	done chan struct{}
+}
+
+// NewResponses returns empty Responses.
+func NewResponses() *Responses {
+	return &Responses{pending: make(map[string]*response)}
+}
+
+// expect starts collecting the findings of a request, which is in flight until its handler calls Track with -1.
+func (r *Responses) expect(requestID string) *response {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	resp := &response{inFlight: 1, done: make(chan struct{})}
+	r.pending[requestID] = resp
+	return resp
+}
+
+// forget stops collecting the findings of a request and returns those collected so far.
+func (r *Responses) forget(requestID string) []Finding {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	resp, ok := r.pending[requestID]
+	if !ok {
+		return nil
+	}
+	delete(r.pending, requestID)
+	return resp.findings
+}
+
+// Track adds delta to the work in flight for a request. Requests whose findings aren't expected are ignored.
+func (r *Responses) Track(requestID string, delta int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	resp, ok := r.pending[requestID]
+	if !ok || resp.inFlight == 0 {
+		return
+	}
+	resp.inFlight += delta
+	if resp.inFlight <= 0 {
+		resp.inFlight = 0
+		close(resp.done)
+	}
+}
+
+// Record records a finding of a request. Requests whose findings aren't expected are ignored.
+func (r *Responses) Record(result detectors.ResultWithMetadata) {
+	requestID := result.SourceMetadata.GetWebhook().GetRequestId()
+	finding := Finding{
+		DetectorName: result.DetectorType.String(),
+		DecoderName:  result.DecoderType.String(),
+		Verified:     result.Verified,
+		Redacted:     result.Redacted,
+		ExtraData:    result.ExtraData,
+	}
+	if err := result.VerificationError(); err != nil {
+		finding.VerificationError = err.Error()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if resp, ok := r.pending[requestID]; ok {
+		resp.findings = append(resp.findings, finding)
+	}
+}
@@ -0,0 +1,305 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_WEBHOOK
+
+const (
+	defaultListenAddress   = ":8080"
+	defaultSignatureHeader = "X-Hub-Signature-256"
+	defaultMaxBodySize     = 10 * 1024 * 1024 // 10 MiB
+	defaultResponseTimeout = 30 * time.Second
+)
+
+// Source listens for HTTP requests and scans the body of each POST request, such as CI logs, chat messages or form
+// submissions forwarded by other systems. It runs until its context is cancelled.
+type Source struct {
+	name     string
+	sourceID sources.SourceID
+	jobID    sources.JobID
+	verify   bool
+
+	listenAddress string
+	// headerKey and headerValue, if set, are a header requests must carry.
+	headerKey   string
+	headerValue string
+	// hmacSecret, if set, is the secret of the HMAC-SHA256 signature of the body requests must carry.
+	hmacSecret      []byte
+	signatureHeader string
+	metadataHeaders []string
+	maxBodySize     int64
+	// responses collects the findings of requests, if requests are responded to with their findings.
+	responses       *Responses
+	responseTimeout time.Duration
+
+	sources.Progress
+}
+
+// Ensure the Source satisfies the interface at compile time.
+var _ sources.Source = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized webhook source.
+func (s *Source) Init(_ context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, _ int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+
+	var conn sourcespb.Webhook
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.listenAddress = conn.GetListenAddress()
+	if s.listenAddress == "" {
+		s.listenAddress = defaultListenAddress
+	}
+	if header := conn.GetHeader(); header != nil {
+		if header.GetKey() == "" {
+			return fmt.Errorf("invalid configuration given for source %q (%s): empty header name", name, s.Type().String())
+		}
+		log.RedactGlobally(header.GetValue())
+		s.headerKey, s.headerValue = header.GetKey(), header.GetValue()
+	}
+	if secret := conn.GetHmacSecret(); secret != "" {
+		log.RedactGlobally(secret)
+		s.hmacSecret = []byte(secret)
+	}
+	s.signatureHeader = conn.GetSignatureHeader()
+	if s.signatureHeader == "" {
+		s.signatureHeader = defaultSignatureHeader
+	}
+	s.metadataHeaders = conn.GetMetadataHeaders()
+	s.maxBodySize = conn.GetMaxBodySize()
+	if s.maxBodySize <= 0 {
+		s.maxBodySize = defaultMaxBodySize
+	}
+	if conn.GetRespondWithFindings() {
+		s.responses = NewResponses()
+		s.responseTimeout = conn.GetResponseTimeout().AsDuration()
+		if s.responseTimeout <= 0 {
+			s.responseTimeout = defaultResponseTimeout
+		}
+	}
+	return nil
+}
+
+// Responses returns the findings collector the engine reports the findings of requests to, if requests are
+// responded to with their findings.
+func (s *Source) Responses() *Responses {
+	return s.responses
+}
+
+// Chunks listens for requests and emits the chunks of their bodies until the context is cancelled.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	listener, err := net.Listen("tcp", s.listenAddress)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %w", s.listenAddress, err)
+	}
+	server := &http.Server{
+		Handler:           s.handler(ctx, chunksChan),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	ctx.Logger().Info("listening for webhook requests", "address", listener.Addr().String())
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("error serving webhook requests: %w", err)
+	}
+	return nil
+}
+
+// handler returns the HTTP handler which authenticates requests and sends the chunks of their bodies to chunksChan.
+func (s *Source) handler(ctx context.Context, chunksChan chan *sources.Chunk) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !s.authorized(r) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxBodySize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, "error reading request body", http.StatusBadRequest)
+			return
+		}
+		if s.hmacSecret != nil && !s.validSignature(r.Header.Get(s.signatureHeader), body) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		requestID := uuid.NewString()
+		ctx := context.WithValues(ctx, "request_id", requestID, "remote_address", r.RemoteAddr)
+		var resp *response
+		if s.responses != nil {
+			resp = s.responses.expect(requestID)
+			defer s.responses.forget(requestID)
+		}
+
+		err = handlers.HandleFile(ctx, bytes.NewReader(body), s.chunkSkel(r, requestID), trackingReporter{
+			ChunkReporter: sources.ChanReporter{Ch: chunksChan},
+			track:         func(delta int) { s.trackRequest(requestID, delta) },
+		})
+		// The request itself is no longer in flight once all of its chunks have been sent.
+		s.trackRequest(requestID, -1)
+		if err != nil {
+			ctx.Logger().Error(err, "error scanning webhook request body")
+			http.Error(w, "error scanning request body", http.StatusInternalServerError)
+			return
+		}
+
+		if resp == nil {
+			writeJSON(w, http.StatusAccepted, map[string]any{"request_id": requestID})
+			return
+		}
+		complete := true
+		timer := time.NewTimer(s.responseTimeout)
+		defer timer.Stop()
+		select {
+		case <-resp.done:
+		case <-timer.C:
+			complete = false
+		case <-r.Context().Done():
+			return
+		}
+		findings := s.responses.forget(requestID)
+		if findings == nil {
+			findings = []Finding{}
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			"request_id": requestID,
+			"findings":   findings,
+			"complete":   complete,
+		})
+	})
+}
+
+// authorized returns whether a request carries the configured header, if any.
+func (s *Source) authorized(r *http.Request) bool {
+	if s.headerKey == "" {
+		return true
+	}
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get(s.headerKey)), []byte(s.headerValue)) == 1
+}
+
+// validSignature returns whether signature is the hex encoded HMAC-SHA256 of the body, optionally prefixed with
+// "sha256=" as GitHub and others send it.
+func (s *Source) validSignature(signature string, body []byte) bool {
+	got, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signature), "sha256="))
+	if err != nil || len(got) == 0 {
+		return false
+	}
+	mac := hmac.New(sha256.New, s.hmacSecret)
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// trackRequest adds delta to the work in flight for a request, if requests are responded to with their findings.
+func (s *Source) trackRequest(requestID string, delta int) {
+	if s.responses != nil {
+		s.responses.Track(requestID, delta)
+	}
+}
+
+// chunkSkel returns the skeleton of the chunks of the body of a request.
+func (s *Source) chunkSkel(r *http.Request, requestID string) *sources.Chunk {
+	var headers map[string]string
+	for _, name := range s.metadataHeaders {
+		if value := r.Header.Get(name); value != "" {
+			if headers == nil {
+				headers = make(map[string]string, len(s.metadataHeaders))
+			}
+			headers[name] = sanitizer.UTF8(value)
+		}
+	}
+	return &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		JobID:      s.JobID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{Data: &source_metadatapb.MetaData_Webhook{
+			Webhook: &source_metadatapb.Webhook{
+				RequestId:     requestID,
+				RemoteAddress: sanitizer.UTF8(r.RemoteAddr),
+				Path:          sanitizer.UTF8(r.URL.Path),
+				Timestamp:     time.Now().UTC().Format(time.RFC3339),
+				Headers:       headers,
+			},
+		}},
+		Verify: s.verify,
+	}
+}
+
+// trackingReporter counts each chunk it reports as work in flight for the request the chunk belongs to, before the
+// engine picks it up.
+type trackingReporter struct {
+	sources.ChunkReporter
+	track func(delta int)
+}
+
+func (r trackingReporter) ChunkOk(ctx context.Context, chunk sources.Chunk) error {
+	r.track(1)
+	if err := r.ChunkReporter.ChunkOk(ctx, chunk); err != nil {
+		r.track(-1)
+		return err
+	}
+	return nil
+}
+
+// writeJSON writes v as the JSON body of a response.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,116 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newSource(t *testing.T, conn *sourcespb.Webhook) *Source {
+	t.Helper()
+	c, err := anypb.New(conn)
+	require.NoError(t, err)
+	s := &Source{}
+	require.NoError(t, s.Init(context.Background(), "test webhook", 0, 0, false, c, 1))
+	return s
+}
+
+func TestSource_Handler(t *testing.T) {
+	s := newSource(t, &sourcespb.Webhook{
+		Credential:      &sourcespb.Webhook_Header{Header: &credentialspb.Header{Key: "X-Token", Value: "token"}},
+		HmacSecret:      "hmac-secret",
+		MetadataHeaders: []string{"X-GitHub-Event", "X-Missing"},
+	})
+	chunksChan := make(chan *sources.Chunk, 1)
+	server := httptest.NewServer(s.handler(context.Background(), chunksChan))
+	defer server.Close()
+
+	body := "deploy log: AKIA... password=hunter2"
+	tests := map[string]struct {
+		method    string
+		token     string
+		signature string
+		want      int
+	}{
+		"wrong method":      {method: http.MethodGet, token: "token", signature: sign("hmac-secret", body), want: http.StatusMethodNotAllowed},
+		"missing header":    {method: http.MethodPost, signature: sign("hmac-secret", body), want: http.StatusUnauthorized},
+		"invalid signature": {method: http.MethodPost, token: "token", signature: sign("other", body), want: http.StatusUnauthorized},
+		"valid":             {method: http.MethodPost, token: "token", signature: sign("hmac-secret", body), want: http.StatusAccepted},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, server.URL+"/ci/logs", strings.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set("X-Token", tt.token)
+			req.Header.Set("X-Hub-Signature-256", tt.signature)
+			req.Header.Set("X-GitHub-Event", "workflow_run")
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tt.want, resp.StatusCode)
+		})
+	}
+
+	require.Len(t, chunksChan, 1)
+	chunk := <-chunksChan
+	assert.Equal(t, body, string(chunk.Data))
+	meta := chunk.SourceMetadata.GetWebhook()
+	assert.NotEmpty(t, meta.GetRequestId())
+	assert.Equal(t, "/ci/logs", meta.GetPath())
+	assert.NotEmpty(t, meta.GetRemoteAddress())
+	assert.Equal(t, map[string]string{"X-GitHub-Event": "workflow_run"}, meta.GetHeaders())
+}
+
+func TestSource_RespondWithFindings(t *testing.T) {
+	s := newSource(t, &sourcespb.Webhook{RespondWithFindings: true})
+	chunksChan := make(chan *sources.Chunk)
+	server := httptest.NewServer(s.handler(context.Background(), chunksChan))
+	defer server.Close()
+
+	// Act as the engine: report a finding of the chunk, then mark the chunk as done.
+	go func() {
+		chunk := <-chunksChan
+		s.Responses().Record(detectors.ResultWithMetadata{
+			SourceMetadata: chunk.SourceMetadata,
+			Result:         detectors.Result{DetectorType: detectorspb.DetectorType_AWS, Redacted: "AKIA..."},
+		})
+		s.Responses().Track(chunk.SourceMetadata.GetWebhook().GetRequestId(), -1)
+	}()
+
+	resp, err := http.Post(server.URL, "text/plain", strings.NewReader("aws_access_key_id=AKIA..."))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var got struct {
+		RequestID string `json:"request_id"`
+		Findings  []Finding
+		Complete  bool
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	assert.NotEmpty(t, got.RequestID)
+	assert.True(t, got.Complete)
+	assert.Equal(t, []Finding{{DetectorName: "AWS", DecoderName: "UNKNOWN", Redacted: "AKIA..."}}, got.Findings)
+}